@@ -10,6 +10,7 @@ import (
 
 	"cv2/internal/config"
 	"cv2/internal/handler"
+	"cv2/internal/logic/resume"
 	"cv2/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/conf"
@@ -37,6 +38,12 @@ func main() {
 	ctx := svc.NewServiceContext(c)
 	handler.RegisterHandlers(server, ctx)
 
+	// 后台任务
+	resume.StartTrashPurger(ctx)
+	resume.StartResumeRenderer(ctx)
+	resume.StartScoreWorker(ctx)
+	ctx.Modules.StartAutoReload()
+
 	fmt.Printf("Starting server at %s:%d...\n", c.Host, c.Port)
 	server.Start()
 }
@@ -41,6 +41,37 @@ type Config struct {
 	DefaultConfig struct {
 		DefaultCoverImage string // 默认封面图URL
 	}
+	Trash struct {
+		RetentionDays int `json:",default=30"` // 回收站保留天数，超期后彻底删除
+	}
+	Render struct {
+		DebounceSeconds int    `json:",default=5"`                           // 内容修改后等待多久再重新渲染，期间的修改合并为一次渲染
+		MaxWaitSeconds  int    `json:",default=30"`                          // 连续修改时距首次修改的最长等待时间
+		Markdown        string `json:",default=remote,options=local|remote"` // Markdown 渲染方式: local=本地模板, remote=算法服务（失败时回退本地模板）
+		PDF             string `json:",default=remote,options=local|remote"` // PDF 渲染方式: local=本地排版（需要中文字体，没有时启动失败）, remote=算法服务
+		FontPath        string `json:",optional"`                            // 中文字体（TTF）路径，为空时使用构建时嵌入的字体
+		BoldFontPath    string `json:",optional"`                            // 粗体字体路径，为空时使用嵌入的粗体或常规字体
+	}
+	Scoring struct {
+		Workers               int `json:",default=4"`      // 同时评分的模块数
+		ModuleTimeoutSeconds  int `json:",default=60"`     // 单个模块的评分超时（秒），超时的模块记为评分失败
+		CacheTTLSeconds       int `json:",default=604800"` // 评分结果缓存时间（秒），为 0 时不缓存
+		PendingTimeoutSeconds int `json:",default=600"`    // 后台评分任务的最长等待时间（秒），超时仍未完成的模块标记为 stale
+	}
+	Redaction struct { // 脱敏导出、脱敏分享的策略: keep=保留, mask=部分遮盖, remove=清空
+		Name      string `json:",default=mask,options=keep|mask|remove"`   // 姓名，mask 只保留姓氏
+		Phone     string `json:",default=mask,options=keep|mask|remove"`   // 电话，mask 保留前 3 位和后 4 位
+		Email     string `json:",default=mask,options=keep|mask|remove"`   // 邮箱，mask 保留首字符和域名
+		Birthday  string `json:",default=mask,options=keep|mask|remove"`   // 出生日期，mask 只保留年份
+		Ethnicity string `json:",default=remove,options=keep|mask|remove"` // 民族
+		Politics  string `json:",default=remove,options=keep|mask|remove"` // 政治面貌
+		School    string `json:",default=mask,options=keep|mask|remove"`   // 学校名称，mask 只保留类别（如 某大学）
+		Photo     string `json:",default=remove,options=keep|remove"`      // 照片
+		FreeText  bool   `json:",default=true"`                            // 遮盖所有文本中的手机号、邮箱和身份证号
+	}
+	Share struct {
+		BaseURL string `json:",optional"` // 分享页面地址前缀（如 https://cv.example.com/s/），分享地址为前缀加令牌；为空时返回接口地址 /api/share/{token}
+	}
 	Pay struct {
 		ServiceURL         string // 支付微服务地址
 		BuySlotNotifyURL   string // 席位购买回调地址
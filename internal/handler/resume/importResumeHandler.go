@@ -0,0 +1,39 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"net/http"
+
+	"cv2/internal/logic/resume"
+	"cv2/internal/svc"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 导入其他格式的简历（JSON Resume、Europass）
+func ImportResumeHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 解析 multipart form
+		if err := r.ParseMultipartForm(2 << 20); err != nil { // 2MB
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		file, fileHeader, err := r.FormFile("file")
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+		defer file.Close()
+
+		l := resume.NewImportResumeLogic(r.Context(), svcCtx)
+		resp, err := l.ImportResume(r.FormValue("format"), fileHeader)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
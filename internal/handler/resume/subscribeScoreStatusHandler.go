@@ -0,0 +1,64 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"cv2/internal/logic/resume"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+	"github.com/zeromicro/go-zero/core/logc"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 订阅评分状态（SSE，评分状态变化时推送，没有进行中的评分后结束）
+func SubscribeScoreStatusHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ScoreStatusReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		client := make(chan *types.ScoreStatusResp, 16)
+
+		l := resume.NewSubscribeScoreStatusLogic(r.Context(), svcCtx)
+		threading.GoSafeCtx(r.Context(), func() {
+			defer close(client)
+			err := l.SubscribeScoreStatus(&req, client)
+			if err != nil {
+				logc.Errorw(r.Context(), "SubscribeScoreStatusHandler", logc.Field("error", err))
+				return
+			}
+		})
+
+		for {
+			select {
+			case data, ok := <-client:
+				if !ok {
+					return
+				}
+				output, err := json.Marshal(data)
+				if err != nil {
+					logc.Errorw(r.Context(), "SubscribeScoreStatusHandler", logc.Field("error", err))
+					continue
+				}
+
+				if _, err := fmt.Fprintf(w, "data: %s\n\n", string(output)); err != nil {
+					logc.Errorw(r.Context(), "SubscribeScoreStatusHandler", logc.Field("error", err))
+					return
+				}
+				if flusher, ok := w.(http.Flusher); ok {
+					flusher.Flush()
+				}
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}
+}
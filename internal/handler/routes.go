@@ -120,12 +120,150 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/api/resume/:resume_id",
 					Handler: resume.GetResumeHandler(serverCtx),
 				},
+				{
+					// 删除简历（移入回收站）
+					Method:  http.MethodDelete,
+					Path:    "/api/resume/:resume_id",
+					Handler: resume.DeleteResumeHandler(serverCtx),
+				},
+				{
+					// 导出简历（返回预签名下载 URL）
+					Method:  http.MethodGet,
+					Path:    "/api/resume/:resume_id/export",
+					Handler: resume.ExportResumeHandler(serverCtx),
+				},
+				{
+					// 新增自定义模块
+					Method:  http.MethodPost,
+					Path:    "/api/resume/:resume_id/modules",
+					Handler: resume.CreateCustomModuleHandler(serverCtx),
+				},
+				{
+					// 修改自定义模块标题
+					Method:  http.MethodPatch,
+					Path:    "/api/resume/:resume_id/modules/:module_id",
+					Handler: resume.UpdateCustomModuleHandler(serverCtx),
+				},
+				{
+					// 删除自定义模块
+					Method:  http.MethodDelete,
+					Path:    "/api/resume/:resume_id/modules/:module_id",
+					Handler: resume.DeleteCustomModuleHandler(serverCtx),
+				},
+				{
+					// 新增模块条目
+					Method:  http.MethodPost,
+					Path:    "/api/resume/:resume_id/modules/:module_id/items",
+					Handler: resume.AppendModuleItemHandler(serverCtx),
+				},
+				{
+					// 删除模块条目
+					Method:  http.MethodDelete,
+					Path:    "/api/resume/:resume_id/modules/:module_id/items/:item_id",
+					Handler: resume.DeleteModuleItemHandler(serverCtx),
+				},
+				{
+					// 修改模块条目（JSON Merge Patch）
+					Method:  http.MethodPatch,
+					Path:    "/api/resume/:resume_id/modules/:module_id/items/:item_id",
+					Handler: resume.PatchModuleItemHandler(serverCtx),
+				},
+				{
+					// 移动模块条目
+					Method:  http.MethodPost,
+					Path:    "/api/resume/:resume_id/modules/:module_id/items/:item_id/move",
+					Handler: resume.MoveModuleItemHandler(serverCtx),
+				},
+				{
+					// 简历 HTML 预览（返回 text/html）
+					Method:  http.MethodGet,
+					Path:    "/api/resume/:resume_id/preview",
+					Handler: resume.PreviewResumeHandler(serverCtx),
+				},
+				{
+					// 从回收站恢复简历
+					Method:  http.MethodPost,
+					Path:    "/api/resume/:resume_id/restore",
+					Handler: resume.RestoreResumeHandler(serverCtx),
+				},
+				{
+					// 获取评分历史和得分变化趋势
+					Method:  http.MethodGet,
+					Path:    "/api/resume/:resume_id/score-history",
+					Handler: resume.GetScoreHistoryHandler(serverCtx),
+				},
+				{
+					// 查询评分状态
+					Method:  http.MethodGet,
+					Path:    "/api/resume/:resume_id/scores",
+					Handler: resume.GetScoreStatusHandler(serverCtx),
+				},
+				{
+					// 创建简历分享链接
+					Method:  http.MethodPost,
+					Path:    "/api/resume/:resume_id/shares",
+					Handler: resume.CreateResumeShareHandler(serverCtx),
+				},
+				{
+					// 获取简历分享链接列表
+					Method:  http.MethodGet,
+					Path:    "/api/resume/:resume_id/shares",
+					Handler: resume.ListResumeSharesHandler(serverCtx),
+				},
+				{
+					// 撤销简历分享链接
+					Method:  http.MethodPost,
+					Path:    "/api/resume/:resume_id/shares/:share_id/revoke",
+					Handler: resume.RevokeResumeShareHandler(serverCtx),
+				},
+				{
+					// 获取分享链接访问记录
+					Method:  http.MethodGet,
+					Path:    "/api/resume/:resume_id/shares/:share_id/views",
+					Handler: resume.ListResumeShareViewsHandler(serverCtx),
+				},
+				{
+					// 设置简历 PDF 模板
+					Method:  http.MethodPut,
+					Path:    "/api/resume/:resume_id/template",
+					Handler: resume.UpdateResumeTemplateHandler(serverCtx),
+				},
+				{
+					// 获取简历版本列表
+					Method:  http.MethodGet,
+					Path:    "/api/resume/:resume_id/versions",
+					Handler: resume.ListResumeVersionsHandler(serverCtx),
+				},
+				{
+					// 回滚简历到指定版本
+					Method:  http.MethodPost,
+					Path:    "/api/resume/:resume_id/versions/:version_id/revert",
+					Handler: resume.RevertResumeVersionHandler(serverCtx),
+				},
+				{
+					// 对比简历版本
+					Method:  http.MethodGet,
+					Path:    "/api/resume/:resume_id/versions/diff",
+					Handler: resume.DiffResumeVersionsHandler(serverCtx),
+				},
 				{
 					// 生成简历
 					Method:  http.MethodPost,
 					Path:    "/api/resume/generate",
 					Handler: resume.GenerateResumeHandler(serverCtx),
 				},
+				{
+					// 导入其他格式的简历（JSON Resume、Europass）
+					Method:  http.MethodPost,
+					Path:    "/api/resume/import",
+					Handler: resume.ImportResumeHandler(serverCtx),
+				},
+				{
+					// 获取我的简历列表
+					Method:  http.MethodGet,
+					Path:    "/api/resume/list",
+					Handler: resume.ListResumesHandler(serverCtx),
+				},
 				{
 					// 保存简历模块
 					Method:  http.MethodPost,
@@ -138,6 +276,24 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/api/resume/task/:task_id",
 					Handler: resume.GetTaskStatusHandler(serverCtx),
 				},
+				{
+					// 获取 PDF 模板列表
+					Method:  http.MethodGet,
+					Path:    "/api/resume/templates",
+					Handler: resume.ListResumeTemplatesHandler(serverCtx),
+				},
+				{
+					// 获取回收站简历列表
+					Method:  http.MethodGet,
+					Path:    "/api/resume/trash",
+					Handler: resume.ListTrashHandler(serverCtx),
+				},
+				{
+					// 彻底删除回收站中的简历
+					Method:  http.MethodDelete,
+					Path:    "/api/resume/trash/:resume_id",
+					Handler: resume.PurgeResumeHandler(serverCtx),
+				},
 				{
 					// 上传简历文件解析
 					Method:  http.MethodPost,
@@ -160,10 +316,39 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		),
 	)
 
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 查看分享的简历（返回 text/html）
+				Method:  http.MethodGet,
+				Path:    "/api/share/:token",
+				Handler: resume.ViewSharedResumeHandler(serverCtx),
+			},
+			{
+				// 下载分享的简历 PDF（返回预签名下载 URL）
+				Method:  http.MethodGet,
+				Path:    "/api/share/:token/download",
+				Handler: resume.DownloadSharedResumeHandler(serverCtx),
+			},
+			{
+				// 获取分享链接信息
+				Method:  http.MethodGet,
+				Path:    "/api/share/:token/info",
+				Handler: resume.GetShareInfoHandler(serverCtx),
+			},
+		},
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.Auth},
 			[]rest.Route{
+				{
+					// 订阅评分状态（SSE，评分状态变化时推送，没有进行中的评分后结束）
+					Method:  http.MethodGet,
+					Path:    "/api/resume/:resume_id/scores/events",
+					Handler: resume.SubscribeScoreStatusHandler(serverCtx),
+				},
 				{
 					// AI 帮写（SSE 流式返回）
 					Method:  http.MethodPost,
@@ -22,26 +22,44 @@ type TaskStatus struct {
 
 // ResumeData 简历数据
 type ResumeData struct {
-	Name       string         `json:"姓名"`
-	Phone      string         `json:"电话"`
-	Email      string         `json:"邮箱"`
-	JobTitle   string         `json:"意向岗位"`
-	Birthday   string         `json:"出生日期,omitempty"`
-	Ethnicity  string         `json:"民族,omitempty"`
-	Politics   string         `json:"政治面貌,omitempty"`
-	Location   string         `json:"所在地,omitempty"`
-	TargetCity string         `json:"意向城市,omitempty"`
-	MaxSalary  string         `json:"期望薪资上限,omitempty"`
-	MinSalary  string         `json:"期望薪资下限,omitempty"`
-	JobType    string         `json:"求职类型,omitempty"`
-	Education  []EducationExp `json:"教育经历"`
-	CampusExp  []CampusExp    `json:"在校经历"`
-	InternExp  []InternExp    `json:"实习经历"`
-	ProjectExp []ProjectExp   `json:"项目经历"`
-	Skills     string         `json:"技能证书"`
-	SelfEval   string         `json:"自我评价"`
+	Name       string          `json:"姓名"`
+	Phone      string          `json:"电话"`
+	Email      string          `json:"邮箱"`
+	JobTitle   string          `json:"意向岗位"`
+	Birthday   string          `json:"出生日期,omitempty"`
+	Ethnicity  string          `json:"民族,omitempty"`
+	Politics   string          `json:"政治面貌,omitempty"`
+	Location   string          `json:"所在地,omitempty"`
+	TargetCity string          `json:"意向城市,omitempty"`
+	MaxSalary  string          `json:"期望薪资上限,omitempty"`
+	MinSalary  string          `json:"期望薪资下限,omitempty"`
+	JobType    string          `json:"求职类型,omitempty"`
+	Photo      string          `json:"照片,omitempty"` // 照片 URL（MinIO 公开地址）
+	Education  []EducationExp  `json:"教育经历"`
+	CampusExp  []CampusExp     `json:"在校经历"`
+	InternExp  []InternExp     `json:"实习经历"`
+	WorkExp    []WorkExp       `json:"工作经历,omitempty"`
+	ProjectExp []ProjectExp    `json:"项目经历"`
+	Skills     string          `json:"技能证书"`
+	SelfEval   string          `json:"自我评价"`
+	Custom     []CustomSection `json:"自定义模块,omitempty"`
 }
 
+// CustomSection 用户自定义模块（如获奖经历、语言能力），条目为自由结构
+type CustomSection struct {
+	Title string              `json:"模块名称"`
+	Items []map[string]string `json:"条目"`
+}
+
+// 自定义模块条目常用字段的显示名（CustomSection.Items 的 key），其他字段保留原字段名
+const (
+	CustomFieldTitle       = "名称"
+	CustomFieldSubtitle    = "副标题"
+	CustomFieldStartTime   = "开始时间"
+	CustomFieldEndTime     = "结束时间"
+	CustomFieldDescription = "描述"
+)
+
 // EducationExp 教育经历
 type EducationExp struct {
 	SchoolName  string `json:"学校名称"`
@@ -70,6 +88,18 @@ type InternExp struct {
 	Description string `json:"工作内容"`
 }
 
+// WorkExp 工作经历（全职）
+type WorkExp struct {
+	Company          string `json:"公司名称"`
+	Title            string `json:"职位"`
+	Department       string `json:"部门"`
+	EmploymentType   string `json:"工作性质"` // 全职/兼职/合同工等
+	StartTime        string `json:"开始时间"`
+	EndTime          string `json:"结束时间"`
+	Responsibilities string `json:"工作职责"`
+	Achievements     string `json:"工作业绩"`
+}
+
 // ProjectExp 项目经历
 type ProjectExp struct {
 	ProjectName string `json:"项目名称"`
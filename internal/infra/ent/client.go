@@ -19,7 +19,11 @@ import (
 	"cv2/internal/infra/ent/position"
 	"cv2/internal/infra/ent/resume"
 	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
 	"cv2/internal/infra/ent/resumeslot"
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -48,8 +52,16 @@ type Client struct {
 	Resume *ResumeClient
 	// ResumeScore is the client for interacting with the ResumeScore builders.
 	ResumeScore *ResumeScoreClient
+	// ResumeShare is the client for interacting with the ResumeShare builders.
+	ResumeShare *ResumeShareClient
+	// ResumeShareView is the client for interacting with the ResumeShareView builders.
+	ResumeShareView *ResumeShareViewClient
 	// ResumeSlot is the client for interacting with the ResumeSlot builders.
 	ResumeSlot *ResumeSlotClient
+	// ScoreHistory is the client for interacting with the ScoreHistory builders.
+	ScoreHistory *ScoreHistoryClient
+	// ScoreRun is the client for interacting with the ScoreRun builders.
+	ScoreRun *ScoreRunClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -69,7 +81,11 @@ func (c *Client) init() {
 	c.Position = NewPositionClient(c.config)
 	c.Resume = NewResumeClient(c.config)
 	c.ResumeScore = NewResumeScoreClient(c.config)
+	c.ResumeShare = NewResumeShareClient(c.config)
+	c.ResumeShareView = NewResumeShareViewClient(c.config)
 	c.ResumeSlot = NewResumeSlotClient(c.config)
+	c.ScoreHistory = NewScoreHistoryClient(c.config)
+	c.ScoreRun = NewScoreRunClient(c.config)
 }
 
 type (
@@ -160,17 +176,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		AIRecord:    NewAIRecordClient(cfg),
-		City:        NewCityClient(cfg),
-		Dictionary:  NewDictionaryClient(cfg),
-		Dimension:   NewDimensionClient(cfg),
-		Module:      NewModuleClient(cfg),
-		Position:    NewPositionClient(cfg),
-		Resume:      NewResumeClient(cfg),
-		ResumeScore: NewResumeScoreClient(cfg),
-		ResumeSlot:  NewResumeSlotClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AIRecord:        NewAIRecordClient(cfg),
+		City:            NewCityClient(cfg),
+		Dictionary:      NewDictionaryClient(cfg),
+		Dimension:       NewDimensionClient(cfg),
+		Module:          NewModuleClient(cfg),
+		Position:        NewPositionClient(cfg),
+		Resume:          NewResumeClient(cfg),
+		ResumeScore:     NewResumeScoreClient(cfg),
+		ResumeShare:     NewResumeShareClient(cfg),
+		ResumeShareView: NewResumeShareViewClient(cfg),
+		ResumeSlot:      NewResumeSlotClient(cfg),
+		ScoreHistory:    NewScoreHistoryClient(cfg),
+		ScoreRun:        NewScoreRunClient(cfg),
 	}, nil
 }
 
@@ -188,17 +208,21 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		AIRecord:    NewAIRecordClient(cfg),
-		City:        NewCityClient(cfg),
-		Dictionary:  NewDictionaryClient(cfg),
-		Dimension:   NewDimensionClient(cfg),
-		Module:      NewModuleClient(cfg),
-		Position:    NewPositionClient(cfg),
-		Resume:      NewResumeClient(cfg),
-		ResumeScore: NewResumeScoreClient(cfg),
-		ResumeSlot:  NewResumeSlotClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AIRecord:        NewAIRecordClient(cfg),
+		City:            NewCityClient(cfg),
+		Dictionary:      NewDictionaryClient(cfg),
+		Dimension:       NewDimensionClient(cfg),
+		Module:          NewModuleClient(cfg),
+		Position:        NewPositionClient(cfg),
+		Resume:          NewResumeClient(cfg),
+		ResumeScore:     NewResumeScoreClient(cfg),
+		ResumeShare:     NewResumeShareClient(cfg),
+		ResumeShareView: NewResumeShareViewClient(cfg),
+		ResumeSlot:      NewResumeSlotClient(cfg),
+		ScoreHistory:    NewScoreHistoryClient(cfg),
+		ScoreRun:        NewScoreRunClient(cfg),
 	}, nil
 }
 
@@ -229,7 +253,8 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.AIRecord, c.City, c.Dictionary, c.Dimension, c.Module, c.Position, c.Resume,
-		c.ResumeScore, c.ResumeSlot,
+		c.ResumeScore, c.ResumeShare, c.ResumeShareView, c.ResumeSlot, c.ScoreHistory,
+		c.ScoreRun,
 	} {
 		n.Use(hooks...)
 	}
@@ -240,7 +265,8 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.AIRecord, c.City, c.Dictionary, c.Dimension, c.Module, c.Position, c.Resume,
-		c.ResumeScore, c.ResumeSlot,
+		c.ResumeScore, c.ResumeShare, c.ResumeShareView, c.ResumeSlot, c.ScoreHistory,
+		c.ScoreRun,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -265,8 +291,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Resume.mutate(ctx, m)
 	case *ResumeScoreMutation:
 		return c.ResumeScore.mutate(ctx, m)
+	case *ResumeShareMutation:
+		return c.ResumeShare.mutate(ctx, m)
+	case *ResumeShareViewMutation:
+		return c.ResumeShareView.mutate(ctx, m)
 	case *ResumeSlotMutation:
 		return c.ResumeSlot.mutate(ctx, m)
+	case *ScoreHistoryMutation:
+		return c.ScoreHistory.mutate(ctx, m)
+	case *ScoreRunMutation:
+		return c.ScoreRun.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -829,12 +863,14 @@ func (c *DimensionClient) QueryModule(_m *Dimension) *ModuleQuery {
 
 // Hooks returns the client hooks.
 func (c *DimensionClient) Hooks() []Hook {
-	return c.hooks.Dimension
+	hooks := c.hooks.Dimension
+	return append(hooks[:len(hooks):len(hooks)], dimension.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *DimensionClient) Interceptors() []Interceptor {
-	return c.inters.Dimension
+	inters := c.inters.Dimension
+	return append(inters[:len(inters):len(inters)], dimension.Interceptors[:]...)
 }
 
 func (c *DimensionClient) mutate(ctx context.Context, m *DimensionMutation) (Value, error) {
@@ -978,12 +1014,14 @@ func (c *ModuleClient) QueryDimensions(_m *Module) *DimensionQuery {
 
 // Hooks returns the client hooks.
 func (c *ModuleClient) Hooks() []Hook {
-	return c.hooks.Module
+	hooks := c.hooks.Module
+	return append(hooks[:len(hooks):len(hooks)], module.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *ModuleClient) Interceptors() []Interceptor {
-	return c.inters.Module
+	inters := c.inters.Module
+	return append(inters[:len(inters):len(inters)], module.Interceptors[:]...)
 }
 
 func (c *ModuleClient) mutate(ctx context.Context, m *ModuleMutation) (Value, error) {
@@ -1290,14 +1328,48 @@ func (c *ResumeClient) QueryScores(_m *Resume) *ResumeScoreQuery {
 	return query
 }
 
+// QueryShares queries the shares edge of a Resume.
+func (c *ResumeClient) QueryShares(_m *Resume) *ResumeShareQuery {
+	query := (&ResumeShareClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resume.Table, resume.FieldID, id),
+			sqlgraph.To(resumeshare.Table, resumeshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resume.SharesTable, resume.SharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScoreRuns queries the score_runs edge of a Resume.
+func (c *ResumeClient) QueryScoreRuns(_m *Resume) *ScoreRunQuery {
+	query := (&ScoreRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resume.Table, resume.FieldID, id),
+			sqlgraph.To(scorerun.Table, scorerun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resume.ScoreRunsTable, resume.ScoreRunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ResumeClient) Hooks() []Hook {
-	return c.hooks.Resume
+	hooks := c.hooks.Resume
+	return append(hooks[:len(hooks):len(hooks)], resume.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *ResumeClient) Interceptors() []Interceptor {
-	return c.inters.Resume
+	inters := c.inters.Resume
+	return append(inters[:len(inters):len(inters)], resume.Interceptors[:]...)
 }
 
 func (c *ResumeClient) mutate(ctx context.Context, m *ResumeMutation) (Value, error) {
@@ -1441,12 +1513,14 @@ func (c *ResumeScoreClient) QueryResume(_m *ResumeScore) *ResumeQuery {
 
 // Hooks returns the client hooks.
 func (c *ResumeScoreClient) Hooks() []Hook {
-	return c.hooks.ResumeScore
+	hooks := c.hooks.ResumeScore
+	return append(hooks[:len(hooks):len(hooks)], resumescore.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *ResumeScoreClient) Interceptors() []Interceptor {
-	return c.inters.ResumeScore
+	inters := c.inters.ResumeScore
+	return append(inters[:len(inters):len(inters)], resumescore.Interceptors[:]...)
 }
 
 func (c *ResumeScoreClient) mutate(ctx context.Context, m *ResumeScoreMutation) (Value, error) {
@@ -1464,6 +1538,320 @@ func (c *ResumeScoreClient) mutate(ctx context.Context, m *ResumeScoreMutation)
 	}
 }
 
+// ResumeShareClient is a client for the ResumeShare schema.
+type ResumeShareClient struct {
+	config
+}
+
+// NewResumeShareClient returns a client for the ResumeShare from the given config.
+func NewResumeShareClient(c config) *ResumeShareClient {
+	return &ResumeShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resumeshare.Hooks(f(g(h())))`.
+func (c *ResumeShareClient) Use(hooks ...Hook) {
+	c.hooks.ResumeShare = append(c.hooks.ResumeShare, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resumeshare.Intercept(f(g(h())))`.
+func (c *ResumeShareClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResumeShare = append(c.inters.ResumeShare, interceptors...)
+}
+
+// Create returns a builder for creating a ResumeShare entity.
+func (c *ResumeShareClient) Create() *ResumeShareCreate {
+	mutation := newResumeShareMutation(c.config, OpCreate)
+	return &ResumeShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResumeShare entities.
+func (c *ResumeShareClient) CreateBulk(builders ...*ResumeShareCreate) *ResumeShareCreateBulk {
+	return &ResumeShareCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResumeShareClient) MapCreateBulk(slice any, setFunc func(*ResumeShareCreate, int)) *ResumeShareCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResumeShareCreateBulk{err: fmt.Errorf("calling to ResumeShareClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResumeShareCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResumeShareCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResumeShare.
+func (c *ResumeShareClient) Update() *ResumeShareUpdate {
+	mutation := newResumeShareMutation(c.config, OpUpdate)
+	return &ResumeShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResumeShareClient) UpdateOne(_m *ResumeShare) *ResumeShareUpdateOne {
+	mutation := newResumeShareMutation(c.config, OpUpdateOne, withResumeShare(_m))
+	return &ResumeShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResumeShareClient) UpdateOneID(id int64) *ResumeShareUpdateOne {
+	mutation := newResumeShareMutation(c.config, OpUpdateOne, withResumeShareID(id))
+	return &ResumeShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResumeShare.
+func (c *ResumeShareClient) Delete() *ResumeShareDelete {
+	mutation := newResumeShareMutation(c.config, OpDelete)
+	return &ResumeShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResumeShareClient) DeleteOne(_m *ResumeShare) *ResumeShareDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResumeShareClient) DeleteOneID(id int64) *ResumeShareDeleteOne {
+	builder := c.Delete().Where(resumeshare.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResumeShareDeleteOne{builder}
+}
+
+// Query returns a query builder for ResumeShare.
+func (c *ResumeShareClient) Query() *ResumeShareQuery {
+	return &ResumeShareQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResumeShare},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResumeShare entity by its id.
+func (c *ResumeShareClient) Get(ctx context.Context, id int64) (*ResumeShare, error) {
+	return c.Query().Where(resumeshare.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResumeShareClient) GetX(ctx context.Context, id int64) *ResumeShare {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryResume queries the resume edge of a ResumeShare.
+func (c *ResumeShareClient) QueryResume(_m *ResumeShare) *ResumeQuery {
+	query := (&ResumeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumeshare.Table, resumeshare.FieldID, id),
+			sqlgraph.To(resume.Table, resume.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumeshare.ResumeTable, resumeshare.ResumeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryViews queries the views edge of a ResumeShare.
+func (c *ResumeShareClient) QueryViews(_m *ResumeShare) *ResumeShareViewQuery {
+	query := (&ResumeShareViewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumeshare.Table, resumeshare.FieldID, id),
+			sqlgraph.To(resumeshareview.Table, resumeshareview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resumeshare.ViewsTable, resumeshare.ViewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResumeShareClient) Hooks() []Hook {
+	return c.hooks.ResumeShare
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResumeShareClient) Interceptors() []Interceptor {
+	return c.inters.ResumeShare
+}
+
+func (c *ResumeShareClient) mutate(ctx context.Context, m *ResumeShareMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResumeShareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResumeShareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResumeShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResumeShareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ResumeShare mutation op: %q", m.Op())
+	}
+}
+
+// ResumeShareViewClient is a client for the ResumeShareView schema.
+type ResumeShareViewClient struct {
+	config
+}
+
+// NewResumeShareViewClient returns a client for the ResumeShareView from the given config.
+func NewResumeShareViewClient(c config) *ResumeShareViewClient {
+	return &ResumeShareViewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resumeshareview.Hooks(f(g(h())))`.
+func (c *ResumeShareViewClient) Use(hooks ...Hook) {
+	c.hooks.ResumeShareView = append(c.hooks.ResumeShareView, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resumeshareview.Intercept(f(g(h())))`.
+func (c *ResumeShareViewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResumeShareView = append(c.inters.ResumeShareView, interceptors...)
+}
+
+// Create returns a builder for creating a ResumeShareView entity.
+func (c *ResumeShareViewClient) Create() *ResumeShareViewCreate {
+	mutation := newResumeShareViewMutation(c.config, OpCreate)
+	return &ResumeShareViewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResumeShareView entities.
+func (c *ResumeShareViewClient) CreateBulk(builders ...*ResumeShareViewCreate) *ResumeShareViewCreateBulk {
+	return &ResumeShareViewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResumeShareViewClient) MapCreateBulk(slice any, setFunc func(*ResumeShareViewCreate, int)) *ResumeShareViewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResumeShareViewCreateBulk{err: fmt.Errorf("calling to ResumeShareViewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResumeShareViewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResumeShareViewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResumeShareView.
+func (c *ResumeShareViewClient) Update() *ResumeShareViewUpdate {
+	mutation := newResumeShareViewMutation(c.config, OpUpdate)
+	return &ResumeShareViewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResumeShareViewClient) UpdateOne(_m *ResumeShareView) *ResumeShareViewUpdateOne {
+	mutation := newResumeShareViewMutation(c.config, OpUpdateOne, withResumeShareView(_m))
+	return &ResumeShareViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResumeShareViewClient) UpdateOneID(id int64) *ResumeShareViewUpdateOne {
+	mutation := newResumeShareViewMutation(c.config, OpUpdateOne, withResumeShareViewID(id))
+	return &ResumeShareViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResumeShareView.
+func (c *ResumeShareViewClient) Delete() *ResumeShareViewDelete {
+	mutation := newResumeShareViewMutation(c.config, OpDelete)
+	return &ResumeShareViewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResumeShareViewClient) DeleteOne(_m *ResumeShareView) *ResumeShareViewDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResumeShareViewClient) DeleteOneID(id int64) *ResumeShareViewDeleteOne {
+	builder := c.Delete().Where(resumeshareview.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResumeShareViewDeleteOne{builder}
+}
+
+// Query returns a query builder for ResumeShareView.
+func (c *ResumeShareViewClient) Query() *ResumeShareViewQuery {
+	return &ResumeShareViewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResumeShareView},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResumeShareView entity by its id.
+func (c *ResumeShareViewClient) Get(ctx context.Context, id int64) (*ResumeShareView, error) {
+	return c.Query().Where(resumeshareview.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResumeShareViewClient) GetX(ctx context.Context, id int64) *ResumeShareView {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryShare queries the share edge of a ResumeShareView.
+func (c *ResumeShareViewClient) QueryShare(_m *ResumeShareView) *ResumeShareQuery {
+	query := (&ResumeShareClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumeshareview.Table, resumeshareview.FieldID, id),
+			sqlgraph.To(resumeshare.Table, resumeshare.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumeshareview.ShareTable, resumeshareview.ShareColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResumeShareViewClient) Hooks() []Hook {
+	return c.hooks.ResumeShareView
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResumeShareViewClient) Interceptors() []Interceptor {
+	return c.inters.ResumeShareView
+}
+
+func (c *ResumeShareViewClient) mutate(ctx context.Context, m *ResumeShareViewMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResumeShareViewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResumeShareViewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResumeShareViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResumeShareViewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ResumeShareView mutation op: %q", m.Op())
+	}
+}
+
 // ResumeSlotClient is a client for the ResumeSlot schema.
 type ResumeSlotClient struct {
 	config
@@ -1597,14 +1985,329 @@ func (c *ResumeSlotClient) mutate(ctx context.Context, m *ResumeSlotMutation) (V
 	}
 }
 
+// ScoreHistoryClient is a client for the ScoreHistory schema.
+type ScoreHistoryClient struct {
+	config
+}
+
+// NewScoreHistoryClient returns a client for the ScoreHistory from the given config.
+func NewScoreHistoryClient(c config) *ScoreHistoryClient {
+	return &ScoreHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scorehistory.Hooks(f(g(h())))`.
+func (c *ScoreHistoryClient) Use(hooks ...Hook) {
+	c.hooks.ScoreHistory = append(c.hooks.ScoreHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scorehistory.Intercept(f(g(h())))`.
+func (c *ScoreHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScoreHistory = append(c.inters.ScoreHistory, interceptors...)
+}
+
+// Create returns a builder for creating a ScoreHistory entity.
+func (c *ScoreHistoryClient) Create() *ScoreHistoryCreate {
+	mutation := newScoreHistoryMutation(c.config, OpCreate)
+	return &ScoreHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScoreHistory entities.
+func (c *ScoreHistoryClient) CreateBulk(builders ...*ScoreHistoryCreate) *ScoreHistoryCreateBulk {
+	return &ScoreHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScoreHistoryClient) MapCreateBulk(slice any, setFunc func(*ScoreHistoryCreate, int)) *ScoreHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScoreHistoryCreateBulk{err: fmt.Errorf("calling to ScoreHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScoreHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScoreHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScoreHistory.
+func (c *ScoreHistoryClient) Update() *ScoreHistoryUpdate {
+	mutation := newScoreHistoryMutation(c.config, OpUpdate)
+	return &ScoreHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScoreHistoryClient) UpdateOne(_m *ScoreHistory) *ScoreHistoryUpdateOne {
+	mutation := newScoreHistoryMutation(c.config, OpUpdateOne, withScoreHistory(_m))
+	return &ScoreHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScoreHistoryClient) UpdateOneID(id int64) *ScoreHistoryUpdateOne {
+	mutation := newScoreHistoryMutation(c.config, OpUpdateOne, withScoreHistoryID(id))
+	return &ScoreHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScoreHistory.
+func (c *ScoreHistoryClient) Delete() *ScoreHistoryDelete {
+	mutation := newScoreHistoryMutation(c.config, OpDelete)
+	return &ScoreHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScoreHistoryClient) DeleteOne(_m *ScoreHistory) *ScoreHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScoreHistoryClient) DeleteOneID(id int64) *ScoreHistoryDeleteOne {
+	builder := c.Delete().Where(scorehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScoreHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for ScoreHistory.
+func (c *ScoreHistoryClient) Query() *ScoreHistoryQuery {
+	return &ScoreHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScoreHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScoreHistory entity by its id.
+func (c *ScoreHistoryClient) Get(ctx context.Context, id int64) (*ScoreHistory, error) {
+	return c.Query().Where(scorehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScoreHistoryClient) GetX(ctx context.Context, id int64) *ScoreHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a ScoreHistory.
+func (c *ScoreHistoryClient) QueryRun(_m *ScoreHistory) *ScoreRunQuery {
+	query := (&ScoreRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scorehistory.Table, scorehistory.FieldID, id),
+			sqlgraph.To(scorerun.Table, scorerun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scorehistory.RunTable, scorehistory.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScoreHistoryClient) Hooks() []Hook {
+	return c.hooks.ScoreHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScoreHistoryClient) Interceptors() []Interceptor {
+	return c.inters.ScoreHistory
+}
+
+func (c *ScoreHistoryClient) mutate(ctx context.Context, m *ScoreHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScoreHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScoreHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScoreHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScoreHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScoreHistory mutation op: %q", m.Op())
+	}
+}
+
+// ScoreRunClient is a client for the ScoreRun schema.
+type ScoreRunClient struct {
+	config
+}
+
+// NewScoreRunClient returns a client for the ScoreRun from the given config.
+func NewScoreRunClient(c config) *ScoreRunClient {
+	return &ScoreRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scorerun.Hooks(f(g(h())))`.
+func (c *ScoreRunClient) Use(hooks ...Hook) {
+	c.hooks.ScoreRun = append(c.hooks.ScoreRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scorerun.Intercept(f(g(h())))`.
+func (c *ScoreRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScoreRun = append(c.inters.ScoreRun, interceptors...)
+}
+
+// Create returns a builder for creating a ScoreRun entity.
+func (c *ScoreRunClient) Create() *ScoreRunCreate {
+	mutation := newScoreRunMutation(c.config, OpCreate)
+	return &ScoreRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScoreRun entities.
+func (c *ScoreRunClient) CreateBulk(builders ...*ScoreRunCreate) *ScoreRunCreateBulk {
+	return &ScoreRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScoreRunClient) MapCreateBulk(slice any, setFunc func(*ScoreRunCreate, int)) *ScoreRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScoreRunCreateBulk{err: fmt.Errorf("calling to ScoreRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScoreRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScoreRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScoreRun.
+func (c *ScoreRunClient) Update() *ScoreRunUpdate {
+	mutation := newScoreRunMutation(c.config, OpUpdate)
+	return &ScoreRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScoreRunClient) UpdateOne(_m *ScoreRun) *ScoreRunUpdateOne {
+	mutation := newScoreRunMutation(c.config, OpUpdateOne, withScoreRun(_m))
+	return &ScoreRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScoreRunClient) UpdateOneID(id int64) *ScoreRunUpdateOne {
+	mutation := newScoreRunMutation(c.config, OpUpdateOne, withScoreRunID(id))
+	return &ScoreRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScoreRun.
+func (c *ScoreRunClient) Delete() *ScoreRunDelete {
+	mutation := newScoreRunMutation(c.config, OpDelete)
+	return &ScoreRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScoreRunClient) DeleteOne(_m *ScoreRun) *ScoreRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScoreRunClient) DeleteOneID(id int64) *ScoreRunDeleteOne {
+	builder := c.Delete().Where(scorerun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScoreRunDeleteOne{builder}
+}
+
+// Query returns a query builder for ScoreRun.
+func (c *ScoreRunClient) Query() *ScoreRunQuery {
+	return &ScoreRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScoreRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScoreRun entity by its id.
+func (c *ScoreRunClient) Get(ctx context.Context, id int64) (*ScoreRun, error) {
+	return c.Query().Where(scorerun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScoreRunClient) GetX(ctx context.Context, id int64) *ScoreRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryResume queries the resume edge of a ScoreRun.
+func (c *ScoreRunClient) QueryResume(_m *ScoreRun) *ResumeQuery {
+	query := (&ResumeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scorerun.Table, scorerun.FieldID, id),
+			sqlgraph.To(resume.Table, resume.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scorerun.ResumeTable, scorerun.ResumeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModules queries the modules edge of a ScoreRun.
+func (c *ScoreRunClient) QueryModules(_m *ScoreRun) *ScoreHistoryQuery {
+	query := (&ScoreHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scorerun.Table, scorerun.FieldID, id),
+			sqlgraph.To(scorehistory.Table, scorehistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scorerun.ModulesTable, scorerun.ModulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScoreRunClient) Hooks() []Hook {
+	return c.hooks.ScoreRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScoreRunClient) Interceptors() []Interceptor {
+	return c.inters.ScoreRun
+}
+
+func (c *ScoreRunClient) mutate(ctx context.Context, m *ScoreRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScoreRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScoreRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScoreRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScoreRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScoreRun mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
 		AIRecord, City, Dictionary, Dimension, Module, Position, Resume, ResumeScore,
-		ResumeSlot []ent.Hook
+		ResumeShare, ResumeShareView, ResumeSlot, ScoreHistory, ScoreRun []ent.Hook
 	}
 	inters struct {
 		AIRecord, City, Dictionary, Dimension, Module, Position, Resume, ResumeScore,
-		ResumeSlot []ent.Interceptor
+		ResumeShare, ResumeShareView, ResumeSlot, ScoreHistory,
+		ScoreRun []ent.Interceptor
 	}
 )
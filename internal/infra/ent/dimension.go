@@ -20,20 +20,22 @@ type Dimension struct {
 	// ID of the ent.
 	// 维度ID
 	ID int64 `json:"id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// 所属模块ID
 	ModuleID int64 `json:"module_id,omitempty"`
 	// 描述
 	Description string `json:"description,omitempty"`
 	// 显示标题
 	Title string `json:"title,omitempty"`
-	// 维度得分详情（包括detail, score, weight的数组）
+	// 维度得分详情（包括detail, score的数组，最高的 score 对应满分）
 	Judgment []map[string]interface{} `json:"judgment,omitempty"`
+	// 维度权重（模块得分为各维度得分按权重的加权平均）
+	Weight float64 `json:"weight,omitempty"`
 	// 创建时间
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新时间
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// 删除时间
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DimensionQuery when eager-loading is set.
 	Edges        DimensionEdges `json:"edges"`
@@ -67,11 +69,13 @@ func (*Dimension) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case dimension.FieldJudgment:
 			values[i] = new([]byte)
+		case dimension.FieldWeight:
+			values[i] = new(sql.NullFloat64)
 		case dimension.FieldID, dimension.FieldModuleID:
 			values[i] = new(sql.NullInt64)
 		case dimension.FieldDescription, dimension.FieldTitle:
 			values[i] = new(sql.NullString)
-		case dimension.FieldCreatedAt, dimension.FieldUpdatedAt, dimension.FieldDeletedAt:
+		case dimension.FieldDeletedAt, dimension.FieldCreatedAt, dimension.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -94,6 +98,13 @@ func (_m *Dimension) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int64(value.Int64)
+		case dimension.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case dimension.FieldModuleID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field module_id", values[i])
@@ -120,6 +131,12 @@ func (_m *Dimension) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field judgment: %w", err)
 				}
 			}
+		case dimension.FieldWeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				_m.Weight = value.Float64
+			}
 		case dimension.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -132,13 +149,6 @@ func (_m *Dimension) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
-		case dimension.FieldDeletedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
-			} else if value.Valid {
-				_m.DeletedAt = new(time.Time)
-				*_m.DeletedAt = value.Time
-			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -180,6 +190,11 @@ func (_m *Dimension) String() string {
 	var builder strings.Builder
 	builder.WriteString("Dimension(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("module_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ModuleID))
 	builder.WriteString(", ")
@@ -192,16 +207,14 @@ func (_m *Dimension) String() string {
 	builder.WriteString("judgment=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Judgment))
 	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weight))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
-	if v := _m.DeletedAt; v != nil {
-		builder.WriteString("deleted_at=")
-		builder.WriteString(v.Format(time.ANSIC))
-	}
 	builder.WriteByte(')')
 	return builder.String()
 }
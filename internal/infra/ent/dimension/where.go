@@ -55,6 +55,11 @@ func IDLTE(id int64) predicate.Dimension {
 	return predicate.Dimension(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Dimension {
+	return predicate.Dimension(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // ModuleID applies equality check predicate on the "module_id" field. It's identical to ModuleIDEQ.
 func ModuleID(v int64) predicate.Dimension {
 	return predicate.Dimension(sql.FieldEQ(FieldModuleID, v))
@@ -70,6 +75,11 @@ func Title(v string) predicate.Dimension {
 	return predicate.Dimension(sql.FieldEQ(FieldTitle, v))
 }
 
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v float64) predicate.Dimension {
+	return predicate.Dimension(sql.FieldEQ(FieldWeight, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Dimension {
 	return predicate.Dimension(sql.FieldEQ(FieldCreatedAt, v))
@@ -80,11 +90,56 @@ func UpdatedAt(v time.Time) predicate.Dimension {
 	return predicate.Dimension(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
-// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
-func DeletedAt(v time.Time) predicate.Dimension {
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Dimension {
 	return predicate.Dimension(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Dimension {
+	return predicate.Dimension(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Dimension {
+	return predicate.Dimension(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Dimension {
+	return predicate.Dimension(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Dimension {
+	return predicate.Dimension(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Dimension {
+	return predicate.Dimension(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Dimension {
+	return predicate.Dimension(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Dimension {
+	return predicate.Dimension(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Dimension {
+	return predicate.Dimension(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Dimension {
+	return predicate.Dimension(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // ModuleIDEQ applies the EQ predicate on the "module_id" field.
 func ModuleIDEQ(v int64) predicate.Dimension {
 	return predicate.Dimension(sql.FieldEQ(FieldModuleID, v))
@@ -245,6 +300,46 @@ func JudgmentNotNil() predicate.Dimension {
 	return predicate.Dimension(sql.FieldNotNull(FieldJudgment))
 }
 
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v float64) predicate.Dimension {
+	return predicate.Dimension(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v float64) predicate.Dimension {
+	return predicate.Dimension(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...float64) predicate.Dimension {
+	return predicate.Dimension(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...float64) predicate.Dimension {
+	return predicate.Dimension(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v float64) predicate.Dimension {
+	return predicate.Dimension(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v float64) predicate.Dimension {
+	return predicate.Dimension(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v float64) predicate.Dimension {
+	return predicate.Dimension(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v float64) predicate.Dimension {
+	return predicate.Dimension(sql.FieldLTE(FieldWeight, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Dimension {
 	return predicate.Dimension(sql.FieldEQ(FieldCreatedAt, v))
@@ -325,56 +420,6 @@ func UpdatedAtLTE(v time.Time) predicate.Dimension {
 	return predicate.Dimension(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
-// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
-func DeletedAtEQ(v time.Time) predicate.Dimension {
-	return predicate.Dimension(sql.FieldEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
-func DeletedAtNEQ(v time.Time) predicate.Dimension {
-	return predicate.Dimension(sql.FieldNEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtIn applies the In predicate on the "deleted_at" field.
-func DeletedAtIn(vs ...time.Time) predicate.Dimension {
-	return predicate.Dimension(sql.FieldIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
-func DeletedAtNotIn(vs ...time.Time) predicate.Dimension {
-	return predicate.Dimension(sql.FieldNotIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtGT applies the GT predicate on the "deleted_at" field.
-func DeletedAtGT(v time.Time) predicate.Dimension {
-	return predicate.Dimension(sql.FieldGT(FieldDeletedAt, v))
-}
-
-// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
-func DeletedAtGTE(v time.Time) predicate.Dimension {
-	return predicate.Dimension(sql.FieldGTE(FieldDeletedAt, v))
-}
-
-// DeletedAtLT applies the LT predicate on the "deleted_at" field.
-func DeletedAtLT(v time.Time) predicate.Dimension {
-	return predicate.Dimension(sql.FieldLT(FieldDeletedAt, v))
-}
-
-// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
-func DeletedAtLTE(v time.Time) predicate.Dimension {
-	return predicate.Dimension(sql.FieldLTE(FieldDeletedAt, v))
-}
-
-// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
-func DeletedAtIsNil() predicate.Dimension {
-	return predicate.Dimension(sql.FieldIsNull(FieldDeletedAt))
-}
-
-// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
-func DeletedAtNotNil() predicate.Dimension {
-	return predicate.Dimension(sql.FieldNotNull(FieldDeletedAt))
-}
-
 // HasModule applies the HasEdge predicate on the "module" edge.
 func HasModule() predicate.Dimension {
 	return predicate.Dimension(func(s *sql.Selector) {
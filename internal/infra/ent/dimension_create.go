@@ -21,6 +21,20 @@ type DimensionCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *DimensionCreate) SetDeletedAt(v time.Time) *DimensionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *DimensionCreate) SetNillableDeletedAt(v *time.Time) *DimensionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetModuleID sets the "module_id" field.
 func (_c *DimensionCreate) SetModuleID(v int64) *DimensionCreate {
 	_c.mutation.SetModuleID(v)
@@ -45,6 +59,20 @@ func (_c *DimensionCreate) SetJudgment(v []map[string]interface{}) *DimensionCre
 	return _c
 }
 
+// SetWeight sets the "weight" field.
+func (_c *DimensionCreate) SetWeight(v float64) *DimensionCreate {
+	_c.mutation.SetWeight(v)
+	return _c
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_c *DimensionCreate) SetNillableWeight(v *float64) *DimensionCreate {
+	if v != nil {
+		_c.SetWeight(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *DimensionCreate) SetCreatedAt(v time.Time) *DimensionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -73,20 +101,6 @@ func (_c *DimensionCreate) SetNillableUpdatedAt(v *time.Time) *DimensionCreate {
 	return _c
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_c *DimensionCreate) SetDeletedAt(v time.Time) *DimensionCreate {
-	_c.mutation.SetDeletedAt(v)
-	return _c
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_c *DimensionCreate) SetNillableDeletedAt(v *time.Time) *DimensionCreate {
-	if v != nil {
-		_c.SetDeletedAt(*v)
-	}
-	return _c
-}
-
 // SetID sets the "id" field.
 func (_c *DimensionCreate) SetID(v int64) *DimensionCreate {
 	_c.mutation.SetID(v)
@@ -113,7 +127,9 @@ func (_c *DimensionCreate) Mutation() *DimensionMutation {
 
 // Save creates the Dimension in the database.
 func (_c *DimensionCreate) Save(ctx context.Context) (*Dimension, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -140,19 +156,33 @@ func (_c *DimensionCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *DimensionCreate) defaults() {
+func (_c *DimensionCreate) defaults() error {
+	if _, ok := _c.mutation.Weight(); !ok {
+		v := dimension.DefaultWeight
+		_c.mutation.SetWeight(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if dimension.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dimension.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := dimension.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if dimension.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dimension.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := dimension.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if dimension.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized dimension.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := dimension.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -171,6 +201,14 @@ func (_c *DimensionCreate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Dimension.title": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "Dimension.weight"`)}
+	}
+	if v, ok := _c.mutation.Weight(); ok {
+		if err := dimension.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "Dimension.weight": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Dimension.created_at"`)}
 	}
@@ -212,6 +250,10 @@ func (_c *DimensionCreate) createSpec() (*Dimension, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(dimension.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.Description(); ok {
 		_spec.SetField(dimension.FieldDescription, field.TypeString, value)
 		_node.Description = value
@@ -224,6 +266,10 @@ func (_c *DimensionCreate) createSpec() (*Dimension, *sqlgraph.CreateSpec) {
 		_spec.SetField(dimension.FieldJudgment, field.TypeJSON, value)
 		_node.Judgment = value
 	}
+	if value, ok := _c.mutation.Weight(); ok {
+		_spec.SetField(dimension.FieldWeight, field.TypeFloat64, value)
+		_node.Weight = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(dimension.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -232,10 +278,6 @@ func (_c *DimensionCreate) createSpec() (*Dimension, *sqlgraph.CreateSpec) {
 		_spec.SetField(dimension.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := _c.mutation.DeletedAt(); ok {
-		_spec.SetField(dimension.FieldDeletedAt, field.TypeTime, value)
-		_node.DeletedAt = &value
-	}
 	if nodes := _c.mutation.ModuleIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
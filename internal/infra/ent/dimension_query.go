@@ -298,12 +298,12 @@ func (_q *DimensionQuery) WithModule(opts ...func(*ModuleQuery)) *DimensionQuery
 // Example:
 //
 //	var v []struct {
-//		ModuleID int64 `json:"module_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Dimension.Query().
-//		GroupBy(dimension.FieldModuleID).
+//		GroupBy(dimension.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *DimensionQuery) GroupBy(field string, fields ...string) *DimensionGroupBy {
@@ -321,11 +321,11 @@ func (_q *DimensionQuery) GroupBy(field string, fields ...string) *DimensionGrou
 // Example:
 //
 //	var v []struct {
-//		ModuleID int64 `json:"module_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Dimension.Query().
-//		Select(dimension.FieldModuleID).
+//		Select(dimension.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (_q *DimensionQuery) Select(fields ...string) *DimensionSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
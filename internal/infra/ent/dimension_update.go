@@ -30,6 +30,26 @@ func (_u *DimensionUpdate) Where(ps ...predicate.Dimension) *DimensionUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *DimensionUpdate) SetDeletedAt(v time.Time) *DimensionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *DimensionUpdate) SetNillableDeletedAt(v *time.Time) *DimensionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *DimensionUpdate) ClearDeletedAt() *DimensionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetModuleID sets the "module_id" field.
 func (_u *DimensionUpdate) SetModuleID(v int64) *DimensionUpdate {
 	_u.mutation.SetModuleID(v)
@@ -90,29 +110,30 @@ func (_u *DimensionUpdate) ClearJudgment() *DimensionUpdate {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *DimensionUpdate) SetUpdatedAt(v time.Time) *DimensionUpdate {
-	_u.mutation.SetUpdatedAt(v)
+// SetWeight sets the "weight" field.
+func (_u *DimensionUpdate) SetWeight(v float64) *DimensionUpdate {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *DimensionUpdate) SetDeletedAt(v time.Time) *DimensionUpdate {
-	_u.mutation.SetDeletedAt(v)
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *DimensionUpdate) SetNillableWeight(v *float64) *DimensionUpdate {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *DimensionUpdate) SetNillableDeletedAt(v *time.Time) *DimensionUpdate {
-	if v != nil {
-		_u.SetDeletedAt(*v)
-	}
+// AddWeight adds value to the "weight" field.
+func (_u *DimensionUpdate) AddWeight(v float64) *DimensionUpdate {
+	_u.mutation.AddWeight(v)
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *DimensionUpdate) ClearDeletedAt() *DimensionUpdate {
-	_u.mutation.ClearDeletedAt()
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DimensionUpdate) SetUpdatedAt(v time.Time) *DimensionUpdate {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
@@ -134,7 +155,9 @@ func (_u *DimensionUpdate) ClearModule() *DimensionUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DimensionUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -161,11 +184,15 @@ func (_u *DimensionUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DimensionUpdate) defaults() {
+func (_u *DimensionUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if dimension.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dimension.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := dimension.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -175,6 +202,11 @@ func (_u *DimensionUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Dimension.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Weight(); ok {
+		if err := dimension.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "Dimension.weight": %w`, err)}
+		}
+	}
 	if _u.mutation.ModuleCleared() && len(_u.mutation.ModuleIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Dimension.module"`)
 	}
@@ -193,6 +225,12 @@ func (_u *DimensionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(dimension.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(dimension.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(dimension.FieldDescription, field.TypeString, value)
 	}
@@ -210,14 +248,14 @@ func (_u *DimensionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.JudgmentCleared() {
 		_spec.ClearField(dimension.FieldJudgment, field.TypeJSON)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(dimension.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(dimension.FieldWeight, field.TypeFloat64, value)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(dimension.FieldDeletedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(dimension.FieldWeight, field.TypeFloat64, value)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(dimension.FieldDeletedAt, field.TypeTime)
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(dimension.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if _u.mutation.ModuleCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -268,6 +306,26 @@ type DimensionUpdateOne struct {
 	mutation *DimensionMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *DimensionUpdateOne) SetDeletedAt(v time.Time) *DimensionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *DimensionUpdateOne) SetNillableDeletedAt(v *time.Time) *DimensionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *DimensionUpdateOne) ClearDeletedAt() *DimensionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetModuleID sets the "module_id" field.
 func (_u *DimensionUpdateOne) SetModuleID(v int64) *DimensionUpdateOne {
 	_u.mutation.SetModuleID(v)
@@ -328,29 +386,30 @@ func (_u *DimensionUpdateOne) ClearJudgment() *DimensionUpdateOne {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *DimensionUpdateOne) SetUpdatedAt(v time.Time) *DimensionUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// SetWeight sets the "weight" field.
+func (_u *DimensionUpdateOne) SetWeight(v float64) *DimensionUpdateOne {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *DimensionUpdateOne) SetDeletedAt(v time.Time) *DimensionUpdateOne {
-	_u.mutation.SetDeletedAt(v)
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *DimensionUpdateOne) SetNillableWeight(v *float64) *DimensionUpdateOne {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *DimensionUpdateOne) SetNillableDeletedAt(v *time.Time) *DimensionUpdateOne {
-	if v != nil {
-		_u.SetDeletedAt(*v)
-	}
+// AddWeight adds value to the "weight" field.
+func (_u *DimensionUpdateOne) AddWeight(v float64) *DimensionUpdateOne {
+	_u.mutation.AddWeight(v)
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *DimensionUpdateOne) ClearDeletedAt() *DimensionUpdateOne {
-	_u.mutation.ClearDeletedAt()
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DimensionUpdateOne) SetUpdatedAt(v time.Time) *DimensionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
@@ -385,7 +444,9 @@ func (_u *DimensionUpdateOne) Select(field string, fields ...string) *DimensionU
 
 // Save executes the query and returns the updated Dimension entity.
 func (_u *DimensionUpdateOne) Save(ctx context.Context) (*Dimension, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -412,11 +473,15 @@ func (_u *DimensionUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DimensionUpdateOne) defaults() {
+func (_u *DimensionUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if dimension.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dimension.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := dimension.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -426,6 +491,11 @@ func (_u *DimensionUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Dimension.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Weight(); ok {
+		if err := dimension.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "Dimension.weight": %w`, err)}
+		}
+	}
 	if _u.mutation.ModuleCleared() && len(_u.mutation.ModuleIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Dimension.module"`)
 	}
@@ -461,6 +531,12 @@ func (_u *DimensionUpdateOne) sqlSave(ctx context.Context) (_node *Dimension, er
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(dimension.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(dimension.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(dimension.FieldDescription, field.TypeString, value)
 	}
@@ -478,14 +554,14 @@ func (_u *DimensionUpdateOne) sqlSave(ctx context.Context) (_node *Dimension, er
 	if _u.mutation.JudgmentCleared() {
 		_spec.ClearField(dimension.FieldJudgment, field.TypeJSON)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(dimension.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(dimension.FieldWeight, field.TypeFloat64, value)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(dimension.FieldDeletedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(dimension.FieldWeight, field.TypeFloat64, value)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(dimension.FieldDeletedAt, field.TypeTime)
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(dimension.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if _u.mutation.ModuleCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -12,7 +12,11 @@ import (
 	"cv2/internal/infra/ent/position"
 	"cv2/internal/infra/ent/resume"
 	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
 	"cv2/internal/infra/ent/resumeslot"
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
 	"errors"
 	"fmt"
 	"reflect"
@@ -81,15 +85,19 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			airecord.Table:    airecord.ValidColumn,
-			city.Table:        city.ValidColumn,
-			dictionary.Table:  dictionary.ValidColumn,
-			dimension.Table:   dimension.ValidColumn,
-			module.Table:      module.ValidColumn,
-			position.Table:    position.ValidColumn,
-			resume.Table:      resume.ValidColumn,
-			resumescore.Table: resumescore.ValidColumn,
-			resumeslot.Table:  resumeslot.ValidColumn,
+			airecord.Table:        airecord.ValidColumn,
+			city.Table:            city.ValidColumn,
+			dictionary.Table:      dictionary.ValidColumn,
+			dimension.Table:       dimension.ValidColumn,
+			module.Table:          module.ValidColumn,
+			position.Table:        position.ValidColumn,
+			resume.Table:          resume.ValidColumn,
+			resumescore.Table:     resumescore.ValidColumn,
+			resumeshare.Table:     resumeshare.ValidColumn,
+			resumeshareview.Table: resumeshareview.ValidColumn,
+			resumeslot.Table:      resumeslot.ValidColumn,
+			scorehistory.Table:    scorehistory.ValidColumn,
+			scorerun.Table:        scorerun.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
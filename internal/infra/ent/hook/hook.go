@@ -104,6 +104,30 @@ func (f ResumeScoreFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ResumeScoreMutation", m)
 }
 
+// The ResumeShareFunc type is an adapter to allow the use of ordinary
+// function as ResumeShare mutator.
+type ResumeShareFunc func(context.Context, *ent.ResumeShareMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResumeShareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ResumeShareMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ResumeShareMutation", m)
+}
+
+// The ResumeShareViewFunc type is an adapter to allow the use of ordinary
+// function as ResumeShareView mutator.
+type ResumeShareViewFunc func(context.Context, *ent.ResumeShareViewMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResumeShareViewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ResumeShareViewMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ResumeShareViewMutation", m)
+}
+
 // The ResumeSlotFunc type is an adapter to allow the use of ordinary
 // function as ResumeSlot mutator.
 type ResumeSlotFunc func(context.Context, *ent.ResumeSlotMutation) (ent.Value, error)
@@ -116,6 +140,30 @@ func (f ResumeSlotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ResumeSlotMutation", m)
 }
 
+// The ScoreHistoryFunc type is an adapter to allow the use of ordinary
+// function as ScoreHistory mutator.
+type ScoreHistoryFunc func(context.Context, *ent.ScoreHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScoreHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScoreHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScoreHistoryMutation", m)
+}
+
+// The ScoreRunFunc type is an adapter to allow the use of ordinary
+// function as ScoreRun mutator.
+type ScoreRunFunc func(context.Context, *ent.ScoreRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScoreRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScoreRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScoreRunMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,510 @@
+// Code generated by ent, DO NOT EDIT.
+
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/airecord"
+	"cv2/internal/infra/ent/city"
+	"cv2/internal/infra/ent/dictionary"
+	"cv2/internal/infra/ent/dimension"
+	"cv2/internal/infra/ent/module"
+	"cv2/internal/infra/ent/position"
+	"cv2/internal/infra/ent/predicate"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
+	"cv2/internal/infra/ent/resumeslot"
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// The Query interface represents an operation that queries a graph.
+// By using this interface, users can write generic code that manipulates
+// query builders of different types.
+type Query interface {
+	// Type returns the string representation of the query type.
+	Type() string
+	// Limit the number of records to be returned by this query.
+	Limit(int)
+	// Offset to start from.
+	Offset(int)
+	// Unique configures the query builder to filter duplicate records.
+	Unique(bool)
+	// Order specifies how the records should be ordered.
+	Order(...func(*sql.Selector))
+	// WhereP appends storage-level predicates to the query builder. Using this method, users
+	// can use type-assertion to append predicates that do not depend on any generated package.
+	WhereP(...func(*sql.Selector))
+}
+
+// The Func type is an adapter that allows ordinary functions to be used as interceptors.
+// Unlike traversal functions, interceptors are skipped during graph traversals. Note that the
+// implementation of Func is different from the one defined in entgo.io/ent.InterceptFunc.
+type Func func(context.Context, Query) error
+
+// Intercept calls f(ctx, q) and then applied the next Querier.
+func (f Func) Intercept(next ent.Querier) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		query, err := NewQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		if err := f(ctx, query); err != nil {
+			return nil, err
+		}
+		return next.Query(ctx, q)
+	})
+}
+
+// The TraverseFunc type is an adapter to allow the use of ordinary function as Traverser.
+// If f is a function with the appropriate signature, TraverseFunc(f) is a Traverser that calls f.
+type TraverseFunc func(context.Context, Query) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFunc) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
+	query, err := NewQuery(q)
+	if err != nil {
+		return err
+	}
+	return f(ctx, query)
+}
+
+// The AIRecordFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AIRecordFunc func(context.Context, *ent.AIRecordQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AIRecordFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AIRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AIRecordQuery", q)
+}
+
+// The TraverseAIRecord type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAIRecord func(context.Context, *ent.AIRecordQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAIRecord) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAIRecord) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AIRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AIRecordQuery", q)
+}
+
+// The CityFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CityFunc func(context.Context, *ent.CityQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CityFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CityQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CityQuery", q)
+}
+
+// The TraverseCity type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCity func(context.Context, *ent.CityQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCity) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCity) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CityQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CityQuery", q)
+}
+
+// The DictionaryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DictionaryFunc func(context.Context, *ent.DictionaryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DictionaryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DictionaryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DictionaryQuery", q)
+}
+
+// The TraverseDictionary type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDictionary func(context.Context, *ent.DictionaryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDictionary) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDictionary) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DictionaryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DictionaryQuery", q)
+}
+
+// The DimensionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DimensionFunc func(context.Context, *ent.DimensionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DimensionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DimensionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DimensionQuery", q)
+}
+
+// The TraverseDimension type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDimension func(context.Context, *ent.DimensionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDimension) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDimension) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DimensionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DimensionQuery", q)
+}
+
+// The ModuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ModuleFunc func(context.Context, *ent.ModuleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ModuleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ModuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ModuleQuery", q)
+}
+
+// The TraverseModule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseModule func(context.Context, *ent.ModuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseModule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseModule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ModuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ModuleQuery", q)
+}
+
+// The PositionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PositionFunc func(context.Context, *ent.PositionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PositionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PositionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PositionQuery", q)
+}
+
+// The TraversePosition type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePosition func(context.Context, *ent.PositionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePosition) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePosition) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PositionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PositionQuery", q)
+}
+
+// The ResumeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResumeFunc func(context.Context, *ent.ResumeQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResumeFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ResumeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ResumeQuery", q)
+}
+
+// The TraverseResume type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResume func(context.Context, *ent.ResumeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResume) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResume) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ResumeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ResumeQuery", q)
+}
+
+// The ResumeScoreFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResumeScoreFunc func(context.Context, *ent.ResumeScoreQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResumeScoreFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ResumeScoreQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ResumeScoreQuery", q)
+}
+
+// The TraverseResumeScore type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResumeScore func(context.Context, *ent.ResumeScoreQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResumeScore) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResumeScore) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ResumeScoreQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ResumeScoreQuery", q)
+}
+
+// The ResumeShareFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResumeShareFunc func(context.Context, *ent.ResumeShareQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResumeShareFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ResumeShareQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ResumeShareQuery", q)
+}
+
+// The TraverseResumeShare type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResumeShare func(context.Context, *ent.ResumeShareQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResumeShare) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResumeShare) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ResumeShareQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ResumeShareQuery", q)
+}
+
+// The ResumeShareViewFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResumeShareViewFunc func(context.Context, *ent.ResumeShareViewQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResumeShareViewFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ResumeShareViewQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ResumeShareViewQuery", q)
+}
+
+// The TraverseResumeShareView type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResumeShareView func(context.Context, *ent.ResumeShareViewQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResumeShareView) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResumeShareView) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ResumeShareViewQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ResumeShareViewQuery", q)
+}
+
+// The ResumeSlotFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResumeSlotFunc func(context.Context, *ent.ResumeSlotQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResumeSlotFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ResumeSlotQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ResumeSlotQuery", q)
+}
+
+// The TraverseResumeSlot type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResumeSlot func(context.Context, *ent.ResumeSlotQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResumeSlot) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResumeSlot) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ResumeSlotQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ResumeSlotQuery", q)
+}
+
+// The ScoreHistoryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScoreHistoryFunc func(context.Context, *ent.ScoreHistoryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScoreHistoryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ScoreHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ScoreHistoryQuery", q)
+}
+
+// The TraverseScoreHistory type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScoreHistory func(context.Context, *ent.ScoreHistoryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScoreHistory) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScoreHistory) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ScoreHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ScoreHistoryQuery", q)
+}
+
+// The ScoreRunFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScoreRunFunc func(context.Context, *ent.ScoreRunQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScoreRunFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ScoreRunQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ScoreRunQuery", q)
+}
+
+// The TraverseScoreRun type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScoreRun func(context.Context, *ent.ScoreRunQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScoreRun) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScoreRun) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ScoreRunQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ScoreRunQuery", q)
+}
+
+// NewQuery returns the generic Query interface for the given typed query.
+func NewQuery(q ent.Query) (Query, error) {
+	switch q := q.(type) {
+	case *ent.AIRecordQuery:
+		return &query[*ent.AIRecordQuery, predicate.AIRecord, airecord.OrderOption]{typ: ent.TypeAIRecord, tq: q}, nil
+	case *ent.CityQuery:
+		return &query[*ent.CityQuery, predicate.City, city.OrderOption]{typ: ent.TypeCity, tq: q}, nil
+	case *ent.DictionaryQuery:
+		return &query[*ent.DictionaryQuery, predicate.Dictionary, dictionary.OrderOption]{typ: ent.TypeDictionary, tq: q}, nil
+	case *ent.DimensionQuery:
+		return &query[*ent.DimensionQuery, predicate.Dimension, dimension.OrderOption]{typ: ent.TypeDimension, tq: q}, nil
+	case *ent.ModuleQuery:
+		return &query[*ent.ModuleQuery, predicate.Module, module.OrderOption]{typ: ent.TypeModule, tq: q}, nil
+	case *ent.PositionQuery:
+		return &query[*ent.PositionQuery, predicate.Position, position.OrderOption]{typ: ent.TypePosition, tq: q}, nil
+	case *ent.ResumeQuery:
+		return &query[*ent.ResumeQuery, predicate.Resume, resume.OrderOption]{typ: ent.TypeResume, tq: q}, nil
+	case *ent.ResumeScoreQuery:
+		return &query[*ent.ResumeScoreQuery, predicate.ResumeScore, resumescore.OrderOption]{typ: ent.TypeResumeScore, tq: q}, nil
+	case *ent.ResumeShareQuery:
+		return &query[*ent.ResumeShareQuery, predicate.ResumeShare, resumeshare.OrderOption]{typ: ent.TypeResumeShare, tq: q}, nil
+	case *ent.ResumeShareViewQuery:
+		return &query[*ent.ResumeShareViewQuery, predicate.ResumeShareView, resumeshareview.OrderOption]{typ: ent.TypeResumeShareView, tq: q}, nil
+	case *ent.ResumeSlotQuery:
+		return &query[*ent.ResumeSlotQuery, predicate.ResumeSlot, resumeslot.OrderOption]{typ: ent.TypeResumeSlot, tq: q}, nil
+	case *ent.ScoreHistoryQuery:
+		return &query[*ent.ScoreHistoryQuery, predicate.ScoreHistory, scorehistory.OrderOption]{typ: ent.TypeScoreHistory, tq: q}, nil
+	case *ent.ScoreRunQuery:
+		return &query[*ent.ScoreRunQuery, predicate.ScoreRun, scorerun.OrderOption]{typ: ent.TypeScoreRun, tq: q}, nil
+	default:
+		return nil, fmt.Errorf("unknown query type %T", q)
+	}
+}
+
+type query[T any, P ~func(*sql.Selector), R ~func(*sql.Selector)] struct {
+	typ string
+	tq  interface {
+		Limit(int) T
+		Offset(int) T
+		Unique(bool) T
+		Order(...R) T
+		Where(...P) T
+	}
+}
+
+func (q query[T, P, R]) Type() string {
+	return q.typ
+}
+
+func (q query[T, P, R]) Limit(limit int) {
+	q.tq.Limit(limit)
+}
+
+func (q query[T, P, R]) Offset(offset int) {
+	q.tq.Offset(offset)
+}
+
+func (q query[T, P, R]) Unique(unique bool) {
+	q.tq.Unique(unique)
+}
+
+func (q query[T, P, R]) Order(orders ...func(*sql.Selector)) {
+	rs := make([]R, len(orders))
+	for i := range orders {
+		rs[i] = orders[i]
+	}
+	q.tq.Order(rs...)
+}
+
+func (q query[T, P, R]) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]P, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	q.tq.Where(p...)
+}
@@ -95,12 +95,13 @@ var (
 	// CvDimensionColumns holds the columns for the "cv_dimension" table.
 	CvDimensionColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "维度ID"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "description", Type: field.TypeString, Comment: "描述"},
 		{Name: "title", Type: field.TypeString, Comment: "显示标题"},
-		{Name: "judgment", Type: field.TypeJSON, Nullable: true, Comment: "维度得分详情（包括detail, score, weight的数组）"},
+		{Name: "judgment", Type: field.TypeJSON, Nullable: true, Comment: "维度得分详情（包括detail, score的数组，最高的 score 对应满分）"},
+		{Name: "weight", Type: field.TypeFloat64, Comment: "维度权重（模块得分为各维度得分按权重的加权平均）", Default: 1},
 		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
-		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "module_id", Type: field.TypeInt64, Comment: "所属模块ID"},
 	}
 	// CvDimensionTable holds the schema information for the "cv_dimension" table.
@@ -111,7 +112,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "cv_dimension_cv_module_dimensions",
-				Columns:    []*schema.Column{CvDimensionColumns[7]},
+				Columns:    []*schema.Column{CvDimensionColumns[8]},
 				RefColumns: []*schema.Column{CvModuleColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -120,18 +121,22 @@ var (
 			{
 				Name:    "dimension_module_id",
 				Unique:  false,
-				Columns: []*schema.Column{CvDimensionColumns[7]},
+				Columns: []*schema.Column{CvDimensionColumns[8]},
 			},
 		},
 	}
 	// CvModuleColumns holds the columns for the "cv_module" table.
 	CvModuleColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "模块ID"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "code", Type: field.TypeString, Unique: true, Nullable: true, Comment: "模块编码（稳定标识，代码中按编码识别模块，不依赖标题）"},
 		{Name: "title", Type: field.TypeString, Comment: "显示标题"},
+		{Name: "shape", Type: field.TypeEnum, Comment: "数据形态: object=单个对象, list=条目列表, text=纯文本", Enums: []string{"object", "list", "text"}, Default: "list"},
 		{Name: "description", Type: field.TypeString, Comment: "模块描述", Default: ""},
+		{Name: "weight", Type: field.TypeFloat64, Comment: "模块权重（简历总分为各模块得分按权重的加权平均）", Default: 1},
+		{Name: "data_schema", Type: field.TypeJSON, Nullable: true, Comment: "模块数据的 JSON Schema（校验保存的 data 数组）"},
 		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
-		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 	}
 	// CvModuleTable holds the schema information for the "cv_module" table.
 	CvModuleTable = &schema.Table{
@@ -163,15 +168,19 @@ var (
 	// CvResumeColumns holds the columns for the "cv_resume" table.
 	CvResumeColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "简历ID"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "user_id", Type: field.TypeInt64, Comment: "用户ID"},
-		{Name: "tenant_id", Type: field.TypeInt64, Comment: "租户ID"},
+		{Name: "tenant_id", Type: field.TypeString, Comment: "租户ID"},
 		{Name: "file_path", Type: field.TypeString, Comment: "文件路径"},
+		{Name: "source_file_path", Type: field.TypeString, Nullable: true, Comment: "原始文件路径（用户上传或首次生成的文件），重新渲染后 file_path 指向渲染文件，彻底删除时按此清理原始文件", Default: ""},
 		{Name: "file_name", Type: field.TypeString, Comment: "文件名"},
 		{Name: "status", Type: field.TypeInt32, Comment: "状态: 1=pending, 2=processing, 3=completed", Default: 1},
 		{Name: "cover_image", Type: field.TypeString, Nullable: true, Comment: "封面图URL", Default: ""},
+		{Name: "render_status", Type: field.TypeEnum, Comment: "PDF 渲染状态: pending=等待渲染, rendering=渲染中, done=已是最新, failed=渲染失败", Enums: []string{"pending", "rendering", "done", "failed"}, Default: "done"},
+		{Name: "rendered_revision", Type: field.TypeInt64, Comment: "file_path 对应的简历内容版本号", Default: 0},
+		{Name: "template", Type: field.TypeEnum, Comment: "PDF 模板: classic=经典, modern=现代, compact=紧凑单页", Enums: []string{"classic", "modern", "compact"}, Default: "classic"},
 		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
-		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 	}
 	// CvResumeTable holds the schema information for the "cv_resume" table.
 	CvResumeTable = &schema.Table{
@@ -182,25 +191,29 @@ var (
 			{
 				Name:    "resume_user_id_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{CvResumeColumns[1], CvResumeColumns[2]},
+				Columns: []*schema.Column{CvResumeColumns[2], CvResumeColumns[3]},
 			},
 			{
 				Name:    "resume_status",
 				Unique:  false,
-				Columns: []*schema.Column{CvResumeColumns[5]},
+				Columns: []*schema.Column{CvResumeColumns[7]},
 			},
 		},
 	}
 	// CvResumeScoreColumns holds the columns for the "cv_resume_score" table.
 	CvResumeScoreColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "得分ID"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "target_id", Type: field.TypeInt64, Comment: "关联的模块ID或维度ID"},
 		{Name: "target_type", Type: field.TypeInt32, Comment: "类型: 0=module, 1=dimension"},
+		{Name: "scope_id", Type: field.TypeInt64, Comment: "维度得分所属的自定义模块ID，内置模块为0", Default: 0},
 		{Name: "score", Type: field.TypeFloat64, Comment: "得分", Default: 0},
 		{Name: "weight", Type: field.TypeFloat64, Comment: "权重", Default: 0},
+		{Name: "state", Type: field.TypeEnum, Comment: "评分状态（模块得分）: pending=等待评分, done=已评分, failed=评分失败, stale=数据已修改但未重新评分", Enums: []string{"pending", "done", "failed", "stale"}, Default: "done"},
+		{Name: "revision", Type: field.TypeInt64, Comment: "请求评分时的简历内容版本号，用于丢弃过期的评分结果", Default: 0},
+		{Name: "scored", Type: field.TypeBool, Comment: "是否已有得分，首次评分完成前的占位记录为 false", Default: true},
 		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
-		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "resume_id", Type: field.TypeInt64, Comment: "关联简历ID"},
 	}
 	// CvResumeScoreTable holds the schema information for the "cv_resume_score" table.
@@ -211,7 +224,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "cv_resume_score_cv_resume_scores",
-				Columns:    []*schema.Column{CvResumeScoreColumns[8]},
+				Columns:    []*schema.Column{CvResumeScoreColumns[12]},
 				RefColumns: []*schema.Column{CvResumeColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -220,12 +233,91 @@ var (
 			{
 				Name:    "resumescore_resume_id_target_type_target_id",
 				Unique:  false,
-				Columns: []*schema.Column{CvResumeScoreColumns[8], CvResumeScoreColumns[2], CvResumeScoreColumns[1]},
+				Columns: []*schema.Column{CvResumeScoreColumns[12], CvResumeScoreColumns[3], CvResumeScoreColumns[2]},
 			},
 			{
 				Name:    "resumescore_target_type_target_id",
 				Unique:  false,
-				Columns: []*schema.Column{CvResumeScoreColumns[2], CvResumeScoreColumns[1]},
+				Columns: []*schema.Column{CvResumeScoreColumns[3], CvResumeScoreColumns[2]},
+			},
+		},
+	}
+	// CvResumeShareColumns holds the columns for the "cv_resume_share" table.
+	CvResumeShareColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "分享ID"},
+		{Name: "user_id", Type: field.TypeInt64, Comment: "创建人用户ID"},
+		{Name: "tenant_id", Type: field.TypeString, Comment: "租户ID"},
+		{Name: "token", Type: field.TypeString, Unique: true, Comment: "分享令牌"},
+		{Name: "permission", Type: field.TypeEnum, Comment: "权限: read=只能在线查看, download=可下载 PDF", Enums: []string{"read", "download"}, Default: "read"},
+		{Name: "redact", Type: field.TypeBool, Comment: "是否脱敏分享", Default: false},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true, Comment: "访问密码的 bcrypt 哈希，为空表示不需要密码", Default: ""},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空表示永不过期"},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true, Comment: "撤销时间"},
+		{Name: "view_count", Type: field.TypeInt64, Comment: "访问次数", Default: 0},
+		{Name: "last_viewed_at", Type: field.TypeTime, Nullable: true, Comment: "最近访问时间"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "resume_id", Type: field.TypeInt64, Comment: "关联简历ID"},
+	}
+	// CvResumeShareTable holds the schema information for the "cv_resume_share" table.
+	CvResumeShareTable = &schema.Table{
+		Name:       "cv_resume_share",
+		Columns:    CvResumeShareColumns,
+		PrimaryKey: []*schema.Column{CvResumeShareColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cv_resume_share_cv_resume_shares",
+				Columns:    []*schema.Column{CvResumeShareColumns[13]},
+				RefColumns: []*schema.Column{CvResumeColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resumeshare_resume_id",
+				Unique:  false,
+				Columns: []*schema.Column{CvResumeShareColumns[13]},
+			},
+			{
+				Name:    "resumeshare_user_id_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{CvResumeShareColumns[1], CvResumeShareColumns[2]},
+			},
+		},
+	}
+	// CvResumeShareViewColumns holds the columns for the "cv_resume_share_view" table.
+	CvResumeShareViewColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "访问记录ID"},
+		{Name: "resume_id", Type: field.TypeInt64, Comment: "简历ID（冗余，便于按简历清理）"},
+		{Name: "action", Type: field.TypeEnum, Comment: "操作: view=在线查看, download=下载 PDF", Enums: []string{"view", "download"}},
+		{Name: "referrer", Type: field.TypeString, Nullable: true, Size: 512, Comment: "来源页面（不含查询参数）", Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 64, Comment: "粗粒度客户端类型，如 Chrome/Windows", Default: ""},
+		{Name: "created_at", Type: field.TypeTime, Comment: "访问时间"},
+		{Name: "share_id", Type: field.TypeInt64, Comment: "关联分享ID"},
+	}
+	// CvResumeShareViewTable holds the schema information for the "cv_resume_share_view" table.
+	CvResumeShareViewTable = &schema.Table{
+		Name:       "cv_resume_share_view",
+		Columns:    CvResumeShareViewColumns,
+		PrimaryKey: []*schema.Column{CvResumeShareViewColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cv_resume_share_view_cv_resume_share_views",
+				Columns:    []*schema.Column{CvResumeShareViewColumns[6]},
+				RefColumns: []*schema.Column{CvResumeShareColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resumeshareview_share_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CvResumeShareViewColumns[6], CvResumeShareViewColumns[5]},
+			},
+			{
+				Name:    "resumeshareview_resume_id",
+				Unique:  false,
+				Columns: []*schema.Column{CvResumeShareViewColumns[1]},
 			},
 		},
 	}
@@ -251,6 +343,76 @@ var (
 			},
 		},
 	}
+	// CvScoreHistoryColumns holds the columns for the "cv_score_history" table.
+	CvScoreHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "得分快照ID"},
+		{Name: "resume_id", Type: field.TypeInt64, Comment: "简历ID（冗余，便于按简历查询和清理）"},
+		{Name: "module_id", Type: field.TypeInt64, Comment: "模块ID（自定义模块为自定义模块ID）"},
+		{Name: "title", Type: field.TypeString, Comment: "评分时的模块标题", Default: ""},
+		{Name: "score", Type: field.TypeFloat64, Comment: "模块得分", Default: 0},
+		{Name: "weight", Type: field.TypeFloat64, Comment: "模块权重", Default: 0},
+		{Name: "rescored", Type: field.TypeBool, Comment: "本次是否重新评分（否则沿用之前的得分）", Default: false},
+		{Name: "created_at", Type: field.TypeTime, Comment: "评分时间"},
+		{Name: "run_id", Type: field.TypeInt64, Comment: "关联评分记录ID"},
+	}
+	// CvScoreHistoryTable holds the schema information for the "cv_score_history" table.
+	CvScoreHistoryTable = &schema.Table{
+		Name:       "cv_score_history",
+		Columns:    CvScoreHistoryColumns,
+		PrimaryKey: []*schema.Column{CvScoreHistoryColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cv_score_history_cv_score_run_modules",
+				Columns:    []*schema.Column{CvScoreHistoryColumns[8]},
+				RefColumns: []*schema.Column{CvScoreRunColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scorehistory_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{CvScoreHistoryColumns[8]},
+			},
+			{
+				Name:    "scorehistory_resume_id_module_id",
+				Unique:  false,
+				Columns: []*schema.Column{CvScoreHistoryColumns[1], CvScoreHistoryColumns[2]},
+			},
+		},
+	}
+	// CvScoreRunColumns holds the columns for the "cv_score_run" table.
+	CvScoreRunColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "评分记录ID"},
+		{Name: "trigger", Type: field.TypeEnum, Comment: "触发方式: upload=上传/生成/导入, save=编辑保存, ai_apply=采纳 AI 帮写, rescore=重新评分（如回滚版本）", Enums: []string{"upload", "save", "ai_apply", "rescore"}},
+		{Name: "rule_version", Type: field.TypeString, Comment: "评分时的规则版本（模块权重和维度规则的指纹）", Default: ""},
+		{Name: "total_score", Type: field.TypeFloat64, Comment: "评分后的简历总分", Default: 0},
+		{Name: "status", Type: field.TypeEnum, Comment: "评分结果: success=全部成功, partial=部分模块失败, failed=全部失败", Enums: []string{"success", "partial", "failed"}, Default: "success"},
+		{Name: "failures", Type: field.TypeJSON, Nullable: true, Comment: "评分失败的模块（包括module_id, title, reason, error的数组）"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "评分时间"},
+		{Name: "resume_id", Type: field.TypeInt64, Comment: "关联简历ID"},
+	}
+	// CvScoreRunTable holds the schema information for the "cv_score_run" table.
+	CvScoreRunTable = &schema.Table{
+		Name:       "cv_score_run",
+		Columns:    CvScoreRunColumns,
+		PrimaryKey: []*schema.Column{CvScoreRunColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cv_score_run_cv_resume_score_runs",
+				Columns:    []*schema.Column{CvScoreRunColumns[7]},
+				RefColumns: []*schema.Column{CvResumeColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scorerun_resume_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CvScoreRunColumns[7], CvScoreRunColumns[6]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		CvAiRecordTable,
@@ -261,7 +423,11 @@ var (
 		RmPositionTable,
 		CvResumeTable,
 		CvResumeScoreTable,
+		CvResumeShareTable,
+		CvResumeShareViewTable,
 		CvResumeSlotTable,
+		CvScoreHistoryTable,
+		CvScoreRunTable,
 	}
 )
 
@@ -310,9 +476,33 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_unicode_ci",
 	}
+	CvResumeShareTable.ForeignKeys[0].RefTable = CvResumeTable
+	CvResumeShareTable.Annotation = &entsql.Annotation{
+		Table:     "cv_resume_share",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_unicode_ci",
+	}
+	CvResumeShareViewTable.ForeignKeys[0].RefTable = CvResumeShareTable
+	CvResumeShareViewTable.Annotation = &entsql.Annotation{
+		Table:     "cv_resume_share_view",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_unicode_ci",
+	}
 	CvResumeSlotTable.Annotation = &entsql.Annotation{
 		Table:     "cv_resume_slot",
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_unicode_ci",
 	}
+	CvScoreHistoryTable.ForeignKeys[0].RefTable = CvScoreRunTable
+	CvScoreHistoryTable.Annotation = &entsql.Annotation{
+		Table:     "cv_score_history",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_unicode_ci",
+	}
+	CvScoreRunTable.ForeignKeys[0].RefTable = CvResumeTable
+	CvScoreRunTable.Annotation = &entsql.Annotation{
+		Table:     "cv_score_run",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_unicode_ci",
+	}
 }
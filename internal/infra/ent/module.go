@@ -4,6 +4,7 @@ package ent
 
 import (
 	"cv2/internal/infra/ent/module"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -18,16 +19,24 @@ type Module struct {
 	// ID of the ent.
 	// 模块ID
 	ID int64 `json:"id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 模块编码（稳定标识，代码中按编码识别模块，不依赖标题）
+	Code *string `json:"code,omitempty"`
 	// 显示标题
 	Title string `json:"title,omitempty"`
+	// 数据形态: object=单个对象, list=条目列表, text=纯文本
+	Shape module.Shape `json:"shape,omitempty"`
 	// 模块描述
 	Description string `json:"description,omitempty"`
+	// 模块权重（简历总分为各模块得分按权重的加权平均）
+	Weight float64 `json:"weight,omitempty"`
+	// 模块数据的 JSON Schema（校验保存的 data 数组）
+	DataSchema map[string]interface{} `json:"data_schema,omitempty"`
 	// 创建时间
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新时间
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// 删除时间
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ModuleQuery when eager-loading is set.
 	Edges        ModuleEdges `json:"edges"`
@@ -57,11 +66,15 @@ func (*Module) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case module.FieldDataSchema:
+			values[i] = new([]byte)
+		case module.FieldWeight:
+			values[i] = new(sql.NullFloat64)
 		case module.FieldID:
 			values[i] = new(sql.NullInt64)
-		case module.FieldTitle, module.FieldDescription:
+		case module.FieldCode, module.FieldTitle, module.FieldShape, module.FieldDescription:
 			values[i] = new(sql.NullString)
-		case module.FieldCreatedAt, module.FieldUpdatedAt, module.FieldDeletedAt:
+		case module.FieldDeletedAt, module.FieldCreatedAt, module.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -84,18 +97,52 @@ func (_m *Module) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int64(value.Int64)
+		case module.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case module.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = new(string)
+				*_m.Code = value.String
+			}
 		case module.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
 			} else if value.Valid {
 				_m.Title = value.String
 			}
+		case module.FieldShape:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shape", values[i])
+			} else if value.Valid {
+				_m.Shape = module.Shape(value.String)
+			}
 		case module.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case module.FieldWeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				_m.Weight = value.Float64
+			}
+		case module.FieldDataSchema:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data_schema", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DataSchema); err != nil {
+					return fmt.Errorf("unmarshal field data_schema: %w", err)
+				}
+			}
 		case module.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -108,13 +155,6 @@ func (_m *Module) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
-		case module.FieldDeletedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
-			} else if value.Valid {
-				_m.DeletedAt = new(time.Time)
-				*_m.DeletedAt = value.Time
-			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -156,22 +196,36 @@ func (_m *Module) String() string {
 	var builder strings.Builder
 	builder.WriteString("Module(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Code; v != nil {
+		builder.WriteString("code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
+	builder.WriteString("shape=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Shape))
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weight))
+	builder.WriteString(", ")
+	builder.WriteString("data_schema=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DataSchema))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
-	if v := _m.DeletedAt; v != nil {
-		builder.WriteString("deleted_at=")
-		builder.WriteString(v.Format(time.ANSIC))
-	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,8 +3,10 @@
 package module
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -14,16 +16,24 @@ const (
 	Label = "module"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
+	// FieldShape holds the string denoting the shape field in the database.
+	FieldShape = "shape"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// FieldDataSchema holds the string denoting the data_schema field in the database.
+	FieldDataSchema = "data_schema"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
-	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
-	FieldDeletedAt = "deleted_at"
 	// EdgeDimensions holds the string denoting the dimensions edge name in mutations.
 	EdgeDimensions = "dimensions"
 	// Table holds the table name of the module in the database.
@@ -40,11 +50,15 @@ const (
 // Columns holds all SQL columns for module fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
+	FieldCode,
 	FieldTitle,
+	FieldShape,
 	FieldDescription,
+	FieldWeight,
+	FieldDataSchema,
 	FieldCreatedAt,
 	FieldUpdatedAt,
-	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -57,11 +71,22 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "cv2/internal/infra/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
 	// DefaultDescription holds the default value on creation for the "description" field.
 	DefaultDescription string
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight float64
+	// WeightValidator is a validator for the "weight" field. It is called by the builders before save.
+	WeightValidator func(float64) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -72,6 +97,33 @@ var (
 	DefaultID func() int64
 )
 
+// Shape defines the type for the "shape" enum field.
+type Shape string
+
+// ShapeList is the default value of the Shape enum.
+const DefaultShape = ShapeList
+
+// Shape values.
+const (
+	ShapeObject Shape = "object"
+	ShapeList   Shape = "list"
+	ShapeText   Shape = "text"
+)
+
+func (s Shape) String() string {
+	return string(s)
+}
+
+// ShapeValidator is a validator for the "shape" field enum values. It is called by the builders before save.
+func ShapeValidator(s Shape) error {
+	switch s {
+	case ShapeObject, ShapeList, ShapeText:
+		return nil
+	default:
+		return fmt.Errorf("module: invalid enum value for shape field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Module queries.
 type OrderOption func(*sql.Selector)
 
@@ -80,16 +132,36 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
 // ByTitle orders the results by the title field.
 func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
 }
 
+// ByShape orders the results by the shape field.
+func ByShape(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShape, opts...).ToFunc()
+}
+
 // ByDescription orders the results by the description field.
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -100,11 +172,6 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
-// ByDeletedAt orders the results by the deleted_at field.
-func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
-}
-
 // ByDimensionsCount orders the results by dimensions count.
 func ByDimensionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -55,6 +55,16 @@ func IDLTE(id int64) predicate.Module {
 	return predicate.Module(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Module {
+	return predicate.Module(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Module {
+	return predicate.Module(sql.FieldEQ(FieldCode, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldTitle, v))
@@ -65,6 +75,11 @@ func Description(v string) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldDescription, v))
 }
 
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v float64) predicate.Module {
+	return predicate.Module(sql.FieldEQ(FieldWeight, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldCreatedAt, v))
@@ -75,11 +90,131 @@ func UpdatedAt(v time.Time) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
-// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
-func DeletedAt(v time.Time) predicate.Module {
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Module {
+	return predicate.Module(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Module {
+	return predicate.Module(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Module {
+	return predicate.Module(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Module {
+	return predicate.Module(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Module {
+	return predicate.Module(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Module {
+	return predicate.Module(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Module {
+	return predicate.Module(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Module {
+	return predicate.Module(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Module {
+	return predicate.Module(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Module {
+	return predicate.Module(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Module {
+	return predicate.Module(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Module {
+	return predicate.Module(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Module {
+	return predicate.Module(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Module {
+	return predicate.Module(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Module {
+	return predicate.Module(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Module {
+	return predicate.Module(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Module {
+	return predicate.Module(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Module {
+	return predicate.Module(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Module {
+	return predicate.Module(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Module {
+	return predicate.Module(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeIsNil applies the IsNil predicate on the "code" field.
+func CodeIsNil() predicate.Module {
+	return predicate.Module(sql.FieldIsNull(FieldCode))
+}
+
+// CodeNotNil applies the NotNil predicate on the "code" field.
+func CodeNotNil() predicate.Module {
+	return predicate.Module(sql.FieldNotNull(FieldCode))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Module {
+	return predicate.Module(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Module {
+	return predicate.Module(sql.FieldContainsFold(FieldCode, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldTitle, v))
@@ -145,6 +280,26 @@ func TitleContainsFold(v string) predicate.Module {
 	return predicate.Module(sql.FieldContainsFold(FieldTitle, v))
 }
 
+// ShapeEQ applies the EQ predicate on the "shape" field.
+func ShapeEQ(v Shape) predicate.Module {
+	return predicate.Module(sql.FieldEQ(FieldShape, v))
+}
+
+// ShapeNEQ applies the NEQ predicate on the "shape" field.
+func ShapeNEQ(v Shape) predicate.Module {
+	return predicate.Module(sql.FieldNEQ(FieldShape, v))
+}
+
+// ShapeIn applies the In predicate on the "shape" field.
+func ShapeIn(vs ...Shape) predicate.Module {
+	return predicate.Module(sql.FieldIn(FieldShape, vs...))
+}
+
+// ShapeNotIn applies the NotIn predicate on the "shape" field.
+func ShapeNotIn(vs ...Shape) predicate.Module {
+	return predicate.Module(sql.FieldNotIn(FieldShape, vs...))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldDescription, v))
@@ -210,6 +365,56 @@ func DescriptionContainsFold(v string) predicate.Module {
 	return predicate.Module(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v float64) predicate.Module {
+	return predicate.Module(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v float64) predicate.Module {
+	return predicate.Module(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...float64) predicate.Module {
+	return predicate.Module(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...float64) predicate.Module {
+	return predicate.Module(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v float64) predicate.Module {
+	return predicate.Module(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v float64) predicate.Module {
+	return predicate.Module(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v float64) predicate.Module {
+	return predicate.Module(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v float64) predicate.Module {
+	return predicate.Module(sql.FieldLTE(FieldWeight, v))
+}
+
+// DataSchemaIsNil applies the IsNil predicate on the "data_schema" field.
+func DataSchemaIsNil() predicate.Module {
+	return predicate.Module(sql.FieldIsNull(FieldDataSchema))
+}
+
+// DataSchemaNotNil applies the NotNil predicate on the "data_schema" field.
+func DataSchemaNotNil() predicate.Module {
+	return predicate.Module(sql.FieldNotNull(FieldDataSchema))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Module {
 	return predicate.Module(sql.FieldEQ(FieldCreatedAt, v))
@@ -290,56 +495,6 @@ func UpdatedAtLTE(v time.Time) predicate.Module {
 	return predicate.Module(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
-// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
-func DeletedAtEQ(v time.Time) predicate.Module {
-	return predicate.Module(sql.FieldEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
-func DeletedAtNEQ(v time.Time) predicate.Module {
-	return predicate.Module(sql.FieldNEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtIn applies the In predicate on the "deleted_at" field.
-func DeletedAtIn(vs ...time.Time) predicate.Module {
-	return predicate.Module(sql.FieldIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
-func DeletedAtNotIn(vs ...time.Time) predicate.Module {
-	return predicate.Module(sql.FieldNotIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtGT applies the GT predicate on the "deleted_at" field.
-func DeletedAtGT(v time.Time) predicate.Module {
-	return predicate.Module(sql.FieldGT(FieldDeletedAt, v))
-}
-
-// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
-func DeletedAtGTE(v time.Time) predicate.Module {
-	return predicate.Module(sql.FieldGTE(FieldDeletedAt, v))
-}
-
-// DeletedAtLT applies the LT predicate on the "deleted_at" field.
-func DeletedAtLT(v time.Time) predicate.Module {
-	return predicate.Module(sql.FieldLT(FieldDeletedAt, v))
-}
-
-// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
-func DeletedAtLTE(v time.Time) predicate.Module {
-	return predicate.Module(sql.FieldLTE(FieldDeletedAt, v))
-}
-
-// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
-func DeletedAtIsNil() predicate.Module {
-	return predicate.Module(sql.FieldIsNull(FieldDeletedAt))
-}
-
-// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
-func DeletedAtNotNil() predicate.Module {
-	return predicate.Module(sql.FieldNotNull(FieldDeletedAt))
-}
-
 // HasDimensions applies the HasEdge predicate on the "dimensions" edge.
 func HasDimensions() predicate.Module {
 	return predicate.Module(func(s *sql.Selector) {
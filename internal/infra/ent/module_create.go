@@ -21,12 +21,54 @@ type ModuleCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ModuleCreate) SetDeletedAt(v time.Time) *ModuleCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ModuleCreate) SetNillableDeletedAt(v *time.Time) *ModuleCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *ModuleCreate) SetCode(v string) *ModuleCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_c *ModuleCreate) SetNillableCode(v *string) *ModuleCreate {
+	if v != nil {
+		_c.SetCode(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *ModuleCreate) SetTitle(v string) *ModuleCreate {
 	_c.mutation.SetTitle(v)
 	return _c
 }
 
+// SetShape sets the "shape" field.
+func (_c *ModuleCreate) SetShape(v module.Shape) *ModuleCreate {
+	_c.mutation.SetShape(v)
+	return _c
+}
+
+// SetNillableShape sets the "shape" field if the given value is not nil.
+func (_c *ModuleCreate) SetNillableShape(v *module.Shape) *ModuleCreate {
+	if v != nil {
+		_c.SetShape(*v)
+	}
+	return _c
+}
+
 // SetDescription sets the "description" field.
 func (_c *ModuleCreate) SetDescription(v string) *ModuleCreate {
 	_c.mutation.SetDescription(v)
@@ -41,6 +83,26 @@ func (_c *ModuleCreate) SetNillableDescription(v *string) *ModuleCreate {
 	return _c
 }
 
+// SetWeight sets the "weight" field.
+func (_c *ModuleCreate) SetWeight(v float64) *ModuleCreate {
+	_c.mutation.SetWeight(v)
+	return _c
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_c *ModuleCreate) SetNillableWeight(v *float64) *ModuleCreate {
+	if v != nil {
+		_c.SetWeight(*v)
+	}
+	return _c
+}
+
+// SetDataSchema sets the "data_schema" field.
+func (_c *ModuleCreate) SetDataSchema(v map[string]interface{}) *ModuleCreate {
+	_c.mutation.SetDataSchema(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ModuleCreate) SetCreatedAt(v time.Time) *ModuleCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -69,20 +131,6 @@ func (_c *ModuleCreate) SetNillableUpdatedAt(v *time.Time) *ModuleCreate {
 	return _c
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_c *ModuleCreate) SetDeletedAt(v time.Time) *ModuleCreate {
-	_c.mutation.SetDeletedAt(v)
-	return _c
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_c *ModuleCreate) SetNillableDeletedAt(v *time.Time) *ModuleCreate {
-	if v != nil {
-		_c.SetDeletedAt(*v)
-	}
-	return _c
-}
-
 // SetID sets the "id" field.
 func (_c *ModuleCreate) SetID(v int64) *ModuleCreate {
 	_c.mutation.SetID(v)
@@ -119,7 +167,9 @@ func (_c *ModuleCreate) Mutation() *ModuleMutation {
 
 // Save creates the Module in the database.
 func (_c *ModuleCreate) Save(ctx context.Context) (*Module, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -146,23 +196,41 @@ func (_c *ModuleCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ModuleCreate) defaults() {
+func (_c *ModuleCreate) defaults() error {
+	if _, ok := _c.mutation.Shape(); !ok {
+		v := module.DefaultShape
+		_c.mutation.SetShape(v)
+	}
 	if _, ok := _c.mutation.Description(); !ok {
 		v := module.DefaultDescription
 		_c.mutation.SetDescription(v)
 	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		v := module.DefaultWeight
+		_c.mutation.SetWeight(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if module.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized module.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := module.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if module.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized module.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := module.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if module.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized module.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := module.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -175,9 +243,25 @@ func (_c *ModuleCreate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Module.title": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Shape(); !ok {
+		return &ValidationError{Name: "shape", err: errors.New(`ent: missing required field "Module.shape"`)}
+	}
+	if v, ok := _c.mutation.Shape(); ok {
+		if err := module.ShapeValidator(v); err != nil {
+			return &ValidationError{Name: "shape", err: fmt.Errorf(`ent: validator failed for field "Module.shape": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Description(); !ok {
 		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Module.description"`)}
 	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "Module.weight"`)}
+	}
+	if v, ok := _c.mutation.Weight(); ok {
+		if err := module.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "Module.weight": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Module.created_at"`)}
 	}
@@ -216,14 +300,34 @@ func (_c *ModuleCreate) createSpec() (*Module, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(module.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(module.FieldCode, field.TypeString, value)
+		_node.Code = &value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(module.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := _c.mutation.Shape(); ok {
+		_spec.SetField(module.FieldShape, field.TypeEnum, value)
+		_node.Shape = value
+	}
 	if value, ok := _c.mutation.Description(); ok {
 		_spec.SetField(module.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.Weight(); ok {
+		_spec.SetField(module.FieldWeight, field.TypeFloat64, value)
+		_node.Weight = value
+	}
+	if value, ok := _c.mutation.DataSchema(); ok {
+		_spec.SetField(module.FieldDataSchema, field.TypeJSON, value)
+		_node.DataSchema = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(module.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -232,10 +336,6 @@ func (_c *ModuleCreate) createSpec() (*Module, *sqlgraph.CreateSpec) {
 		_spec.SetField(module.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := _c.mutation.DeletedAt(); ok {
-		_spec.SetField(module.FieldDeletedAt, field.TypeTime, value)
-		_node.DeletedAt = &value
-	}
 	if nodes := _c.mutation.DimensionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -299,12 +299,12 @@ func (_q *ModuleQuery) WithDimensions(opts ...func(*DimensionQuery)) *ModuleQuer
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Module.Query().
-//		GroupBy(module.FieldTitle).
+//		GroupBy(module.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ModuleQuery) GroupBy(field string, fields ...string) *ModuleGroupBy {
@@ -322,11 +322,11 @@ func (_q *ModuleQuery) GroupBy(field string, fields ...string) *ModuleGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Module.Query().
-//		Select(module.FieldTitle).
+//		Select(module.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (_q *ModuleQuery) Select(fields ...string) *ModuleSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -29,6 +29,46 @@ func (_u *ModuleUpdate) Where(ps ...predicate.Module) *ModuleUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ModuleUpdate) SetDeletedAt(v time.Time) *ModuleUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ModuleUpdate) SetNillableDeletedAt(v *time.Time) *ModuleUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ModuleUpdate) ClearDeletedAt() *ModuleUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *ModuleUpdate) SetCode(v string) *ModuleUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ModuleUpdate) SetNillableCode(v *string) *ModuleUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// ClearCode clears the value of the "code" field.
+func (_u *ModuleUpdate) ClearCode() *ModuleUpdate {
+	_u.mutation.ClearCode()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *ModuleUpdate) SetTitle(v string) *ModuleUpdate {
 	_u.mutation.SetTitle(v)
@@ -43,6 +83,20 @@ func (_u *ModuleUpdate) SetNillableTitle(v *string) *ModuleUpdate {
 	return _u
 }
 
+// SetShape sets the "shape" field.
+func (_u *ModuleUpdate) SetShape(v module.Shape) *ModuleUpdate {
+	_u.mutation.SetShape(v)
+	return _u
+}
+
+// SetNillableShape sets the "shape" field if the given value is not nil.
+func (_u *ModuleUpdate) SetNillableShape(v *module.Shape) *ModuleUpdate {
+	if v != nil {
+		_u.SetShape(*v)
+	}
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *ModuleUpdate) SetDescription(v string) *ModuleUpdate {
 	_u.mutation.SetDescription(v)
@@ -57,29 +111,42 @@ func (_u *ModuleUpdate) SetNillableDescription(v *string) *ModuleUpdate {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *ModuleUpdate) SetUpdatedAt(v time.Time) *ModuleUpdate {
-	_u.mutation.SetUpdatedAt(v)
+// SetWeight sets the "weight" field.
+func (_u *ModuleUpdate) SetWeight(v float64) *ModuleUpdate {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *ModuleUpdate) SetDeletedAt(v time.Time) *ModuleUpdate {
-	_u.mutation.SetDeletedAt(v)
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *ModuleUpdate) SetNillableWeight(v *float64) *ModuleUpdate {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *ModuleUpdate) SetNillableDeletedAt(v *time.Time) *ModuleUpdate {
-	if v != nil {
-		_u.SetDeletedAt(*v)
-	}
+// AddWeight adds value to the "weight" field.
+func (_u *ModuleUpdate) AddWeight(v float64) *ModuleUpdate {
+	_u.mutation.AddWeight(v)
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *ModuleUpdate) ClearDeletedAt() *ModuleUpdate {
-	_u.mutation.ClearDeletedAt()
+// SetDataSchema sets the "data_schema" field.
+func (_u *ModuleUpdate) SetDataSchema(v map[string]interface{}) *ModuleUpdate {
+	_u.mutation.SetDataSchema(v)
+	return _u
+}
+
+// ClearDataSchema clears the value of the "data_schema" field.
+func (_u *ModuleUpdate) ClearDataSchema() *ModuleUpdate {
+	_u.mutation.ClearDataSchema()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModuleUpdate) SetUpdatedAt(v time.Time) *ModuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
@@ -126,7 +193,9 @@ func (_u *ModuleUpdate) RemoveDimensions(v ...*Dimension) *ModuleUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ModuleUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -153,11 +222,15 @@ func (_u *ModuleUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ModuleUpdate) defaults() {
+func (_u *ModuleUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if module.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized module.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := module.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -167,6 +240,16 @@ func (_u *ModuleUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Module.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Shape(); ok {
+		if err := module.ShapeValidator(v); err != nil {
+			return &ValidationError{Name: "shape", err: fmt.Errorf(`ent: validator failed for field "Module.shape": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Weight(); ok {
+		if err := module.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "Module.weight": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -182,20 +265,41 @@ func (_u *ModuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(module.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(module.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(module.FieldCode, field.TypeString, value)
+	}
+	if _u.mutation.CodeCleared() {
+		_spec.ClearField(module.FieldCode, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(module.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Shape(); ok {
+		_spec.SetField(module.FieldShape, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(module.FieldDescription, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(module.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(module.FieldWeight, field.TypeFloat64, value)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(module.FieldDeletedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(module.FieldWeight, field.TypeFloat64, value)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(module.FieldDeletedAt, field.TypeTime)
+	if value, ok := _u.mutation.DataSchema(); ok {
+		_spec.SetField(module.FieldDataSchema, field.TypeJSON, value)
+	}
+	if _u.mutation.DataSchemaCleared() {
+		_spec.ClearField(module.FieldDataSchema, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(module.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if _u.mutation.DimensionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -262,6 +366,46 @@ type ModuleUpdateOne struct {
 	mutation *ModuleMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ModuleUpdateOne) SetDeletedAt(v time.Time) *ModuleUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ModuleUpdateOne) SetNillableDeletedAt(v *time.Time) *ModuleUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ModuleUpdateOne) ClearDeletedAt() *ModuleUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *ModuleUpdateOne) SetCode(v string) *ModuleUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ModuleUpdateOne) SetNillableCode(v *string) *ModuleUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// ClearCode clears the value of the "code" field.
+func (_u *ModuleUpdateOne) ClearCode() *ModuleUpdateOne {
+	_u.mutation.ClearCode()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *ModuleUpdateOne) SetTitle(v string) *ModuleUpdateOne {
 	_u.mutation.SetTitle(v)
@@ -276,6 +420,20 @@ func (_u *ModuleUpdateOne) SetNillableTitle(v *string) *ModuleUpdateOne {
 	return _u
 }
 
+// SetShape sets the "shape" field.
+func (_u *ModuleUpdateOne) SetShape(v module.Shape) *ModuleUpdateOne {
+	_u.mutation.SetShape(v)
+	return _u
+}
+
+// SetNillableShape sets the "shape" field if the given value is not nil.
+func (_u *ModuleUpdateOne) SetNillableShape(v *module.Shape) *ModuleUpdateOne {
+	if v != nil {
+		_u.SetShape(*v)
+	}
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *ModuleUpdateOne) SetDescription(v string) *ModuleUpdateOne {
 	_u.mutation.SetDescription(v)
@@ -290,29 +448,42 @@ func (_u *ModuleUpdateOne) SetNillableDescription(v *string) *ModuleUpdateOne {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *ModuleUpdateOne) SetUpdatedAt(v time.Time) *ModuleUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// SetWeight sets the "weight" field.
+func (_u *ModuleUpdateOne) SetWeight(v float64) *ModuleUpdateOne {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *ModuleUpdateOne) SetDeletedAt(v time.Time) *ModuleUpdateOne {
-	_u.mutation.SetDeletedAt(v)
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *ModuleUpdateOne) SetNillableWeight(v *float64) *ModuleUpdateOne {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *ModuleUpdateOne) SetNillableDeletedAt(v *time.Time) *ModuleUpdateOne {
-	if v != nil {
-		_u.SetDeletedAt(*v)
-	}
+// AddWeight adds value to the "weight" field.
+func (_u *ModuleUpdateOne) AddWeight(v float64) *ModuleUpdateOne {
+	_u.mutation.AddWeight(v)
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *ModuleUpdateOne) ClearDeletedAt() *ModuleUpdateOne {
-	_u.mutation.ClearDeletedAt()
+// SetDataSchema sets the "data_schema" field.
+func (_u *ModuleUpdateOne) SetDataSchema(v map[string]interface{}) *ModuleUpdateOne {
+	_u.mutation.SetDataSchema(v)
+	return _u
+}
+
+// ClearDataSchema clears the value of the "data_schema" field.
+func (_u *ModuleUpdateOne) ClearDataSchema() *ModuleUpdateOne {
+	_u.mutation.ClearDataSchema()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModuleUpdateOne) SetUpdatedAt(v time.Time) *ModuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
@@ -372,7 +543,9 @@ func (_u *ModuleUpdateOne) Select(field string, fields ...string) *ModuleUpdateO
 
 // Save executes the query and returns the updated Module entity.
 func (_u *ModuleUpdateOne) Save(ctx context.Context) (*Module, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -399,11 +572,15 @@ func (_u *ModuleUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ModuleUpdateOne) defaults() {
+func (_u *ModuleUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if module.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized module.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := module.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -413,6 +590,16 @@ func (_u *ModuleUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Module.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Shape(); ok {
+		if err := module.ShapeValidator(v); err != nil {
+			return &ValidationError{Name: "shape", err: fmt.Errorf(`ent: validator failed for field "Module.shape": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Weight(); ok {
+		if err := module.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "Module.weight": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -445,20 +632,41 @@ func (_u *ModuleUpdateOne) sqlSave(ctx context.Context) (_node *Module, err erro
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(module.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(module.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(module.FieldCode, field.TypeString, value)
+	}
+	if _u.mutation.CodeCleared() {
+		_spec.ClearField(module.FieldCode, field.TypeString)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(module.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Shape(); ok {
+		_spec.SetField(module.FieldShape, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(module.FieldDescription, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(module.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(module.FieldWeight, field.TypeFloat64, value)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(module.FieldDeletedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(module.FieldWeight, field.TypeFloat64, value)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(module.FieldDeletedAt, field.TypeTime)
+	if value, ok := _u.mutation.DataSchema(); ok {
+		_spec.SetField(module.FieldDataSchema, field.TypeJSON, value)
+	}
+	if _u.mutation.DataSchemaCleared() {
+		_spec.ClearField(module.FieldDataSchema, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(module.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if _u.mutation.DimensionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
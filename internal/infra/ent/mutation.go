@@ -13,7 +13,11 @@ import (
 	"cv2/internal/infra/ent/predicate"
 	"cv2/internal/infra/ent/resume"
 	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
 	"cv2/internal/infra/ent/resumeslot"
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
 	"errors"
 	"fmt"
 	"sync"
@@ -32,15 +36,19 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAIRecord    = "AIRecord"
-	TypeCity        = "City"
-	TypeDictionary  = "Dictionary"
-	TypeDimension   = "Dimension"
-	TypeModule      = "Module"
-	TypePosition    = "Position"
-	TypeResume      = "Resume"
-	TypeResumeScore = "ResumeScore"
-	TypeResumeSlot  = "ResumeSlot"
+	TypeAIRecord        = "AIRecord"
+	TypeCity            = "City"
+	TypeDictionary      = "Dictionary"
+	TypeDimension       = "Dimension"
+	TypeModule          = "Module"
+	TypePosition        = "Position"
+	TypeResume          = "Resume"
+	TypeResumeScore     = "ResumeScore"
+	TypeResumeShare     = "ResumeShare"
+	TypeResumeShareView = "ResumeShareView"
+	TypeResumeSlot      = "ResumeSlot"
+	TypeScoreHistory    = "ScoreHistory"
+	TypeScoreRun        = "ScoreRun"
 )
 
 // AIRecordMutation represents an operation that mutates the AIRecord nodes in the graph.
@@ -2285,13 +2293,15 @@ type DimensionMutation struct {
 	op             Op
 	typ            string
 	id             *int64
+	deleted_at     *time.Time
 	description    *string
 	title          *string
 	judgment       *[]map[string]interface{}
 	appendjudgment []map[string]interface{}
+	weight         *float64
+	addweight      *float64
 	created_at     *time.Time
 	updated_at     *time.Time
-	deleted_at     *time.Time
 	clearedFields  map[string]struct{}
 	module         *int64
 	clearedmodule  bool
@@ -2404,6 +2414,55 @@ func (m *DimensionMutation) IDs(ctx context.Context) ([]int64, error) {
 	}
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *DimensionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *DimensionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Dimension entity.
+// If the Dimension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DimensionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *DimensionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[dimension.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *DimensionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[dimension.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *DimensionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, dimension.FieldDeletedAt)
+}
+
 // SetModuleID sets the "module_id" field.
 func (m *DimensionMutation) SetModuleID(i int64) {
 	m.module = &i
@@ -2577,6 +2636,62 @@ func (m *DimensionMutation) ResetJudgment() {
 	delete(m.clearedFields, dimension.FieldJudgment)
 }
 
+// SetWeight sets the "weight" field.
+func (m *DimensionMutation) SetWeight(f float64) {
+	m.weight = &f
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *DimensionMutation) Weight() (r float64, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the Dimension entity.
+// If the Dimension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DimensionMutation) OldWeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
+}
+
+// AddWeight adds f to the "weight" field.
+func (m *DimensionMutation) AddWeight(f float64) {
+	if m.addweight != nil {
+		*m.addweight += f
+	} else {
+		m.addweight = &f
+	}
+}
+
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *DimensionMutation) AddedWeight() (r float64, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeight resets all changes to the "weight" field.
+func (m *DimensionMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *DimensionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -2649,55 +2764,6 @@ func (m *DimensionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *DimensionMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
-}
-
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *DimensionMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeletedAt returns the old "deleted_at" field's value of the Dimension entity.
-// If the Dimension object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DimensionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *DimensionMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[dimension.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *DimensionMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[dimension.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *DimensionMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, dimension.FieldDeletedAt)
-}
-
 // ClearModule clears the "module" edge to the Module entity.
 func (m *DimensionMutation) ClearModule() {
 	m.clearedmodule = true
@@ -2759,7 +2825,10 @@ func (m *DimensionMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *DimensionMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 8)
+	if m.deleted_at != nil {
+		fields = append(fields, dimension.FieldDeletedAt)
+	}
 	if m.module != nil {
 		fields = append(fields, dimension.FieldModuleID)
 	}
@@ -2772,15 +2841,15 @@ func (m *DimensionMutation) Fields() []string {
 	if m.judgment != nil {
 		fields = append(fields, dimension.FieldJudgment)
 	}
+	if m.weight != nil {
+		fields = append(fields, dimension.FieldWeight)
+	}
 	if m.created_at != nil {
 		fields = append(fields, dimension.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
 		fields = append(fields, dimension.FieldUpdatedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, dimension.FieldDeletedAt)
-	}
 	return fields
 }
 
@@ -2789,6 +2858,8 @@ func (m *DimensionMutation) Fields() []string {
 // schema.
 func (m *DimensionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case dimension.FieldDeletedAt:
+		return m.DeletedAt()
 	case dimension.FieldModuleID:
 		return m.ModuleID()
 	case dimension.FieldDescription:
@@ -2797,12 +2868,12 @@ func (m *DimensionMutation) Field(name string) (ent.Value, bool) {
 		return m.Title()
 	case dimension.FieldJudgment:
 		return m.Judgment()
+	case dimension.FieldWeight:
+		return m.Weight()
 	case dimension.FieldCreatedAt:
 		return m.CreatedAt()
 	case dimension.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case dimension.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -2812,6 +2883,8 @@ func (m *DimensionMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *DimensionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case dimension.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	case dimension.FieldModuleID:
 		return m.OldModuleID(ctx)
 	case dimension.FieldDescription:
@@ -2820,12 +2893,12 @@ func (m *DimensionMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldTitle(ctx)
 	case dimension.FieldJudgment:
 		return m.OldJudgment(ctx)
+	case dimension.FieldWeight:
+		return m.OldWeight(ctx)
 	case dimension.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case dimension.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case dimension.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Dimension field %s", name)
 }
@@ -2835,6 +2908,13 @@ func (m *DimensionMutation) OldField(ctx context.Context, name string) (ent.Valu
 // type.
 func (m *DimensionMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case dimension.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	case dimension.FieldModuleID:
 		v, ok := value.(int64)
 		if !ok {
@@ -2863,26 +2943,26 @@ func (m *DimensionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetJudgment(v)
 		return nil
-	case dimension.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case dimension.FieldWeight:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetWeight(v)
 		return nil
-	case dimension.FieldUpdatedAt:
+	case dimension.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case dimension.FieldDeletedAt:
+	case dimension.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
 	return fmt.Errorf("unknown Dimension field %s", name)
@@ -2892,6 +2972,9 @@ func (m *DimensionMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *DimensionMutation) AddedFields() []string {
 	var fields []string
+	if m.addweight != nil {
+		fields = append(fields, dimension.FieldWeight)
+	}
 	return fields
 }
 
@@ -2900,6 +2983,8 @@ func (m *DimensionMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *DimensionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case dimension.FieldWeight:
+		return m.AddedWeight()
 	}
 	return nil, false
 }
@@ -2909,6 +2994,13 @@ func (m *DimensionMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *DimensionMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case dimension.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Dimension numeric field %s", name)
 }
@@ -2917,12 +3009,12 @@ func (m *DimensionMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *DimensionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(dimension.FieldJudgment) {
-		fields = append(fields, dimension.FieldJudgment)
-	}
 	if m.FieldCleared(dimension.FieldDeletedAt) {
 		fields = append(fields, dimension.FieldDeletedAt)
 	}
+	if m.FieldCleared(dimension.FieldJudgment) {
+		fields = append(fields, dimension.FieldJudgment)
+	}
 	return fields
 }
 
@@ -2937,12 +3029,12 @@ func (m *DimensionMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *DimensionMutation) ClearField(name string) error {
 	switch name {
-	case dimension.FieldJudgment:
-		m.ClearJudgment()
-		return nil
 	case dimension.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
+	case dimension.FieldJudgment:
+		m.ClearJudgment()
+		return nil
 	}
 	return fmt.Errorf("unknown Dimension nullable field %s", name)
 }
@@ -2951,6 +3043,9 @@ func (m *DimensionMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *DimensionMutation) ResetField(name string) error {
 	switch name {
+	case dimension.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	case dimension.FieldModuleID:
 		m.ResetModuleID()
 		return nil
@@ -2963,15 +3058,15 @@ func (m *DimensionMutation) ResetField(name string) error {
 	case dimension.FieldJudgment:
 		m.ResetJudgment()
 		return nil
+	case dimension.FieldWeight:
+		m.ResetWeight()
+		return nil
 	case dimension.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	case dimension.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case dimension.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
 	}
 	return fmt.Errorf("unknown Dimension field %s", name)
 }
@@ -3056,11 +3151,16 @@ type ModuleMutation struct {
 	op                Op
 	typ               string
 	id                *int64
+	deleted_at        *time.Time
+	code              *string
 	title             *string
+	shape             *module.Shape
 	description       *string
+	weight            *float64
+	addweight         *float64
+	data_schema       *map[string]interface{}
 	created_at        *time.Time
 	updated_at        *time.Time
-	deleted_at        *time.Time
 	clearedFields     map[string]struct{}
 	dimensions        map[int64]struct{}
 	removeddimensions map[int64]struct{}
@@ -3174,45 +3274,179 @@ func (m *ModuleMutation) IDs(ctx context.Context) ([]int64, error) {
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *ModuleMutation) SetTitle(s string) {
-	m.title = &s
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ModuleMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ModuleMutation) Title() (r string, exists bool) {
-	v := m.title
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ModuleMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Module entity.
+// OldDeletedAt returns the old "deleted_at" field's value of the Module entity.
 // If the Module object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *ModuleMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ModuleMutation) ResetTitle() {
-	m.title = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ModuleMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[module.FieldDeletedAt] = struct{}{}
 }
 
-// SetDescription sets the "description" field.
-func (m *ModuleMutation) SetDescription(s string) {
-	m.description = &s
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ModuleMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[module.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ModuleMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, module.FieldDeletedAt)
+}
+
+// SetCode sets the "code" field.
+func (m *ModuleMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *ModuleMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldCode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ClearCode clears the value of the "code" field.
+func (m *ModuleMutation) ClearCode() {
+	m.code = nil
+	m.clearedFields[module.FieldCode] = struct{}{}
+}
+
+// CodeCleared returns if the "code" field was cleared in this mutation.
+func (m *ModuleMutation) CodeCleared() bool {
+	_, ok := m.clearedFields[module.FieldCode]
+	return ok
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *ModuleMutation) ResetCode() {
+	m.code = nil
+	delete(m.clearedFields, module.FieldCode)
+}
+
+// SetTitle sets the "title" field.
+func (m *ModuleMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ModuleMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ModuleMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetShape sets the "shape" field.
+func (m *ModuleMutation) SetShape(value module.Shape) {
+	m.shape = &value
+}
+
+// Shape returns the value of the "shape" field in the mutation.
+func (m *ModuleMutation) Shape() (r module.Shape, exists bool) {
+	v := m.shape
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShape returns the old "shape" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldShape(ctx context.Context) (v module.Shape, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShape is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShape requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShape: %w", err)
+	}
+	return oldValue.Shape, nil
+}
+
+// ResetShape resets all changes to the "shape" field.
+func (m *ModuleMutation) ResetShape() {
+	m.shape = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ModuleMutation) SetDescription(s string) {
+	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
@@ -3246,6 +3480,111 @@ func (m *ModuleMutation) ResetDescription() {
 	m.description = nil
 }
 
+// SetWeight sets the "weight" field.
+func (m *ModuleMutation) SetWeight(f float64) {
+	m.weight = &f
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *ModuleMutation) Weight() (r float64, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldWeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
+}
+
+// AddWeight adds f to the "weight" field.
+func (m *ModuleMutation) AddWeight(f float64) {
+	if m.addweight != nil {
+		*m.addweight += f
+	} else {
+		m.addweight = &f
+	}
+}
+
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *ModuleMutation) AddedWeight() (r float64, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeight resets all changes to the "weight" field.
+func (m *ModuleMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+}
+
+// SetDataSchema sets the "data_schema" field.
+func (m *ModuleMutation) SetDataSchema(value map[string]interface{}) {
+	m.data_schema = &value
+}
+
+// DataSchema returns the value of the "data_schema" field in the mutation.
+func (m *ModuleMutation) DataSchema() (r map[string]interface{}, exists bool) {
+	v := m.data_schema
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataSchema returns the old "data_schema" field's value of the Module entity.
+// If the Module object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModuleMutation) OldDataSchema(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataSchema is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataSchema requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataSchema: %w", err)
+	}
+	return oldValue.DataSchema, nil
+}
+
+// ClearDataSchema clears the value of the "data_schema" field.
+func (m *ModuleMutation) ClearDataSchema() {
+	m.data_schema = nil
+	m.clearedFields[module.FieldDataSchema] = struct{}{}
+}
+
+// DataSchemaCleared returns if the "data_schema" field was cleared in this mutation.
+func (m *ModuleMutation) DataSchemaCleared() bool {
+	_, ok := m.clearedFields[module.FieldDataSchema]
+	return ok
+}
+
+// ResetDataSchema resets all changes to the "data_schema" field.
+func (m *ModuleMutation) ResetDataSchema() {
+	m.data_schema = nil
+	delete(m.clearedFields, module.FieldDataSchema)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *ModuleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -3318,55 +3657,6 @@ func (m *ModuleMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *ModuleMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
-}
-
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ModuleMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeletedAt returns the old "deleted_at" field's value of the Module entity.
-// If the Module object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModuleMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *ModuleMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[module.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *ModuleMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[module.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ModuleMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, module.FieldDeletedAt)
-}
-
 // AddDimensionIDs adds the "dimensions" edge to the Dimension entity by ids.
 func (m *ModuleMutation) AddDimensionIDs(ids ...int64) {
 	if m.dimensions == nil {
@@ -3455,22 +3745,34 @@ func (m *ModuleMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ModuleMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 9)
+	if m.deleted_at != nil {
+		fields = append(fields, module.FieldDeletedAt)
+	}
+	if m.code != nil {
+		fields = append(fields, module.FieldCode)
+	}
 	if m.title != nil {
 		fields = append(fields, module.FieldTitle)
 	}
+	if m.shape != nil {
+		fields = append(fields, module.FieldShape)
+	}
 	if m.description != nil {
 		fields = append(fields, module.FieldDescription)
 	}
+	if m.weight != nil {
+		fields = append(fields, module.FieldWeight)
+	}
+	if m.data_schema != nil {
+		fields = append(fields, module.FieldDataSchema)
+	}
 	if m.created_at != nil {
 		fields = append(fields, module.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
 		fields = append(fields, module.FieldUpdatedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, module.FieldDeletedAt)
-	}
 	return fields
 }
 
@@ -3479,16 +3781,24 @@ func (m *ModuleMutation) Fields() []string {
 // schema.
 func (m *ModuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case module.FieldDeletedAt:
+		return m.DeletedAt()
+	case module.FieldCode:
+		return m.Code()
 	case module.FieldTitle:
 		return m.Title()
+	case module.FieldShape:
+		return m.Shape()
 	case module.FieldDescription:
 		return m.Description()
+	case module.FieldWeight:
+		return m.Weight()
+	case module.FieldDataSchema:
+		return m.DataSchema()
 	case module.FieldCreatedAt:
 		return m.CreatedAt()
 	case module.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case module.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -3498,16 +3808,24 @@ func (m *ModuleMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *ModuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case module.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case module.FieldCode:
+		return m.OldCode(ctx)
 	case module.FieldTitle:
 		return m.OldTitle(ctx)
+	case module.FieldShape:
+		return m.OldShape(ctx)
 	case module.FieldDescription:
 		return m.OldDescription(ctx)
+	case module.FieldWeight:
+		return m.OldWeight(ctx)
+	case module.FieldDataSchema:
+		return m.OldDataSchema(ctx)
 	case module.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case module.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case module.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Module field %s", name)
 }
@@ -3517,40 +3835,68 @@ func (m *ModuleMutation) OldField(ctx context.Context, name string) (ent.Value,
 // type.
 func (m *ModuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case module.FieldTitle:
-		v, ok := value.(string)
+	case module.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetDeletedAt(v)
 		return nil
-	case module.FieldDescription:
+	case module.FieldCode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetCode(v)
 		return nil
-	case module.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
+	case module.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetTitle(v)
 		return nil
-	case module.FieldUpdatedAt:
+	case module.FieldShape:
+		v, ok := value.(module.Shape)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShape(v)
+		return nil
+	case module.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case module.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeight(v)
+		return nil
+	case module.FieldDataSchema:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataSchema(v)
+		return nil
+	case module.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case module.FieldDeletedAt:
+	case module.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
 	return fmt.Errorf("unknown Module field %s", name)
@@ -3559,13 +3905,21 @@ func (m *ModuleMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ModuleMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addweight != nil {
+		fields = append(fields, module.FieldWeight)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ModuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case module.FieldWeight:
+		return m.AddedWeight()
+	}
 	return nil, false
 }
 
@@ -3574,6 +3928,13 @@ func (m *ModuleMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ModuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case module.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Module numeric field %s", name)
 }
@@ -3585,6 +3946,12 @@ func (m *ModuleMutation) ClearedFields() []string {
 	if m.FieldCleared(module.FieldDeletedAt) {
 		fields = append(fields, module.FieldDeletedAt)
 	}
+	if m.FieldCleared(module.FieldCode) {
+		fields = append(fields, module.FieldCode)
+	}
+	if m.FieldCleared(module.FieldDataSchema) {
+		fields = append(fields, module.FieldDataSchema)
+	}
 	return fields
 }
 
@@ -3602,6 +3969,12 @@ func (m *ModuleMutation) ClearField(name string) error {
 	case module.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
+	case module.FieldCode:
+		m.ClearCode()
+		return nil
+	case module.FieldDataSchema:
+		m.ClearDataSchema()
+		return nil
 	}
 	return fmt.Errorf("unknown Module nullable field %s", name)
 }
@@ -3610,21 +3983,33 @@ func (m *ModuleMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *ModuleMutation) ResetField(name string) error {
 	switch name {
+	case module.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case module.FieldCode:
+		m.ResetCode()
+		return nil
 	case module.FieldTitle:
 		m.ResetTitle()
 		return nil
+	case module.FieldShape:
+		m.ResetShape()
+		return nil
 	case module.FieldDescription:
 		m.ResetDescription()
 		return nil
+	case module.FieldWeight:
+		m.ResetWeight()
+		return nil
+	case module.FieldDataSchema:
+		m.ResetDataSchema()
+		return nil
 	case module.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	case module.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case module.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
 	}
 	return fmt.Errorf("unknown Module field %s", name)
 }
@@ -4353,28 +4738,38 @@ func (m *PositionMutation) ResetEdge(name string) error {
 // ResumeMutation represents an operation that mutates the Resume nodes in the graph.
 type ResumeMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int64
-	user_id       *int64
-	adduser_id    *int64
-	tenant_id     *int64
-	addtenant_id  *int64
-	file_path     *string
-	file_name     *string
-	status        *int32
-	addstatus     *int32
-	cover_image   *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	clearedFields map[string]struct{}
-	scores        map[int64]struct{}
-	removedscores map[int64]struct{}
-	clearedscores bool
-	done          bool
-	oldValue      func(context.Context) (*Resume, error)
-	predicates    []predicate.Resume
+	op                   Op
+	typ                  string
+	id                   *int64
+	deleted_at           *time.Time
+	user_id              *int64
+	adduser_id           *int64
+	tenant_id            *string
+	file_path            *string
+	source_file_path     *string
+	file_name            *string
+	status               *int32
+	addstatus            *int32
+	cover_image          *string
+	render_status        *resume.RenderStatus
+	rendered_revision    *int64
+	addrendered_revision *int64
+	template             *resume.Template
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	scores               map[int64]struct{}
+	removedscores        map[int64]struct{}
+	clearedscores        bool
+	shares               map[int64]struct{}
+	removedshares        map[int64]struct{}
+	clearedshares        bool
+	score_runs           map[int64]struct{}
+	removedscore_runs    map[int64]struct{}
+	clearedscore_runs    bool
+	done                 bool
+	oldValue             func(context.Context) (*Resume, error)
+	predicates           []predicate.Resume
 }
 
 var _ ent.Mutation = (*ResumeMutation)(nil)
@@ -4481,6 +4876,55 @@ func (m *ResumeMutation) IDs(ctx context.Context) ([]int64, error) {
 	}
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ResumeMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ResumeMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Resume entity.
+// If the Resume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ResumeMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[resume.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ResumeMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[resume.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ResumeMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, resume.FieldDeletedAt)
+}
+
 // SetUserID sets the "user_id" field.
 func (m *ResumeMutation) SetUserID(i int64) {
 	m.user_id = &i
@@ -4538,13 +4982,12 @@ func (m *ResumeMutation) ResetUserID() {
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *ResumeMutation) SetTenantID(i int64) {
-	m.tenant_id = &i
-	m.addtenant_id = nil
+func (m *ResumeMutation) SetTenantID(s string) {
+	m.tenant_id = &s
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *ResumeMutation) TenantID() (r int64, exists bool) {
+func (m *ResumeMutation) TenantID() (r string, exists bool) {
 	v := m.tenant_id
 	if v == nil {
 		return
@@ -4555,7 +4998,7 @@ func (m *ResumeMutation) TenantID() (r int64, exists bool) {
 // OldTenantID returns the old "tenant_id" field's value of the Resume entity.
 // If the Resume object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResumeMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+func (m *ResumeMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -4569,28 +5012,9 @@ func (m *ResumeMutation) OldTenantID(ctx context.Context) (v int64, err error) {
 	return oldValue.TenantID, nil
 }
 
-// AddTenantID adds i to the "tenant_id" field.
-func (m *ResumeMutation) AddTenantID(i int64) {
-	if m.addtenant_id != nil {
-		*m.addtenant_id += i
-	} else {
-		m.addtenant_id = &i
-	}
-}
-
-// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
-func (m *ResumeMutation) AddedTenantID() (r int64, exists bool) {
-	v := m.addtenant_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
 // ResetTenantID resets all changes to the "tenant_id" field.
 func (m *ResumeMutation) ResetTenantID() {
 	m.tenant_id = nil
-	m.addtenant_id = nil
 }
 
 // SetFilePath sets the "file_path" field.
@@ -4629,6 +5053,55 @@ func (m *ResumeMutation) ResetFilePath() {
 	m.file_path = nil
 }
 
+// SetSourceFilePath sets the "source_file_path" field.
+func (m *ResumeMutation) SetSourceFilePath(s string) {
+	m.source_file_path = &s
+}
+
+// SourceFilePath returns the value of the "source_file_path" field in the mutation.
+func (m *ResumeMutation) SourceFilePath() (r string, exists bool) {
+	v := m.source_file_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceFilePath returns the old "source_file_path" field's value of the Resume entity.
+// If the Resume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeMutation) OldSourceFilePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceFilePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceFilePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceFilePath: %w", err)
+	}
+	return oldValue.SourceFilePath, nil
+}
+
+// ClearSourceFilePath clears the value of the "source_file_path" field.
+func (m *ResumeMutation) ClearSourceFilePath() {
+	m.source_file_path = nil
+	m.clearedFields[resume.FieldSourceFilePath] = struct{}{}
+}
+
+// SourceFilePathCleared returns if the "source_file_path" field was cleared in this mutation.
+func (m *ResumeMutation) SourceFilePathCleared() bool {
+	_, ok := m.clearedFields[resume.FieldSourceFilePath]
+	return ok
+}
+
+// ResetSourceFilePath resets all changes to the "source_file_path" field.
+func (m *ResumeMutation) ResetSourceFilePath() {
+	m.source_file_path = nil
+	delete(m.clearedFields, resume.FieldSourceFilePath)
+}
+
 // SetFileName sets the "file_name" field.
 func (m *ResumeMutation) SetFileName(s string) {
 	m.file_name = &s
@@ -4770,6 +5243,134 @@ func (m *ResumeMutation) ResetCoverImage() {
 	delete(m.clearedFields, resume.FieldCoverImage)
 }
 
+// SetRenderStatus sets the "render_status" field.
+func (m *ResumeMutation) SetRenderStatus(rs resume.RenderStatus) {
+	m.render_status = &rs
+}
+
+// RenderStatus returns the value of the "render_status" field in the mutation.
+func (m *ResumeMutation) RenderStatus() (r resume.RenderStatus, exists bool) {
+	v := m.render_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRenderStatus returns the old "render_status" field's value of the Resume entity.
+// If the Resume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeMutation) OldRenderStatus(ctx context.Context) (v resume.RenderStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRenderStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRenderStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRenderStatus: %w", err)
+	}
+	return oldValue.RenderStatus, nil
+}
+
+// ResetRenderStatus resets all changes to the "render_status" field.
+func (m *ResumeMutation) ResetRenderStatus() {
+	m.render_status = nil
+}
+
+// SetRenderedRevision sets the "rendered_revision" field.
+func (m *ResumeMutation) SetRenderedRevision(i int64) {
+	m.rendered_revision = &i
+	m.addrendered_revision = nil
+}
+
+// RenderedRevision returns the value of the "rendered_revision" field in the mutation.
+func (m *ResumeMutation) RenderedRevision() (r int64, exists bool) {
+	v := m.rendered_revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRenderedRevision returns the old "rendered_revision" field's value of the Resume entity.
+// If the Resume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeMutation) OldRenderedRevision(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRenderedRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRenderedRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRenderedRevision: %w", err)
+	}
+	return oldValue.RenderedRevision, nil
+}
+
+// AddRenderedRevision adds i to the "rendered_revision" field.
+func (m *ResumeMutation) AddRenderedRevision(i int64) {
+	if m.addrendered_revision != nil {
+		*m.addrendered_revision += i
+	} else {
+		m.addrendered_revision = &i
+	}
+}
+
+// AddedRenderedRevision returns the value that was added to the "rendered_revision" field in this mutation.
+func (m *ResumeMutation) AddedRenderedRevision() (r int64, exists bool) {
+	v := m.addrendered_revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRenderedRevision resets all changes to the "rendered_revision" field.
+func (m *ResumeMutation) ResetRenderedRevision() {
+	m.rendered_revision = nil
+	m.addrendered_revision = nil
+}
+
+// SetTemplate sets the "template" field.
+func (m *ResumeMutation) SetTemplate(r resume.Template) {
+	m.template = &r
+}
+
+// Template returns the value of the "template" field in the mutation.
+func (m *ResumeMutation) Template() (r resume.Template, exists bool) {
+	v := m.template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplate returns the old "template" field's value of the Resume entity.
+// If the Resume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeMutation) OldTemplate(ctx context.Context) (v resume.Template, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplate: %w", err)
+	}
+	return oldValue.Template, nil
+}
+
+// ResetTemplate resets all changes to the "template" field.
+func (m *ResumeMutation) ResetTemplate() {
+	m.template = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *ResumeMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -4842,68 +5443,19 @@ func (m *ResumeMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *ResumeMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// AddScoreIDs adds the "scores" edge to the ResumeScore entity by ids.
+func (m *ResumeMutation) AddScoreIDs(ids ...int64) {
+	if m.scores == nil {
+		m.scores = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.scores[ids[i]] = struct{}{}
+	}
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ResumeMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeletedAt returns the old "deleted_at" field's value of the Resume entity.
-// If the Resume object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResumeMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *ResumeMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[resume.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *ResumeMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[resume.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ResumeMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, resume.FieldDeletedAt)
-}
-
-// AddScoreIDs adds the "scores" edge to the ResumeScore entity by ids.
-func (m *ResumeMutation) AddScoreIDs(ids ...int64) {
-	if m.scores == nil {
-		m.scores = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.scores[ids[i]] = struct{}{}
-	}
-}
-
-// ClearScores clears the "scores" edge to the ResumeScore entity.
-func (m *ResumeMutation) ClearScores() {
-	m.clearedscores = true
+// ClearScores clears the "scores" edge to the ResumeScore entity.
+func (m *ResumeMutation) ClearScores() {
+	m.clearedscores = true
 }
 
 // ScoresCleared reports if the "scores" edge to the ResumeScore entity was cleared.
@@ -4945,6 +5497,114 @@ func (m *ResumeMutation) ResetScores() {
 	m.removedscores = nil
 }
 
+// AddShareIDs adds the "shares" edge to the ResumeShare entity by ids.
+func (m *ResumeMutation) AddShareIDs(ids ...int64) {
+	if m.shares == nil {
+		m.shares = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShares clears the "shares" edge to the ResumeShare entity.
+func (m *ResumeMutation) ClearShares() {
+	m.clearedshares = true
+}
+
+// SharesCleared reports if the "shares" edge to the ResumeShare entity was cleared.
+func (m *ResumeMutation) SharesCleared() bool {
+	return m.clearedshares
+}
+
+// RemoveShareIDs removes the "shares" edge to the ResumeShare entity by IDs.
+func (m *ResumeMutation) RemoveShareIDs(ids ...int64) {
+	if m.removedshares == nil {
+		m.removedshares = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.shares, ids[i])
+		m.removedshares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShares returns the removed IDs of the "shares" edge to the ResumeShare entity.
+func (m *ResumeMutation) RemovedSharesIDs() (ids []int64) {
+	for id := range m.removedshares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharesIDs returns the "shares" edge IDs in the mutation.
+func (m *ResumeMutation) SharesIDs() (ids []int64) {
+	for id := range m.shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShares resets all changes to the "shares" edge.
+func (m *ResumeMutation) ResetShares() {
+	m.shares = nil
+	m.clearedshares = false
+	m.removedshares = nil
+}
+
+// AddScoreRunIDs adds the "score_runs" edge to the ScoreRun entity by ids.
+func (m *ResumeMutation) AddScoreRunIDs(ids ...int64) {
+	if m.score_runs == nil {
+		m.score_runs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.score_runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearScoreRuns clears the "score_runs" edge to the ScoreRun entity.
+func (m *ResumeMutation) ClearScoreRuns() {
+	m.clearedscore_runs = true
+}
+
+// ScoreRunsCleared reports if the "score_runs" edge to the ScoreRun entity was cleared.
+func (m *ResumeMutation) ScoreRunsCleared() bool {
+	return m.clearedscore_runs
+}
+
+// RemoveScoreRunIDs removes the "score_runs" edge to the ScoreRun entity by IDs.
+func (m *ResumeMutation) RemoveScoreRunIDs(ids ...int64) {
+	if m.removedscore_runs == nil {
+		m.removedscore_runs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.score_runs, ids[i])
+		m.removedscore_runs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedScoreRuns returns the removed IDs of the "score_runs" edge to the ScoreRun entity.
+func (m *ResumeMutation) RemovedScoreRunsIDs() (ids []int64) {
+	for id := range m.removedscore_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ScoreRunsIDs returns the "score_runs" edge IDs in the mutation.
+func (m *ResumeMutation) ScoreRunsIDs() (ids []int64) {
+	for id := range m.score_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetScoreRuns resets all changes to the "score_runs" edge.
+func (m *ResumeMutation) ResetScoreRuns() {
+	m.score_runs = nil
+	m.clearedscore_runs = false
+	m.removedscore_runs = nil
+}
+
 // Where appends a list predicates to the ResumeMutation builder.
 func (m *ResumeMutation) Where(ps ...predicate.Resume) {
 	m.predicates = append(m.predicates, ps...)
@@ -4979,7 +5639,10 @@ func (m *ResumeMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ResumeMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 13)
+	if m.deleted_at != nil {
+		fields = append(fields, resume.FieldDeletedAt)
+	}
 	if m.user_id != nil {
 		fields = append(fields, resume.FieldUserID)
 	}
@@ -4989,6 +5652,9 @@ func (m *ResumeMutation) Fields() []string {
 	if m.file_path != nil {
 		fields = append(fields, resume.FieldFilePath)
 	}
+	if m.source_file_path != nil {
+		fields = append(fields, resume.FieldSourceFilePath)
+	}
 	if m.file_name != nil {
 		fields = append(fields, resume.FieldFileName)
 	}
@@ -4998,15 +5664,21 @@ func (m *ResumeMutation) Fields() []string {
 	if m.cover_image != nil {
 		fields = append(fields, resume.FieldCoverImage)
 	}
+	if m.render_status != nil {
+		fields = append(fields, resume.FieldRenderStatus)
+	}
+	if m.rendered_revision != nil {
+		fields = append(fields, resume.FieldRenderedRevision)
+	}
+	if m.template != nil {
+		fields = append(fields, resume.FieldTemplate)
+	}
 	if m.created_at != nil {
 		fields = append(fields, resume.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
 		fields = append(fields, resume.FieldUpdatedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, resume.FieldDeletedAt)
-	}
 	return fields
 }
 
@@ -5015,24 +5687,32 @@ func (m *ResumeMutation) Fields() []string {
 // schema.
 func (m *ResumeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case resume.FieldDeletedAt:
+		return m.DeletedAt()
 	case resume.FieldUserID:
 		return m.UserID()
 	case resume.FieldTenantID:
 		return m.TenantID()
 	case resume.FieldFilePath:
 		return m.FilePath()
+	case resume.FieldSourceFilePath:
+		return m.SourceFilePath()
 	case resume.FieldFileName:
 		return m.FileName()
 	case resume.FieldStatus:
 		return m.Status()
 	case resume.FieldCoverImage:
 		return m.CoverImage()
+	case resume.FieldRenderStatus:
+		return m.RenderStatus()
+	case resume.FieldRenderedRevision:
+		return m.RenderedRevision()
+	case resume.FieldTemplate:
+		return m.Template()
 	case resume.FieldCreatedAt:
 		return m.CreatedAt()
 	case resume.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case resume.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -5042,24 +5722,32 @@ func (m *ResumeMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *ResumeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case resume.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	case resume.FieldUserID:
 		return m.OldUserID(ctx)
 	case resume.FieldTenantID:
 		return m.OldTenantID(ctx)
 	case resume.FieldFilePath:
 		return m.OldFilePath(ctx)
+	case resume.FieldSourceFilePath:
+		return m.OldSourceFilePath(ctx)
 	case resume.FieldFileName:
 		return m.OldFileName(ctx)
 	case resume.FieldStatus:
 		return m.OldStatus(ctx)
 	case resume.FieldCoverImage:
 		return m.OldCoverImage(ctx)
+	case resume.FieldRenderStatus:
+		return m.OldRenderStatus(ctx)
+	case resume.FieldRenderedRevision:
+		return m.OldRenderedRevision(ctx)
+	case resume.FieldTemplate:
+		return m.OldTemplate(ctx)
 	case resume.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case resume.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case resume.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Resume field %s", name)
 }
@@ -5069,6 +5757,13 @@ func (m *ResumeMutation) OldField(ctx context.Context, name string) (ent.Value,
 // type.
 func (m *ResumeMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case resume.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	case resume.FieldUserID:
 		v, ok := value.(int64)
 		if !ok {
@@ -5077,7 +5772,7 @@ func (m *ResumeMutation) SetField(name string, value ent.Value) error {
 		m.SetUserID(v)
 		return nil
 	case resume.FieldTenantID:
-		v, ok := value.(int64)
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
@@ -5090,6 +5785,13 @@ func (m *ResumeMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetFilePath(v)
 		return nil
+	case resume.FieldSourceFilePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceFilePath(v)
+		return nil
 	case resume.FieldFileName:
 		v, ok := value.(string)
 		if !ok {
@@ -5111,26 +5813,40 @@ func (m *ResumeMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCoverImage(v)
 		return nil
-	case resume.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case resume.FieldRenderStatus:
+		v, ok := value.(resume.RenderStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetRenderStatus(v)
 		return nil
-	case resume.FieldUpdatedAt:
+	case resume.FieldRenderedRevision:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenderedRevision(v)
+		return nil
+	case resume.FieldTemplate:
+		v, ok := value.(resume.Template)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplate(v)
+		return nil
+	case resume.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case resume.FieldDeletedAt:
+	case resume.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
 	return fmt.Errorf("unknown Resume field %s", name)
@@ -5143,12 +5859,12 @@ func (m *ResumeMutation) AddedFields() []string {
 	if m.adduser_id != nil {
 		fields = append(fields, resume.FieldUserID)
 	}
-	if m.addtenant_id != nil {
-		fields = append(fields, resume.FieldTenantID)
-	}
 	if m.addstatus != nil {
 		fields = append(fields, resume.FieldStatus)
 	}
+	if m.addrendered_revision != nil {
+		fields = append(fields, resume.FieldRenderedRevision)
+	}
 	return fields
 }
 
@@ -5159,10 +5875,10 @@ func (m *ResumeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case resume.FieldUserID:
 		return m.AddedUserID()
-	case resume.FieldTenantID:
-		return m.AddedTenantID()
 	case resume.FieldStatus:
 		return m.AddedStatus()
+	case resume.FieldRenderedRevision:
+		return m.AddedRenderedRevision()
 	}
 	return nil, false
 }
@@ -5179,19 +5895,19 @@ func (m *ResumeMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddUserID(v)
 		return nil
-	case resume.FieldTenantID:
-		v, ok := value.(int64)
+	case resume.FieldStatus:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTenantID(v)
+		m.AddStatus(v)
 		return nil
-	case resume.FieldStatus:
-		v, ok := value.(int32)
+	case resume.FieldRenderedRevision:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatus(v)
+		m.AddRenderedRevision(v)
 		return nil
 	}
 	return fmt.Errorf("unknown Resume numeric field %s", name)
@@ -5201,12 +5917,15 @@ func (m *ResumeMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *ResumeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(resume.FieldCoverImage) {
-		fields = append(fields, resume.FieldCoverImage)
-	}
 	if m.FieldCleared(resume.FieldDeletedAt) {
 		fields = append(fields, resume.FieldDeletedAt)
 	}
+	if m.FieldCleared(resume.FieldSourceFilePath) {
+		fields = append(fields, resume.FieldSourceFilePath)
+	}
+	if m.FieldCleared(resume.FieldCoverImage) {
+		fields = append(fields, resume.FieldCoverImage)
+	}
 	return fields
 }
 
@@ -5221,12 +5940,15 @@ func (m *ResumeMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *ResumeMutation) ClearField(name string) error {
 	switch name {
-	case resume.FieldCoverImage:
-		m.ClearCoverImage()
-		return nil
 	case resume.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
+	case resume.FieldSourceFilePath:
+		m.ClearSourceFilePath()
+		return nil
+	case resume.FieldCoverImage:
+		m.ClearCoverImage()
+		return nil
 	}
 	return fmt.Errorf("unknown Resume nullable field %s", name)
 }
@@ -5235,6 +5957,9 @@ func (m *ResumeMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *ResumeMutation) ResetField(name string) error {
 	switch name {
+	case resume.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	case resume.FieldUserID:
 		m.ResetUserID()
 		return nil
@@ -5244,6 +5969,9 @@ func (m *ResumeMutation) ResetField(name string) error {
 	case resume.FieldFilePath:
 		m.ResetFilePath()
 		return nil
+	case resume.FieldSourceFilePath:
+		m.ResetSourceFilePath()
+		return nil
 	case resume.FieldFileName:
 		m.ResetFileName()
 		return nil
@@ -5253,25 +5981,37 @@ func (m *ResumeMutation) ResetField(name string) error {
 	case resume.FieldCoverImage:
 		m.ResetCoverImage()
 		return nil
+	case resume.FieldRenderStatus:
+		m.ResetRenderStatus()
+		return nil
+	case resume.FieldRenderedRevision:
+		m.ResetRenderedRevision()
+		return nil
+	case resume.FieldTemplate:
+		m.ResetTemplate()
+		return nil
 	case resume.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	case resume.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case resume.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
 	}
 	return fmt.Errorf("unknown Resume field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ResumeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	if m.scores != nil {
 		edges = append(edges, resume.EdgeScores)
 	}
+	if m.shares != nil {
+		edges = append(edges, resume.EdgeShares)
+	}
+	if m.score_runs != nil {
+		edges = append(edges, resume.EdgeScoreRuns)
+	}
 	return edges
 }
 
@@ -5285,16 +6025,34 @@ func (m *ResumeMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case resume.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.shares))
+		for id := range m.shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case resume.EdgeScoreRuns:
+		ids := make([]ent.Value, 0, len(m.score_runs))
+		for id := range m.score_runs {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ResumeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	if m.removedscores != nil {
 		edges = append(edges, resume.EdgeScores)
 	}
+	if m.removedshares != nil {
+		edges = append(edges, resume.EdgeShares)
+	}
+	if m.removedscore_runs != nil {
+		edges = append(edges, resume.EdgeScoreRuns)
+	}
 	return edges
 }
 
@@ -5308,16 +6066,34 @@ func (m *ResumeMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case resume.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.removedshares))
+		for id := range m.removedshares {
+			ids = append(ids, id)
+		}
+		return ids
+	case resume.EdgeScoreRuns:
+		ids := make([]ent.Value, 0, len(m.removedscore_runs))
+		for id := range m.removedscore_runs {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ResumeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	if m.clearedscores {
 		edges = append(edges, resume.EdgeScores)
 	}
+	if m.clearedshares {
+		edges = append(edges, resume.EdgeShares)
+	}
+	if m.clearedscore_runs {
+		edges = append(edges, resume.EdgeScoreRuns)
+	}
 	return edges
 }
 
@@ -5327,6 +6103,10 @@ func (m *ResumeMutation) EdgeCleared(name string) bool {
 	switch name {
 	case resume.EdgeScores:
 		return m.clearedscores
+	case resume.EdgeShares:
+		return m.clearedshares
+	case resume.EdgeScoreRuns:
+		return m.clearedscore_runs
 	}
 	return false
 }
@@ -5346,6 +6126,12 @@ func (m *ResumeMutation) ResetEdge(name string) error {
 	case resume.EdgeScores:
 		m.ResetScores()
 		return nil
+	case resume.EdgeShares:
+		m.ResetShares()
+		return nil
+	case resume.EdgeScoreRuns:
+		m.ResetScoreRuns()
+		return nil
 	}
 	return fmt.Errorf("unknown Resume edge %s", name)
 }
@@ -5356,17 +6142,23 @@ type ResumeScoreMutation struct {
 	op             Op
 	typ            string
 	id             *int64
+	deleted_at     *time.Time
 	target_id      *int64
 	addtarget_id   *int64
 	target_type    *int32
 	addtarget_type *int32
+	scope_id       *int64
+	addscope_id    *int64
 	score          *float64
 	addscore       *float64
 	weight         *float64
 	addweight      *float64
+	state          *resumescore.State
+	revision       *int64
+	addrevision    *int64
+	scored         *bool
 	created_at     *time.Time
 	updated_at     *time.Time
-	deleted_at     *time.Time
 	clearedFields  map[string]struct{}
 	resume         *int64
 	clearedresume  bool
@@ -5479,51 +6271,100 @@ func (m *ResumeScoreMutation) IDs(ctx context.Context) ([]int64, error) {
 	}
 }
 
-// SetResumeID sets the "resume_id" field.
-func (m *ResumeScoreMutation) SetResumeID(i int64) {
-	m.resume = &i
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ResumeScoreMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// ResumeID returns the value of the "resume_id" field in the mutation.
-func (m *ResumeScoreMutation) ResumeID() (r int64, exists bool) {
-	v := m.resume
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ResumeScoreMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResumeID returns the old "resume_id" field's value of the ResumeScore entity.
+// OldDeletedAt returns the old "deleted_at" field's value of the ResumeScore entity.
 // If the ResumeScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResumeScoreMutation) OldResumeID(ctx context.Context) (v int64, err error) {
+func (m *ResumeScoreMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResumeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResumeID requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResumeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.ResumeID, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetResumeID resets all changes to the "resume_id" field.
-func (m *ResumeScoreMutation) ResetResumeID() {
-	m.resume = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ResumeScoreMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[resumescore.FieldDeletedAt] = struct{}{}
 }
 
-// SetTargetID sets the "target_id" field.
-func (m *ResumeScoreMutation) SetTargetID(i int64) {
-	m.target_id = &i
-	m.addtarget_id = nil
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ResumeScoreMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[resumescore.FieldDeletedAt]
+	return ok
 }
 
-// TargetID returns the value of the "target_id" field in the mutation.
-func (m *ResumeScoreMutation) TargetID() (r int64, exists bool) {
-	v := m.target_id
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ResumeScoreMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, resumescore.FieldDeletedAt)
+}
+
+// SetResumeID sets the "resume_id" field.
+func (m *ResumeScoreMutation) SetResumeID(i int64) {
+	m.resume = &i
+}
+
+// ResumeID returns the value of the "resume_id" field in the mutation.
+func (m *ResumeScoreMutation) ResumeID() (r int64, exists bool) {
+	v := m.resume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumeID returns the old "resume_id" field's value of the ResumeScore entity.
+// If the ResumeScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeScoreMutation) OldResumeID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumeID: %w", err)
+	}
+	return oldValue.ResumeID, nil
+}
+
+// ResetResumeID resets all changes to the "resume_id" field.
+func (m *ResumeScoreMutation) ResetResumeID() {
+	m.resume = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *ResumeScoreMutation) SetTargetID(i int64) {
+	m.target_id = &i
+	m.addtarget_id = nil
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *ResumeScoreMutation) TargetID() (r int64, exists bool) {
+	v := m.target_id
 	if v == nil {
 		return
 	}
@@ -5627,6 +6468,62 @@ func (m *ResumeScoreMutation) ResetTargetType() {
 	m.addtarget_type = nil
 }
 
+// SetScopeID sets the "scope_id" field.
+func (m *ResumeScoreMutation) SetScopeID(i int64) {
+	m.scope_id = &i
+	m.addscope_id = nil
+}
+
+// ScopeID returns the value of the "scope_id" field in the mutation.
+func (m *ResumeScoreMutation) ScopeID() (r int64, exists bool) {
+	v := m.scope_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopeID returns the old "scope_id" field's value of the ResumeScore entity.
+// If the ResumeScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeScoreMutation) OldScopeID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopeID: %w", err)
+	}
+	return oldValue.ScopeID, nil
+}
+
+// AddScopeID adds i to the "scope_id" field.
+func (m *ResumeScoreMutation) AddScopeID(i int64) {
+	if m.addscope_id != nil {
+		*m.addscope_id += i
+	} else {
+		m.addscope_id = &i
+	}
+}
+
+// AddedScopeID returns the value that was added to the "scope_id" field in this mutation.
+func (m *ResumeScoreMutation) AddedScopeID() (r int64, exists bool) {
+	v := m.addscope_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScopeID resets all changes to the "scope_id" field.
+func (m *ResumeScoreMutation) ResetScopeID() {
+	m.scope_id = nil
+	m.addscope_id = nil
+}
+
 // SetScore sets the "score" field.
 func (m *ResumeScoreMutation) SetScore(f float64) {
 	m.score = &f
@@ -5739,6 +6636,134 @@ func (m *ResumeScoreMutation) ResetWeight() {
 	m.addweight = nil
 }
 
+// SetState sets the "state" field.
+func (m *ResumeScoreMutation) SetState(r resumescore.State) {
+	m.state = &r
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *ResumeScoreMutation) State() (r resumescore.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the ResumeScore entity.
+// If the ResumeScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeScoreMutation) OldState(ctx context.Context) (v resumescore.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *ResumeScoreMutation) ResetState() {
+	m.state = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *ResumeScoreMutation) SetRevision(i int64) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *ResumeScoreMutation) Revision() (r int64, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the ResumeScore entity.
+// If the ResumeScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeScoreMutation) OldRevision(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *ResumeScoreMutation) AddRevision(i int64) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *ResumeScoreMutation) AddedRevision() (r int64, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *ResumeScoreMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetScored sets the "scored" field.
+func (m *ResumeScoreMutation) SetScored(b bool) {
+	m.scored = &b
+}
+
+// Scored returns the value of the "scored" field in the mutation.
+func (m *ResumeScoreMutation) Scored() (r bool, exists bool) {
+	v := m.scored
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScored returns the old "scored" field's value of the ResumeScore entity.
+// If the ResumeScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeScoreMutation) OldScored(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScored is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScored requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScored: %w", err)
+	}
+	return oldValue.Scored, nil
+}
+
+// ResetScored resets all changes to the "scored" field.
+func (m *ResumeScoreMutation) ResetScored() {
+	m.scored = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *ResumeScoreMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -5811,55 +6836,6 @@ func (m *ResumeScoreMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *ResumeScoreMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
-}
-
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ResumeScoreMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeletedAt returns the old "deleted_at" field's value of the ResumeScore entity.
-// If the ResumeScore object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResumeScoreMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *ResumeScoreMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[resumescore.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *ResumeScoreMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[resumescore.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ResumeScoreMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, resumescore.FieldDeletedAt)
-}
-
 // ClearResume clears the "resume" edge to the Resume entity.
 func (m *ResumeScoreMutation) ClearResume() {
 	m.clearedresume = true
@@ -5921,7 +6897,10 @@ func (m *ResumeScoreMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ResumeScoreMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 12)
+	if m.deleted_at != nil {
+		fields = append(fields, resumescore.FieldDeletedAt)
+	}
 	if m.resume != nil {
 		fields = append(fields, resumescore.FieldResumeID)
 	}
@@ -5931,21 +6910,30 @@ func (m *ResumeScoreMutation) Fields() []string {
 	if m.target_type != nil {
 		fields = append(fields, resumescore.FieldTargetType)
 	}
+	if m.scope_id != nil {
+		fields = append(fields, resumescore.FieldScopeID)
+	}
 	if m.score != nil {
 		fields = append(fields, resumescore.FieldScore)
 	}
 	if m.weight != nil {
 		fields = append(fields, resumescore.FieldWeight)
 	}
+	if m.state != nil {
+		fields = append(fields, resumescore.FieldState)
+	}
+	if m.revision != nil {
+		fields = append(fields, resumescore.FieldRevision)
+	}
+	if m.scored != nil {
+		fields = append(fields, resumescore.FieldScored)
+	}
 	if m.created_at != nil {
 		fields = append(fields, resumescore.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
 		fields = append(fields, resumescore.FieldUpdatedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, resumescore.FieldDeletedAt)
-	}
 	return fields
 }
 
@@ -5954,22 +6942,30 @@ func (m *ResumeScoreMutation) Fields() []string {
 // schema.
 func (m *ResumeScoreMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case resumescore.FieldDeletedAt:
+		return m.DeletedAt()
 	case resumescore.FieldResumeID:
 		return m.ResumeID()
 	case resumescore.FieldTargetID:
 		return m.TargetID()
 	case resumescore.FieldTargetType:
 		return m.TargetType()
+	case resumescore.FieldScopeID:
+		return m.ScopeID()
 	case resumescore.FieldScore:
 		return m.Score()
 	case resumescore.FieldWeight:
 		return m.Weight()
+	case resumescore.FieldState:
+		return m.State()
+	case resumescore.FieldRevision:
+		return m.Revision()
+	case resumescore.FieldScored:
+		return m.Scored()
 	case resumescore.FieldCreatedAt:
 		return m.CreatedAt()
 	case resumescore.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case resumescore.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -5979,22 +6975,30 @@ func (m *ResumeScoreMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *ResumeScoreMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case resumescore.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	case resumescore.FieldResumeID:
 		return m.OldResumeID(ctx)
 	case resumescore.FieldTargetID:
 		return m.OldTargetID(ctx)
 	case resumescore.FieldTargetType:
 		return m.OldTargetType(ctx)
+	case resumescore.FieldScopeID:
+		return m.OldScopeID(ctx)
 	case resumescore.FieldScore:
 		return m.OldScore(ctx)
 	case resumescore.FieldWeight:
 		return m.OldWeight(ctx)
+	case resumescore.FieldState:
+		return m.OldState(ctx)
+	case resumescore.FieldRevision:
+		return m.OldRevision(ctx)
+	case resumescore.FieldScored:
+		return m.OldScored(ctx)
 	case resumescore.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case resumescore.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case resumescore.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown ResumeScore field %s", name)
 }
@@ -6004,6 +7008,13 @@ func (m *ResumeScoreMutation) OldField(ctx context.Context, name string) (ent.Va
 // type.
 func (m *ResumeScoreMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case resumescore.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	case resumescore.FieldResumeID:
 		v, ok := value.(int64)
 		if !ok {
@@ -6025,6 +7036,13 @@ func (m *ResumeScoreMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTargetType(v)
 		return nil
+	case resumescore.FieldScopeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopeID(v)
+		return nil
 	case resumescore.FieldScore:
 		v, ok := value.(float64)
 		if !ok {
@@ -6039,26 +7057,40 @@ func (m *ResumeScoreMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetWeight(v)
 		return nil
-	case resumescore.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case resumescore.FieldState:
+		v, ok := value.(resumescore.State)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetState(v)
 		return nil
-	case resumescore.FieldUpdatedAt:
+	case resumescore.FieldRevision:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case resumescore.FieldScored:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScored(v)
+		return nil
+	case resumescore.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case resumescore.FieldDeletedAt:
+	case resumescore.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
 	return fmt.Errorf("unknown ResumeScore field %s", name)
@@ -6074,12 +7106,18 @@ func (m *ResumeScoreMutation) AddedFields() []string {
 	if m.addtarget_type != nil {
 		fields = append(fields, resumescore.FieldTargetType)
 	}
+	if m.addscope_id != nil {
+		fields = append(fields, resumescore.FieldScopeID)
+	}
 	if m.addscore != nil {
 		fields = append(fields, resumescore.FieldScore)
 	}
 	if m.addweight != nil {
 		fields = append(fields, resumescore.FieldWeight)
 	}
+	if m.addrevision != nil {
+		fields = append(fields, resumescore.FieldRevision)
+	}
 	return fields
 }
 
@@ -6092,10 +7130,14 @@ func (m *ResumeScoreMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedTargetID()
 	case resumescore.FieldTargetType:
 		return m.AddedTargetType()
+	case resumescore.FieldScopeID:
+		return m.AddedScopeID()
 	case resumescore.FieldScore:
 		return m.AddedScore()
 	case resumescore.FieldWeight:
 		return m.AddedWeight()
+	case resumescore.FieldRevision:
+		return m.AddedRevision()
 	}
 	return nil, false
 }
@@ -6119,6 +7161,13 @@ func (m *ResumeScoreMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddTargetType(v)
 		return nil
+	case resumescore.FieldScopeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScopeID(v)
+		return nil
 	case resumescore.FieldScore:
 		v, ok := value.(float64)
 		if !ok {
@@ -6133,6 +7182,13 @@ func (m *ResumeScoreMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddWeight(v)
 		return nil
+	case resumescore.FieldRevision:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
 	}
 	return fmt.Errorf("unknown ResumeScore numeric field %s", name)
 }
@@ -6169,6 +7225,9 @@ func (m *ResumeScoreMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *ResumeScoreMutation) ResetField(name string) error {
 	switch name {
+	case resumescore.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	case resumescore.FieldResumeID:
 		m.ResetResumeID()
 		return nil
@@ -6178,21 +7237,30 @@ func (m *ResumeScoreMutation) ResetField(name string) error {
 	case resumescore.FieldTargetType:
 		m.ResetTargetType()
 		return nil
+	case resumescore.FieldScopeID:
+		m.ResetScopeID()
+		return nil
 	case resumescore.FieldScore:
 		m.ResetScore()
 		return nil
 	case resumescore.FieldWeight:
 		m.ResetWeight()
 		return nil
+	case resumescore.FieldState:
+		m.ResetState()
+		return nil
+	case resumescore.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case resumescore.FieldScored:
+		m.ResetScored()
+		return nil
 	case resumescore.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	case resumescore.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case resumescore.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
 	}
 	return fmt.Errorf("unknown ResumeScore field %s", name)
 }
@@ -6271,35 +7339,48 @@ func (m *ResumeScoreMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ResumeScore edge %s", name)
 }
 
-// ResumeSlotMutation represents an operation that mutates the ResumeSlot nodes in the graph.
-type ResumeSlotMutation struct {
+// ResumeShareMutation represents an operation that mutates the ResumeShare nodes in the graph.
+type ResumeShareMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int64
-	user_id       *string
-	max_slots     *int32
-	addmax_slots  *int32
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*ResumeSlot, error)
-	predicates    []predicate.ResumeSlot
+	op             Op
+	typ            string
+	id             *int64
+	user_id        *int64
+	adduser_id     *int64
+	tenant_id      *string
+	token          *string
+	permission     *resumeshare.Permission
+	redact         *bool
+	password_hash  *string
+	expires_at     *time.Time
+	revoked_at     *time.Time
+	view_count     *int64
+	addview_count  *int64
+	last_viewed_at *time.Time
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	resume         *int64
+	clearedresume  bool
+	views          map[int64]struct{}
+	removedviews   map[int64]struct{}
+	clearedviews   bool
+	done           bool
+	oldValue       func(context.Context) (*ResumeShare, error)
+	predicates     []predicate.ResumeShare
 }
 
-var _ ent.Mutation = (*ResumeSlotMutation)(nil)
+var _ ent.Mutation = (*ResumeShareMutation)(nil)
 
-// resumeslotOption allows management of the mutation configuration using functional options.
-type resumeslotOption func(*ResumeSlotMutation)
+// resumeshareOption allows management of the mutation configuration using functional options.
+type resumeshareOption func(*ResumeShareMutation)
 
-// newResumeSlotMutation creates new mutation for the ResumeSlot entity.
-func newResumeSlotMutation(c config, op Op, opts ...resumeslotOption) *ResumeSlotMutation {
-	m := &ResumeSlotMutation{
+// newResumeShareMutation creates new mutation for the ResumeShare entity.
+func newResumeShareMutation(c config, op Op, opts ...resumeshareOption) *ResumeShareMutation {
+	m := &ResumeShareMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeResumeSlot,
+		typ:           TypeResumeShare,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6308,20 +7389,20 @@ func newResumeSlotMutation(c config, op Op, opts ...resumeslotOption) *ResumeSlo
 	return m
 }
 
-// withResumeSlotID sets the ID field of the mutation.
-func withResumeSlotID(id int64) resumeslotOption {
-	return func(m *ResumeSlotMutation) {
+// withResumeShareID sets the ID field of the mutation.
+func withResumeShareID(id int64) resumeshareOption {
+	return func(m *ResumeShareMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ResumeSlot
+			value *ResumeShare
 		)
-		m.oldValue = func(ctx context.Context) (*ResumeSlot, error) {
+		m.oldValue = func(ctx context.Context) (*ResumeShare, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ResumeSlot.Get(ctx, id)
+					value, err = m.Client().ResumeShare.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6330,10 +7411,10 @@ func withResumeSlotID(id int64) resumeslotOption {
 	}
 }
 
-// withResumeSlot sets the old ResumeSlot of the mutation.
-func withResumeSlot(node *ResumeSlot) resumeslotOption {
-	return func(m *ResumeSlotMutation) {
-		m.oldValue = func(context.Context) (*ResumeSlot, error) {
+// withResumeShare sets the old ResumeShare of the mutation.
+func withResumeShare(node *ResumeShare) resumeshareOption {
+	return func(m *ResumeShareMutation) {
+		m.oldValue = func(context.Context) (*ResumeShare, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6342,7 +7423,7 @@ func withResumeSlot(node *ResumeSlot) resumeslotOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ResumeSlotMutation) Client() *Client {
+func (m ResumeShareMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6350,7 +7431,7 @@ func (m ResumeSlotMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ResumeSlotMutation) Tx() (*Tx, error) {
+func (m ResumeShareMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6360,14 +7441,14 @@ func (m ResumeSlotMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ResumeSlot entities.
-func (m *ResumeSlotMutation) SetID(id int64) {
+// operation is only accepted on creation of ResumeShare entities.
+func (m *ResumeShareMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ResumeSlotMutation) ID() (id int64, exists bool) {
+func (m *ResumeShareMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6378,7 +7459,7 @@ func (m *ResumeSlotMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ResumeSlotMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *ResumeShareMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6387,19 +7468,56 @@ func (m *ResumeSlotMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ResumeSlot.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ResumeShare.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetResumeID sets the "resume_id" field.
+func (m *ResumeShareMutation) SetResumeID(i int64) {
+	m.resume = &i
+}
+
+// ResumeID returns the value of the "resume_id" field in the mutation.
+func (m *ResumeShareMutation) ResumeID() (r int64, exists bool) {
+	v := m.resume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumeID returns the old "resume_id" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareMutation) OldResumeID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumeID: %w", err)
+	}
+	return oldValue.ResumeID, nil
+}
+
+// ResetResumeID resets all changes to the "resume_id" field.
+func (m *ResumeShareMutation) ResetResumeID() {
+	m.resume = nil
+}
+
 // SetUserID sets the "user_id" field.
-func (m *ResumeSlotMutation) SetUserID(s string) {
-	m.user_id = &s
+func (m *ResumeShareMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *ResumeSlotMutation) UserID() (r string, exists bool) {
+func (m *ResumeShareMutation) UserID() (r int64, exists bool) {
 	v := m.user_id
 	if v == nil {
 		return
@@ -6407,10 +7525,10 @@ func (m *ResumeSlotMutation) UserID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the ResumeSlot entity.
-// If the ResumeSlot object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResumeSlotMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *ResumeShareMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -6424,237 +7542,3874 @@ func (m *ResumeSlotMutation) OldUserID(ctx context.Context) (v string, err error
 	return oldValue.UserID, nil
 }
 
+// AddUserID adds i to the "user_id" field.
+func (m *ResumeShareMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *ResumeShareMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetUserID resets all changes to the "user_id" field.
-func (m *ResumeSlotMutation) ResetUserID() {
+func (m *ResumeShareMutation) ResetUserID() {
 	m.user_id = nil
+	m.adduser_id = nil
 }
 
-// SetMaxSlots sets the "max_slots" field.
-func (m *ResumeSlotMutation) SetMaxSlots(i int32) {
-	m.max_slots = &i
-	m.addmax_slots = nil
+// SetTenantID sets the "tenant_id" field.
+func (m *ResumeShareMutation) SetTenantID(s string) {
+	m.tenant_id = &s
 }
 
-// MaxSlots returns the value of the "max_slots" field in the mutation.
-func (m *ResumeSlotMutation) MaxSlots() (r int32, exists bool) {
-	v := m.max_slots
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ResumeShareMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxSlots returns the old "max_slots" field's value of the ResumeSlot entity.
-// If the ResumeSlot object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResumeSlotMutation) OldMaxSlots(ctx context.Context) (v int32, err error) {
+func (m *ResumeShareMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxSlots is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxSlots requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxSlots: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.MaxSlots, nil
+	return oldValue.TenantID, nil
 }
 
-// AddMaxSlots adds i to the "max_slots" field.
-func (m *ResumeSlotMutation) AddMaxSlots(i int32) {
-	if m.addmax_slots != nil {
-		*m.addmax_slots += i
-	} else {
-		m.addmax_slots = &i
-	}
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ResumeShareMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// AddedMaxSlots returns the value that was added to the "max_slots" field in this mutation.
-func (m *ResumeSlotMutation) AddedMaxSlots() (r int32, exists bool) {
-	v := m.addmax_slots
+// SetToken sets the "token" field.
+func (m *ResumeShareMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *ResumeShareMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMaxSlots resets all changes to the "max_slots" field.
-func (m *ResumeSlotMutation) ResetMaxSlots() {
-	m.max_slots = nil
-	m.addmax_slots = nil
+// OldToken returns the old "token" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ResumeSlotMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetToken resets all changes to the "token" field.
+func (m *ResumeShareMutation) ResetToken() {
+	m.token = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ResumeSlotMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetPermission sets the "permission" field.
+func (m *ResumeShareMutation) SetPermission(r resumeshare.Permission) {
+	m.permission = &r
+}
+
+// Permission returns the value of the "permission" field in the mutation.
+func (m *ResumeShareMutation) Permission() (r resumeshare.Permission, exists bool) {
+	v := m.permission
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ResumeSlot entity.
-// If the ResumeSlot object wasn't provided to the builder, the object is fetched from the database.
+// OldPermission returns the old "permission" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResumeSlotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ResumeShareMutation) OldPermission(ctx context.Context) (v resumeshare.Permission, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPermission is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPermission requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPermission: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Permission, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ResumeSlotMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetPermission resets all changes to the "permission" field.
+func (m *ResumeShareMutation) ResetPermission() {
+	m.permission = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ResumeSlotMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetRedact sets the "redact" field.
+func (m *ResumeShareMutation) SetRedact(b bool) {
+	m.redact = &b
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ResumeSlotMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Redact returns the value of the "redact" field in the mutation.
+func (m *ResumeShareMutation) Redact() (r bool, exists bool) {
+	v := m.redact
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ResumeSlot entity.
-// If the ResumeSlot object wasn't provided to the builder, the object is fetched from the database.
+// OldRedact returns the old "redact" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResumeSlotMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ResumeShareMutation) OldRedact(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRedact is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRedact requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRedact: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Redact, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ResumeSlotMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetRedact resets all changes to the "redact" field.
+func (m *ResumeShareMutation) ResetRedact() {
+	m.redact = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *ResumeSlotMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetPasswordHash sets the "password_hash" field.
+func (m *ResumeShareMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ResumeSlotMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *ResumeShareMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the ResumeSlot entity.
-// If the ResumeSlot object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResumeSlotMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ResumeShareMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.PasswordHash, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *ResumeSlotMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[resumeslot.FieldDeletedAt] = struct{}{}
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (m *ResumeShareMutation) ClearPasswordHash() {
+	m.password_hash = nil
+	m.clearedFields[resumeshare.FieldPasswordHash] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *ResumeSlotMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[resumeslot.FieldDeletedAt]
+// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
+func (m *ResumeShareMutation) PasswordHashCleared() bool {
+	_, ok := m.clearedFields[resumeshare.FieldPasswordHash]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ResumeSlotMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, resumeslot.FieldDeletedAt)
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *ResumeShareMutation) ResetPasswordHash() {
+	m.password_hash = nil
+	delete(m.clearedFields, resumeshare.FieldPasswordHash)
 }
 
-// Where appends a list predicates to the ResumeSlotMutation builder.
-func (m *ResumeSlotMutation) Where(ps ...predicate.ResumeSlot) {
-	m.predicates = append(m.predicates, ps...)
+// SetExpiresAt sets the "expires_at" field.
+func (m *ResumeShareMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// WhereP appends storage-level predicates to the ResumeSlotMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ResumeSlotMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ResumeSlot, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ResumeShareMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ResumeSlotMutation) Op() Op {
-	return m.op
+// OldExpiresAt returns the old "expires_at" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ResumeShareMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[resumeshare.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ResumeShareMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[resumeshare.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ResumeShareMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, resumeshare.FieldExpiresAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *ResumeShareMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *ResumeShareMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *ResumeShareMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[resumeshare.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *ResumeShareMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[resumeshare.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *ResumeShareMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, resumeshare.FieldRevokedAt)
+}
+
+// SetViewCount sets the "view_count" field.
+func (m *ResumeShareMutation) SetViewCount(i int64) {
+	m.view_count = &i
+	m.addview_count = nil
+}
+
+// ViewCount returns the value of the "view_count" field in the mutation.
+func (m *ResumeShareMutation) ViewCount() (r int64, exists bool) {
+	v := m.view_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViewCount returns the old "view_count" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareMutation) OldViewCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
+	}
+	return oldValue.ViewCount, nil
+}
+
+// AddViewCount adds i to the "view_count" field.
+func (m *ResumeShareMutation) AddViewCount(i int64) {
+	if m.addview_count != nil {
+		*m.addview_count += i
+	} else {
+		m.addview_count = &i
+	}
+}
+
+// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
+func (m *ResumeShareMutation) AddedViewCount() (r int64, exists bool) {
+	v := m.addview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetViewCount resets all changes to the "view_count" field.
+func (m *ResumeShareMutation) ResetViewCount() {
+	m.view_count = nil
+	m.addview_count = nil
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (m *ResumeShareMutation) SetLastViewedAt(t time.Time) {
+	m.last_viewed_at = &t
+}
+
+// LastViewedAt returns the value of the "last_viewed_at" field in the mutation.
+func (m *ResumeShareMutation) LastViewedAt() (r time.Time, exists bool) {
+	v := m.last_viewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastViewedAt returns the old "last_viewed_at" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareMutation) OldLastViewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastViewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastViewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastViewedAt: %w", err)
+	}
+	return oldValue.LastViewedAt, nil
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (m *ResumeShareMutation) ClearLastViewedAt() {
+	m.last_viewed_at = nil
+	m.clearedFields[resumeshare.FieldLastViewedAt] = struct{}{}
+}
+
+// LastViewedAtCleared returns if the "last_viewed_at" field was cleared in this mutation.
+func (m *ResumeShareMutation) LastViewedAtCleared() bool {
+	_, ok := m.clearedFields[resumeshare.FieldLastViewedAt]
+	return ok
+}
+
+// ResetLastViewedAt resets all changes to the "last_viewed_at" field.
+func (m *ResumeShareMutation) ResetLastViewedAt() {
+	m.last_viewed_at = nil
+	delete(m.clearedFields, resumeshare.FieldLastViewedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ResumeShareMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ResumeShareMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ResumeShareMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ResumeShareMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ResumeShareMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ResumeShare entity.
+// If the ResumeShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ResumeShareMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearResume clears the "resume" edge to the Resume entity.
+func (m *ResumeShareMutation) ClearResume() {
+	m.clearedresume = true
+	m.clearedFields[resumeshare.FieldResumeID] = struct{}{}
+}
+
+// ResumeCleared reports if the "resume" edge to the Resume entity was cleared.
+func (m *ResumeShareMutation) ResumeCleared() bool {
+	return m.clearedresume
+}
+
+// ResumeIDs returns the "resume" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResumeID instead. It exists only for internal usage by the builders.
+func (m *ResumeShareMutation) ResumeIDs() (ids []int64) {
+	if id := m.resume; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResume resets all changes to the "resume" edge.
+func (m *ResumeShareMutation) ResetResume() {
+	m.resume = nil
+	m.clearedresume = false
+}
+
+// AddViewIDs adds the "views" edge to the ResumeShareView entity by ids.
+func (m *ResumeShareMutation) AddViewIDs(ids ...int64) {
+	if m.views == nil {
+		m.views = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.views[ids[i]] = struct{}{}
+	}
+}
+
+// ClearViews clears the "views" edge to the ResumeShareView entity.
+func (m *ResumeShareMutation) ClearViews() {
+	m.clearedviews = true
+}
+
+// ViewsCleared reports if the "views" edge to the ResumeShareView entity was cleared.
+func (m *ResumeShareMutation) ViewsCleared() bool {
+	return m.clearedviews
+}
+
+// RemoveViewIDs removes the "views" edge to the ResumeShareView entity by IDs.
+func (m *ResumeShareMutation) RemoveViewIDs(ids ...int64) {
+	if m.removedviews == nil {
+		m.removedviews = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.views, ids[i])
+		m.removedviews[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedViews returns the removed IDs of the "views" edge to the ResumeShareView entity.
+func (m *ResumeShareMutation) RemovedViewsIDs() (ids []int64) {
+	for id := range m.removedviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ViewsIDs returns the "views" edge IDs in the mutation.
+func (m *ResumeShareMutation) ViewsIDs() (ids []int64) {
+	for id := range m.views {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetViews resets all changes to the "views" edge.
+func (m *ResumeShareMutation) ResetViews() {
+	m.views = nil
+	m.clearedviews = false
+	m.removedviews = nil
+}
+
+// Where appends a list predicates to the ResumeShareMutation builder.
+func (m *ResumeShareMutation) Where(ps ...predicate.ResumeShare) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ResumeShareMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ResumeShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ResumeShare, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ResumeShareMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ResumeShareMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ResumeShare).
+func (m *ResumeShareMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResumeShareMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.resume != nil {
+		fields = append(fields, resumeshare.FieldResumeID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, resumeshare.FieldUserID)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, resumeshare.FieldTenantID)
+	}
+	if m.token != nil {
+		fields = append(fields, resumeshare.FieldToken)
+	}
+	if m.permission != nil {
+		fields = append(fields, resumeshare.FieldPermission)
+	}
+	if m.redact != nil {
+		fields = append(fields, resumeshare.FieldRedact)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, resumeshare.FieldPasswordHash)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, resumeshare.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, resumeshare.FieldRevokedAt)
+	}
+	if m.view_count != nil {
+		fields = append(fields, resumeshare.FieldViewCount)
+	}
+	if m.last_viewed_at != nil {
+		fields = append(fields, resumeshare.FieldLastViewedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, resumeshare.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, resumeshare.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResumeShareMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case resumeshare.FieldResumeID:
+		return m.ResumeID()
+	case resumeshare.FieldUserID:
+		return m.UserID()
+	case resumeshare.FieldTenantID:
+		return m.TenantID()
+	case resumeshare.FieldToken:
+		return m.Token()
+	case resumeshare.FieldPermission:
+		return m.Permission()
+	case resumeshare.FieldRedact:
+		return m.Redact()
+	case resumeshare.FieldPasswordHash:
+		return m.PasswordHash()
+	case resumeshare.FieldExpiresAt:
+		return m.ExpiresAt()
+	case resumeshare.FieldRevokedAt:
+		return m.RevokedAt()
+	case resumeshare.FieldViewCount:
+		return m.ViewCount()
+	case resumeshare.FieldLastViewedAt:
+		return m.LastViewedAt()
+	case resumeshare.FieldCreatedAt:
+		return m.CreatedAt()
+	case resumeshare.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResumeShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case resumeshare.FieldResumeID:
+		return m.OldResumeID(ctx)
+	case resumeshare.FieldUserID:
+		return m.OldUserID(ctx)
+	case resumeshare.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case resumeshare.FieldToken:
+		return m.OldToken(ctx)
+	case resumeshare.FieldPermission:
+		return m.OldPermission(ctx)
+	case resumeshare.FieldRedact:
+		return m.OldRedact(ctx)
+	case resumeshare.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case resumeshare.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case resumeshare.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case resumeshare.FieldViewCount:
+		return m.OldViewCount(ctx)
+	case resumeshare.FieldLastViewedAt:
+		return m.OldLastViewedAt(ctx)
+	case resumeshare.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case resumeshare.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ResumeShare field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResumeShareMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case resumeshare.FieldResumeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumeID(v)
+		return nil
+	case resumeshare.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case resumeshare.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case resumeshare.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case resumeshare.FieldPermission:
+		v, ok := value.(resumeshare.Permission)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermission(v)
+		return nil
+	case resumeshare.FieldRedact:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedact(v)
+		return nil
+	case resumeshare.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case resumeshare.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case resumeshare.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case resumeshare.FieldViewCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewCount(v)
+		return nil
+	case resumeshare.FieldLastViewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastViewedAt(v)
+		return nil
+	case resumeshare.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case resumeshare.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShare field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResumeShareMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, resumeshare.FieldUserID)
+	}
+	if m.addview_count != nil {
+		fields = append(fields, resumeshare.FieldViewCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResumeShareMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case resumeshare.FieldUserID:
+		return m.AddedUserID()
+	case resumeshare.FieldViewCount:
+		return m.AddedViewCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResumeShareMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case resumeshare.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case resumeshare.FieldViewCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViewCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShare numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResumeShareMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(resumeshare.FieldPasswordHash) {
+		fields = append(fields, resumeshare.FieldPasswordHash)
+	}
+	if m.FieldCleared(resumeshare.FieldExpiresAt) {
+		fields = append(fields, resumeshare.FieldExpiresAt)
+	}
+	if m.FieldCleared(resumeshare.FieldRevokedAt) {
+		fields = append(fields, resumeshare.FieldRevokedAt)
+	}
+	if m.FieldCleared(resumeshare.FieldLastViewedAt) {
+		fields = append(fields, resumeshare.FieldLastViewedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResumeShareMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResumeShareMutation) ClearField(name string) error {
+	switch name {
+	case resumeshare.FieldPasswordHash:
+		m.ClearPasswordHash()
+		return nil
+	case resumeshare.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case resumeshare.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	case resumeshare.FieldLastViewedAt:
+		m.ClearLastViewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShare nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResumeShareMutation) ResetField(name string) error {
+	switch name {
+	case resumeshare.FieldResumeID:
+		m.ResetResumeID()
+		return nil
+	case resumeshare.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case resumeshare.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case resumeshare.FieldToken:
+		m.ResetToken()
+		return nil
+	case resumeshare.FieldPermission:
+		m.ResetPermission()
+		return nil
+	case resumeshare.FieldRedact:
+		m.ResetRedact()
+		return nil
+	case resumeshare.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case resumeshare.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case resumeshare.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case resumeshare.FieldViewCount:
+		m.ResetViewCount()
+		return nil
+	case resumeshare.FieldLastViewedAt:
+		m.ResetLastViewedAt()
+		return nil
+	case resumeshare.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case resumeshare.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShare field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResumeShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.resume != nil {
+		edges = append(edges, resumeshare.EdgeResume)
+	}
+	if m.views != nil {
+		edges = append(edges, resumeshare.EdgeViews)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResumeShareMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case resumeshare.EdgeResume:
+		if id := m.resume; id != nil {
+			return []ent.Value{*id}
+		}
+	case resumeshare.EdgeViews:
+		ids := make([]ent.Value, 0, len(m.views))
+		for id := range m.views {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResumeShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedviews != nil {
+		edges = append(edges, resumeshare.EdgeViews)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResumeShareMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case resumeshare.EdgeViews:
+		ids := make([]ent.Value, 0, len(m.removedviews))
+		for id := range m.removedviews {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResumeShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedresume {
+		edges = append(edges, resumeshare.EdgeResume)
+	}
+	if m.clearedviews {
+		edges = append(edges, resumeshare.EdgeViews)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResumeShareMutation) EdgeCleared(name string) bool {
+	switch name {
+	case resumeshare.EdgeResume:
+		return m.clearedresume
+	case resumeshare.EdgeViews:
+		return m.clearedviews
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResumeShareMutation) ClearEdge(name string) error {
+	switch name {
+	case resumeshare.EdgeResume:
+		m.ClearResume()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShare unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResumeShareMutation) ResetEdge(name string) error {
+	switch name {
+	case resumeshare.EdgeResume:
+		m.ResetResume()
+		return nil
+	case resumeshare.EdgeViews:
+		m.ResetViews()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShare edge %s", name)
+}
+
+// ResumeShareViewMutation represents an operation that mutates the ResumeShareView nodes in the graph.
+type ResumeShareViewMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	resume_id     *int64
+	addresume_id  *int64
+	action        *resumeshareview.Action
+	referrer      *string
+	user_agent    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	share         *int64
+	clearedshare  bool
+	done          bool
+	oldValue      func(context.Context) (*ResumeShareView, error)
+	predicates    []predicate.ResumeShareView
+}
+
+var _ ent.Mutation = (*ResumeShareViewMutation)(nil)
+
+// resumeshareviewOption allows management of the mutation configuration using functional options.
+type resumeshareviewOption func(*ResumeShareViewMutation)
+
+// newResumeShareViewMutation creates new mutation for the ResumeShareView entity.
+func newResumeShareViewMutation(c config, op Op, opts ...resumeshareviewOption) *ResumeShareViewMutation {
+	m := &ResumeShareViewMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResumeShareView,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withResumeShareViewID sets the ID field of the mutation.
+func withResumeShareViewID(id int64) resumeshareviewOption {
+	return func(m *ResumeShareViewMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ResumeShareView
+		)
+		m.oldValue = func(ctx context.Context) (*ResumeShareView, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ResumeShareView.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withResumeShareView sets the old ResumeShareView of the mutation.
+func withResumeShareView(node *ResumeShareView) resumeshareviewOption {
+	return func(m *ResumeShareViewMutation) {
+		m.oldValue = func(context.Context) (*ResumeShareView, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResumeShareViewMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResumeShareViewMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ResumeShareView entities.
+func (m *ResumeShareViewMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResumeShareViewMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ResumeShareViewMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ResumeShareView.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetShareID sets the "share_id" field.
+func (m *ResumeShareViewMutation) SetShareID(i int64) {
+	m.share = &i
+}
+
+// ShareID returns the value of the "share_id" field in the mutation.
+func (m *ResumeShareViewMutation) ShareID() (r int64, exists bool) {
+	v := m.share
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareID returns the old "share_id" field's value of the ResumeShareView entity.
+// If the ResumeShareView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareViewMutation) OldShareID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareID: %w", err)
+	}
+	return oldValue.ShareID, nil
+}
+
+// ResetShareID resets all changes to the "share_id" field.
+func (m *ResumeShareViewMutation) ResetShareID() {
+	m.share = nil
+}
+
+// SetResumeID sets the "resume_id" field.
+func (m *ResumeShareViewMutation) SetResumeID(i int64) {
+	m.resume_id = &i
+	m.addresume_id = nil
+}
+
+// ResumeID returns the value of the "resume_id" field in the mutation.
+func (m *ResumeShareViewMutation) ResumeID() (r int64, exists bool) {
+	v := m.resume_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumeID returns the old "resume_id" field's value of the ResumeShareView entity.
+// If the ResumeShareView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareViewMutation) OldResumeID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumeID: %w", err)
+	}
+	return oldValue.ResumeID, nil
+}
+
+// AddResumeID adds i to the "resume_id" field.
+func (m *ResumeShareViewMutation) AddResumeID(i int64) {
+	if m.addresume_id != nil {
+		*m.addresume_id += i
+	} else {
+		m.addresume_id = &i
+	}
+}
+
+// AddedResumeID returns the value that was added to the "resume_id" field in this mutation.
+func (m *ResumeShareViewMutation) AddedResumeID() (r int64, exists bool) {
+	v := m.addresume_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResumeID resets all changes to the "resume_id" field.
+func (m *ResumeShareViewMutation) ResetResumeID() {
+	m.resume_id = nil
+	m.addresume_id = nil
+}
+
+// SetAction sets the "action" field.
+func (m *ResumeShareViewMutation) SetAction(r resumeshareview.Action) {
+	m.action = &r
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ResumeShareViewMutation) Action() (r resumeshareview.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ResumeShareView entity.
+// If the ResumeShareView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareViewMutation) OldAction(ctx context.Context) (v resumeshareview.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ResumeShareViewMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetReferrer sets the "referrer" field.
+func (m *ResumeShareViewMutation) SetReferrer(s string) {
+	m.referrer = &s
+}
+
+// Referrer returns the value of the "referrer" field in the mutation.
+func (m *ResumeShareViewMutation) Referrer() (r string, exists bool) {
+	v := m.referrer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferrer returns the old "referrer" field's value of the ResumeShareView entity.
+// If the ResumeShareView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareViewMutation) OldReferrer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferrer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferrer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferrer: %w", err)
+	}
+	return oldValue.Referrer, nil
+}
+
+// ClearReferrer clears the value of the "referrer" field.
+func (m *ResumeShareViewMutation) ClearReferrer() {
+	m.referrer = nil
+	m.clearedFields[resumeshareview.FieldReferrer] = struct{}{}
+}
+
+// ReferrerCleared returns if the "referrer" field was cleared in this mutation.
+func (m *ResumeShareViewMutation) ReferrerCleared() bool {
+	_, ok := m.clearedFields[resumeshareview.FieldReferrer]
+	return ok
+}
+
+// ResetReferrer resets all changes to the "referrer" field.
+func (m *ResumeShareViewMutation) ResetReferrer() {
+	m.referrer = nil
+	delete(m.clearedFields, resumeshareview.FieldReferrer)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *ResumeShareViewMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *ResumeShareViewMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the ResumeShareView entity.
+// If the ResumeShareView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareViewMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *ResumeShareViewMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[resumeshareview.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *ResumeShareViewMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[resumeshareview.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *ResumeShareViewMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, resumeshareview.FieldUserAgent)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ResumeShareViewMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ResumeShareViewMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ResumeShareView entity.
+// If the ResumeShareView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeShareViewMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ResumeShareViewMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearShare clears the "share" edge to the ResumeShare entity.
+func (m *ResumeShareViewMutation) ClearShare() {
+	m.clearedshare = true
+	m.clearedFields[resumeshareview.FieldShareID] = struct{}{}
+}
+
+// ShareCleared reports if the "share" edge to the ResumeShare entity was cleared.
+func (m *ResumeShareViewMutation) ShareCleared() bool {
+	return m.clearedshare
+}
+
+// ShareIDs returns the "share" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ShareID instead. It exists only for internal usage by the builders.
+func (m *ResumeShareViewMutation) ShareIDs() (ids []int64) {
+	if id := m.share; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetShare resets all changes to the "share" edge.
+func (m *ResumeShareViewMutation) ResetShare() {
+	m.share = nil
+	m.clearedshare = false
+}
+
+// Where appends a list predicates to the ResumeShareViewMutation builder.
+func (m *ResumeShareViewMutation) Where(ps ...predicate.ResumeShareView) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ResumeShareViewMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ResumeShareViewMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ResumeShareView, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ResumeShareViewMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ResumeShareViewMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ResumeShareView).
+func (m *ResumeShareViewMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResumeShareViewMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.share != nil {
+		fields = append(fields, resumeshareview.FieldShareID)
+	}
+	if m.resume_id != nil {
+		fields = append(fields, resumeshareview.FieldResumeID)
+	}
+	if m.action != nil {
+		fields = append(fields, resumeshareview.FieldAction)
+	}
+	if m.referrer != nil {
+		fields = append(fields, resumeshareview.FieldReferrer)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, resumeshareview.FieldUserAgent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, resumeshareview.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResumeShareViewMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case resumeshareview.FieldShareID:
+		return m.ShareID()
+	case resumeshareview.FieldResumeID:
+		return m.ResumeID()
+	case resumeshareview.FieldAction:
+		return m.Action()
+	case resumeshareview.FieldReferrer:
+		return m.Referrer()
+	case resumeshareview.FieldUserAgent:
+		return m.UserAgent()
+	case resumeshareview.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResumeShareViewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case resumeshareview.FieldShareID:
+		return m.OldShareID(ctx)
+	case resumeshareview.FieldResumeID:
+		return m.OldResumeID(ctx)
+	case resumeshareview.FieldAction:
+		return m.OldAction(ctx)
+	case resumeshareview.FieldReferrer:
+		return m.OldReferrer(ctx)
+	case resumeshareview.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case resumeshareview.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ResumeShareView field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResumeShareViewMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case resumeshareview.FieldShareID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareID(v)
+		return nil
+	case resumeshareview.FieldResumeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumeID(v)
+		return nil
+	case resumeshareview.FieldAction:
+		v, ok := value.(resumeshareview.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case resumeshareview.FieldReferrer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferrer(v)
+		return nil
+	case resumeshareview.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case resumeshareview.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShareView field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResumeShareViewMutation) AddedFields() []string {
+	var fields []string
+	if m.addresume_id != nil {
+		fields = append(fields, resumeshareview.FieldResumeID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResumeShareViewMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case resumeshareview.FieldResumeID:
+		return m.AddedResumeID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResumeShareViewMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case resumeshareview.FieldResumeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResumeID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShareView numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResumeShareViewMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(resumeshareview.FieldReferrer) {
+		fields = append(fields, resumeshareview.FieldReferrer)
+	}
+	if m.FieldCleared(resumeshareview.FieldUserAgent) {
+		fields = append(fields, resumeshareview.FieldUserAgent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResumeShareViewMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResumeShareViewMutation) ClearField(name string) error {
+	switch name {
+	case resumeshareview.FieldReferrer:
+		m.ClearReferrer()
+		return nil
+	case resumeshareview.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShareView nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResumeShareViewMutation) ResetField(name string) error {
+	switch name {
+	case resumeshareview.FieldShareID:
+		m.ResetShareID()
+		return nil
+	case resumeshareview.FieldResumeID:
+		m.ResetResumeID()
+		return nil
+	case resumeshareview.FieldAction:
+		m.ResetAction()
+		return nil
+	case resumeshareview.FieldReferrer:
+		m.ResetReferrer()
+		return nil
+	case resumeshareview.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case resumeshareview.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShareView field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResumeShareViewMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.share != nil {
+		edges = append(edges, resumeshareview.EdgeShare)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResumeShareViewMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case resumeshareview.EdgeShare:
+		if id := m.share; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResumeShareViewMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResumeShareViewMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResumeShareViewMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedshare {
+		edges = append(edges, resumeshareview.EdgeShare)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResumeShareViewMutation) EdgeCleared(name string) bool {
+	switch name {
+	case resumeshareview.EdgeShare:
+		return m.clearedshare
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResumeShareViewMutation) ClearEdge(name string) error {
+	switch name {
+	case resumeshareview.EdgeShare:
+		m.ClearShare()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShareView unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResumeShareViewMutation) ResetEdge(name string) error {
+	switch name {
+	case resumeshareview.EdgeShare:
+		m.ResetShare()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeShareView edge %s", name)
+}
+
+// ResumeSlotMutation represents an operation that mutates the ResumeSlot nodes in the graph.
+type ResumeSlotMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	user_id       *string
+	max_slots     *int32
+	addmax_slots  *int32
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ResumeSlot, error)
+	predicates    []predicate.ResumeSlot
+}
+
+var _ ent.Mutation = (*ResumeSlotMutation)(nil)
+
+// resumeslotOption allows management of the mutation configuration using functional options.
+type resumeslotOption func(*ResumeSlotMutation)
+
+// newResumeSlotMutation creates new mutation for the ResumeSlot entity.
+func newResumeSlotMutation(c config, op Op, opts ...resumeslotOption) *ResumeSlotMutation {
+	m := &ResumeSlotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResumeSlot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withResumeSlotID sets the ID field of the mutation.
+func withResumeSlotID(id int64) resumeslotOption {
+	return func(m *ResumeSlotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ResumeSlot
+		)
+		m.oldValue = func(ctx context.Context) (*ResumeSlot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ResumeSlot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withResumeSlot sets the old ResumeSlot of the mutation.
+func withResumeSlot(node *ResumeSlot) resumeslotOption {
+	return func(m *ResumeSlotMutation) {
+		m.oldValue = func(context.Context) (*ResumeSlot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResumeSlotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResumeSlotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ResumeSlot entities.
+func (m *ResumeSlotMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResumeSlotMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ResumeSlotMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ResumeSlot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ResumeSlotMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ResumeSlotMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ResumeSlot entity.
+// If the ResumeSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeSlotMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ResumeSlotMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetMaxSlots sets the "max_slots" field.
+func (m *ResumeSlotMutation) SetMaxSlots(i int32) {
+	m.max_slots = &i
+	m.addmax_slots = nil
+}
+
+// MaxSlots returns the value of the "max_slots" field in the mutation.
+func (m *ResumeSlotMutation) MaxSlots() (r int32, exists bool) {
+	v := m.max_slots
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxSlots returns the old "max_slots" field's value of the ResumeSlot entity.
+// If the ResumeSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeSlotMutation) OldMaxSlots(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxSlots is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxSlots requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxSlots: %w", err)
+	}
+	return oldValue.MaxSlots, nil
+}
+
+// AddMaxSlots adds i to the "max_slots" field.
+func (m *ResumeSlotMutation) AddMaxSlots(i int32) {
+	if m.addmax_slots != nil {
+		*m.addmax_slots += i
+	} else {
+		m.addmax_slots = &i
+	}
+}
+
+// AddedMaxSlots returns the value that was added to the "max_slots" field in this mutation.
+func (m *ResumeSlotMutation) AddedMaxSlots() (r int32, exists bool) {
+	v := m.addmax_slots
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxSlots resets all changes to the "max_slots" field.
+func (m *ResumeSlotMutation) ResetMaxSlots() {
+	m.max_slots = nil
+	m.addmax_slots = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ResumeSlotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ResumeSlotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ResumeSlot entity.
+// If the ResumeSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeSlotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ResumeSlotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ResumeSlotMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ResumeSlotMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ResumeSlot entity.
+// If the ResumeSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeSlotMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ResumeSlotMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ResumeSlotMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ResumeSlotMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the ResumeSlot entity.
+// If the ResumeSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumeSlotMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ResumeSlotMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[resumeslot.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ResumeSlotMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[resumeslot.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ResumeSlotMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, resumeslot.FieldDeletedAt)
+}
+
+// Where appends a list predicates to the ResumeSlotMutation builder.
+func (m *ResumeSlotMutation) Where(ps ...predicate.ResumeSlot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ResumeSlotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ResumeSlotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ResumeSlot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ResumeSlotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ResumeSlotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ResumeSlot).
+func (m *ResumeSlotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResumeSlotMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, resumeslot.FieldUserID)
+	}
+	if m.max_slots != nil {
+		fields = append(fields, resumeslot.FieldMaxSlots)
+	}
+	if m.created_at != nil {
+		fields = append(fields, resumeslot.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, resumeslot.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, resumeslot.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResumeSlotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case resumeslot.FieldUserID:
+		return m.UserID()
+	case resumeslot.FieldMaxSlots:
+		return m.MaxSlots()
+	case resumeslot.FieldCreatedAt:
+		return m.CreatedAt()
+	case resumeslot.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case resumeslot.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResumeSlotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case resumeslot.FieldUserID:
+		return m.OldUserID(ctx)
+	case resumeslot.FieldMaxSlots:
+		return m.OldMaxSlots(ctx)
+	case resumeslot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case resumeslot.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case resumeslot.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ResumeSlot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResumeSlotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case resumeslot.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case resumeslot.FieldMaxSlots:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxSlots(v)
+		return nil
+	case resumeslot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case resumeslot.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case resumeslot.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeSlot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResumeSlotMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_slots != nil {
+		fields = append(fields, resumeslot.FieldMaxSlots)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResumeSlotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case resumeslot.FieldMaxSlots:
+		return m.AddedMaxSlots()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResumeSlotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case resumeslot.FieldMaxSlots:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxSlots(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeSlot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResumeSlotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(resumeslot.FieldDeletedAt) {
+		fields = append(fields, resumeslot.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResumeSlotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResumeSlotMutation) ClearField(name string) error {
+	switch name {
+	case resumeslot.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeSlot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResumeSlotMutation) ResetField(name string) error {
+	switch name {
+	case resumeslot.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case resumeslot.FieldMaxSlots:
+		m.ResetMaxSlots()
+		return nil
+	case resumeslot.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case resumeslot.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case resumeslot.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumeSlot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResumeSlotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResumeSlotMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResumeSlotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResumeSlotMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResumeSlotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResumeSlotMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResumeSlotMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ResumeSlot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResumeSlotMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ResumeSlot edge %s", name)
+}
+
+// ScoreHistoryMutation represents an operation that mutates the ScoreHistory nodes in the graph.
+type ScoreHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	resume_id     *int64
+	addresume_id  *int64
+	module_id     *int64
+	addmodule_id  *int64
+	title         *string
+	score         *float64
+	addscore      *float64
+	weight        *float64
+	addweight     *float64
+	rescored      *bool
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	run           *int64
+	clearedrun    bool
+	done          bool
+	oldValue      func(context.Context) (*ScoreHistory, error)
+	predicates    []predicate.ScoreHistory
+}
+
+var _ ent.Mutation = (*ScoreHistoryMutation)(nil)
+
+// scorehistoryOption allows management of the mutation configuration using functional options.
+type scorehistoryOption func(*ScoreHistoryMutation)
+
+// newScoreHistoryMutation creates new mutation for the ScoreHistory entity.
+func newScoreHistoryMutation(c config, op Op, opts ...scorehistoryOption) *ScoreHistoryMutation {
+	m := &ScoreHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScoreHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScoreHistoryID sets the ID field of the mutation.
+func withScoreHistoryID(id int64) scorehistoryOption {
+	return func(m *ScoreHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScoreHistory
+		)
+		m.oldValue = func(ctx context.Context) (*ScoreHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScoreHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScoreHistory sets the old ScoreHistory of the mutation.
+func withScoreHistory(node *ScoreHistory) scorehistoryOption {
+	return func(m *ScoreHistoryMutation) {
+		m.oldValue = func(context.Context) (*ScoreHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScoreHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScoreHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScoreHistory entities.
+func (m *ScoreHistoryMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScoreHistoryMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScoreHistoryMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScoreHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRunID sets the "run_id" field.
+func (m *ScoreHistoryMutation) SetRunID(i int64) {
+	m.run = &i
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *ScoreHistoryMutation) RunID() (r int64, exists bool) {
+	v := m.run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the ScoreHistory entity.
+// If the ScoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreHistoryMutation) OldRunID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *ScoreHistoryMutation) ResetRunID() {
+	m.run = nil
+}
+
+// SetResumeID sets the "resume_id" field.
+func (m *ScoreHistoryMutation) SetResumeID(i int64) {
+	m.resume_id = &i
+	m.addresume_id = nil
+}
+
+// ResumeID returns the value of the "resume_id" field in the mutation.
+func (m *ScoreHistoryMutation) ResumeID() (r int64, exists bool) {
+	v := m.resume_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumeID returns the old "resume_id" field's value of the ScoreHistory entity.
+// If the ScoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreHistoryMutation) OldResumeID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumeID: %w", err)
+	}
+	return oldValue.ResumeID, nil
+}
+
+// AddResumeID adds i to the "resume_id" field.
+func (m *ScoreHistoryMutation) AddResumeID(i int64) {
+	if m.addresume_id != nil {
+		*m.addresume_id += i
+	} else {
+		m.addresume_id = &i
+	}
+}
+
+// AddedResumeID returns the value that was added to the "resume_id" field in this mutation.
+func (m *ScoreHistoryMutation) AddedResumeID() (r int64, exists bool) {
+	v := m.addresume_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResumeID resets all changes to the "resume_id" field.
+func (m *ScoreHistoryMutation) ResetResumeID() {
+	m.resume_id = nil
+	m.addresume_id = nil
+}
+
+// SetModuleID sets the "module_id" field.
+func (m *ScoreHistoryMutation) SetModuleID(i int64) {
+	m.module_id = &i
+	m.addmodule_id = nil
+}
+
+// ModuleID returns the value of the "module_id" field in the mutation.
+func (m *ScoreHistoryMutation) ModuleID() (r int64, exists bool) {
+	v := m.module_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModuleID returns the old "module_id" field's value of the ScoreHistory entity.
+// If the ScoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreHistoryMutation) OldModuleID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModuleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModuleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModuleID: %w", err)
+	}
+	return oldValue.ModuleID, nil
+}
+
+// AddModuleID adds i to the "module_id" field.
+func (m *ScoreHistoryMutation) AddModuleID(i int64) {
+	if m.addmodule_id != nil {
+		*m.addmodule_id += i
+	} else {
+		m.addmodule_id = &i
+	}
+}
+
+// AddedModuleID returns the value that was added to the "module_id" field in this mutation.
+func (m *ScoreHistoryMutation) AddedModuleID() (r int64, exists bool) {
+	v := m.addmodule_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetModuleID resets all changes to the "module_id" field.
+func (m *ScoreHistoryMutation) ResetModuleID() {
+	m.module_id = nil
+	m.addmodule_id = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ScoreHistoryMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ScoreHistoryMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the ScoreHistory entity.
+// If the ScoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreHistoryMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ScoreHistoryMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetScore sets the "score" field.
+func (m *ScoreHistoryMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *ScoreHistoryMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the ScoreHistory entity.
+// If the ScoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreHistoryMutation) OldScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *ScoreHistoryMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *ScoreHistoryMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *ScoreHistoryMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetWeight sets the "weight" field.
+func (m *ScoreHistoryMutation) SetWeight(f float64) {
+	m.weight = &f
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *ScoreHistoryMutation) Weight() (r float64, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the ScoreHistory entity.
+// If the ScoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreHistoryMutation) OldWeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
+}
+
+// AddWeight adds f to the "weight" field.
+func (m *ScoreHistoryMutation) AddWeight(f float64) {
+	if m.addweight != nil {
+		*m.addweight += f
+	} else {
+		m.addweight = &f
+	}
+}
+
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *ScoreHistoryMutation) AddedWeight() (r float64, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeight resets all changes to the "weight" field.
+func (m *ScoreHistoryMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+}
+
+// SetRescored sets the "rescored" field.
+func (m *ScoreHistoryMutation) SetRescored(b bool) {
+	m.rescored = &b
+}
+
+// Rescored returns the value of the "rescored" field in the mutation.
+func (m *ScoreHistoryMutation) Rescored() (r bool, exists bool) {
+	v := m.rescored
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRescored returns the old "rescored" field's value of the ScoreHistory entity.
+// If the ScoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreHistoryMutation) OldRescored(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRescored is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRescored requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRescored: %w", err)
+	}
+	return oldValue.Rescored, nil
+}
+
+// ResetRescored resets all changes to the "rescored" field.
+func (m *ScoreHistoryMutation) ResetRescored() {
+	m.rescored = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ScoreHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ScoreHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ScoreHistory entity.
+// If the ScoreHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ScoreHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearRun clears the "run" edge to the ScoreRun entity.
+func (m *ScoreHistoryMutation) ClearRun() {
+	m.clearedrun = true
+	m.clearedFields[scorehistory.FieldRunID] = struct{}{}
+}
+
+// RunCleared reports if the "run" edge to the ScoreRun entity was cleared.
+func (m *ScoreHistoryMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *ScoreHistoryMutation) RunIDs() (ids []int64) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *ScoreHistoryMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// Where appends a list predicates to the ScoreHistoryMutation builder.
+func (m *ScoreHistoryMutation) Where(ps ...predicate.ScoreHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScoreHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScoreHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScoreHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScoreHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScoreHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScoreHistory).
+func (m *ScoreHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScoreHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.run != nil {
+		fields = append(fields, scorehistory.FieldRunID)
+	}
+	if m.resume_id != nil {
+		fields = append(fields, scorehistory.FieldResumeID)
+	}
+	if m.module_id != nil {
+		fields = append(fields, scorehistory.FieldModuleID)
+	}
+	if m.title != nil {
+		fields = append(fields, scorehistory.FieldTitle)
+	}
+	if m.score != nil {
+		fields = append(fields, scorehistory.FieldScore)
+	}
+	if m.weight != nil {
+		fields = append(fields, scorehistory.FieldWeight)
+	}
+	if m.rescored != nil {
+		fields = append(fields, scorehistory.FieldRescored)
+	}
+	if m.created_at != nil {
+		fields = append(fields, scorehistory.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScoreHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scorehistory.FieldRunID:
+		return m.RunID()
+	case scorehistory.FieldResumeID:
+		return m.ResumeID()
+	case scorehistory.FieldModuleID:
+		return m.ModuleID()
+	case scorehistory.FieldTitle:
+		return m.Title()
+	case scorehistory.FieldScore:
+		return m.Score()
+	case scorehistory.FieldWeight:
+		return m.Weight()
+	case scorehistory.FieldRescored:
+		return m.Rescored()
+	case scorehistory.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScoreHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scorehistory.FieldRunID:
+		return m.OldRunID(ctx)
+	case scorehistory.FieldResumeID:
+		return m.OldResumeID(ctx)
+	case scorehistory.FieldModuleID:
+		return m.OldModuleID(ctx)
+	case scorehistory.FieldTitle:
+		return m.OldTitle(ctx)
+	case scorehistory.FieldScore:
+		return m.OldScore(ctx)
+	case scorehistory.FieldWeight:
+		return m.OldWeight(ctx)
+	case scorehistory.FieldRescored:
+		return m.OldRescored(ctx)
+	case scorehistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScoreHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScoreHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scorehistory.FieldRunID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	case scorehistory.FieldResumeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumeID(v)
+		return nil
+	case scorehistory.FieldModuleID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModuleID(v)
+		return nil
+	case scorehistory.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case scorehistory.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case scorehistory.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeight(v)
+		return nil
+	case scorehistory.FieldRescored:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRescored(v)
+		return nil
+	case scorehistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScoreHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScoreHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addresume_id != nil {
+		fields = append(fields, scorehistory.FieldResumeID)
+	}
+	if m.addmodule_id != nil {
+		fields = append(fields, scorehistory.FieldModuleID)
+	}
+	if m.addscore != nil {
+		fields = append(fields, scorehistory.FieldScore)
+	}
+	if m.addweight != nil {
+		fields = append(fields, scorehistory.FieldWeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScoreHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case scorehistory.FieldResumeID:
+		return m.AddedResumeID()
+	case scorehistory.FieldModuleID:
+		return m.AddedModuleID()
+	case scorehistory.FieldScore:
+		return m.AddedScore()
+	case scorehistory.FieldWeight:
+		return m.AddedWeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScoreHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case scorehistory.FieldResumeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResumeID(v)
+		return nil
+	case scorehistory.FieldModuleID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModuleID(v)
+		return nil
+	case scorehistory.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case scorehistory.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScoreHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScoreHistoryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScoreHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScoreHistoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ScoreHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScoreHistoryMutation) ResetField(name string) error {
+	switch name {
+	case scorehistory.FieldRunID:
+		m.ResetRunID()
+		return nil
+	case scorehistory.FieldResumeID:
+		m.ResetResumeID()
+		return nil
+	case scorehistory.FieldModuleID:
+		m.ResetModuleID()
+		return nil
+	case scorehistory.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case scorehistory.FieldScore:
+		m.ResetScore()
+		return nil
+	case scorehistory.FieldWeight:
+		m.ResetWeight()
+		return nil
+	case scorehistory.FieldRescored:
+		m.ResetRescored()
+		return nil
+	case scorehistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ScoreHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScoreHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.run != nil {
+		edges = append(edges, scorehistory.EdgeRun)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScoreHistoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scorehistory.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScoreHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScoreHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScoreHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrun {
+		edges = append(edges, scorehistory.EdgeRun)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScoreHistoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scorehistory.EdgeRun:
+		return m.clearedrun
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScoreHistoryMutation) ClearEdge(name string) error {
+	switch name {
+	case scorehistory.EdgeRun:
+		m.ClearRun()
+		return nil
+	}
+	return fmt.Errorf("unknown ScoreHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScoreHistoryMutation) ResetEdge(name string) error {
+	switch name {
+	case scorehistory.EdgeRun:
+		m.ResetRun()
+		return nil
+	}
+	return fmt.Errorf("unknown ScoreHistory edge %s", name)
+}
+
+// ScoreRunMutation represents an operation that mutates the ScoreRun nodes in the graph.
+type ScoreRunMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int64
+	trigger        *scorerun.Trigger
+	rule_version   *string
+	total_score    *float64
+	addtotal_score *float64
+	status         *scorerun.Status
+	failures       *[]map[string]string
+	appendfailures []map[string]string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	resume         *int64
+	clearedresume  bool
+	modules        map[int64]struct{}
+	removedmodules map[int64]struct{}
+	clearedmodules bool
+	done           bool
+	oldValue       func(context.Context) (*ScoreRun, error)
+	predicates     []predicate.ScoreRun
+}
+
+var _ ent.Mutation = (*ScoreRunMutation)(nil)
+
+// scorerunOption allows management of the mutation configuration using functional options.
+type scorerunOption func(*ScoreRunMutation)
+
+// newScoreRunMutation creates new mutation for the ScoreRun entity.
+func newScoreRunMutation(c config, op Op, opts ...scorerunOption) *ScoreRunMutation {
+	m := &ScoreRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScoreRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScoreRunID sets the ID field of the mutation.
+func withScoreRunID(id int64) scorerunOption {
+	return func(m *ScoreRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScoreRun
+		)
+		m.oldValue = func(ctx context.Context) (*ScoreRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScoreRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScoreRun sets the old ScoreRun of the mutation.
+func withScoreRun(node *ScoreRun) scorerunOption {
+	return func(m *ScoreRunMutation) {
+		m.oldValue = func(context.Context) (*ScoreRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScoreRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScoreRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScoreRun entities.
+func (m *ScoreRunMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScoreRunMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScoreRunMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScoreRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetResumeID sets the "resume_id" field.
+func (m *ScoreRunMutation) SetResumeID(i int64) {
+	m.resume = &i
+}
+
+// ResumeID returns the value of the "resume_id" field in the mutation.
+func (m *ScoreRunMutation) ResumeID() (r int64, exists bool) {
+	v := m.resume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumeID returns the old "resume_id" field's value of the ScoreRun entity.
+// If the ScoreRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreRunMutation) OldResumeID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumeID: %w", err)
+	}
+	return oldValue.ResumeID, nil
+}
+
+// ResetResumeID resets all changes to the "resume_id" field.
+func (m *ScoreRunMutation) ResetResumeID() {
+	m.resume = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *ScoreRunMutation) SetTrigger(s scorerun.Trigger) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *ScoreRunMutation) Trigger() (r scorerun.Trigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the ScoreRun entity.
+// If the ScoreRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreRunMutation) OldTrigger(ctx context.Context) (v scorerun.Trigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *ScoreRunMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetRuleVersion sets the "rule_version" field.
+func (m *ScoreRunMutation) SetRuleVersion(s string) {
+	m.rule_version = &s
+}
+
+// RuleVersion returns the value of the "rule_version" field in the mutation.
+func (m *ScoreRunMutation) RuleVersion() (r string, exists bool) {
+	v := m.rule_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleVersion returns the old "rule_version" field's value of the ScoreRun entity.
+// If the ScoreRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreRunMutation) OldRuleVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleVersion: %w", err)
+	}
+	return oldValue.RuleVersion, nil
+}
+
+// ResetRuleVersion resets all changes to the "rule_version" field.
+func (m *ScoreRunMutation) ResetRuleVersion() {
+	m.rule_version = nil
+}
+
+// SetTotalScore sets the "total_score" field.
+func (m *ScoreRunMutation) SetTotalScore(f float64) {
+	m.total_score = &f
+	m.addtotal_score = nil
+}
+
+// TotalScore returns the value of the "total_score" field in the mutation.
+func (m *ScoreRunMutation) TotalScore() (r float64, exists bool) {
+	v := m.total_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalScore returns the old "total_score" field's value of the ScoreRun entity.
+// If the ScoreRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreRunMutation) OldTotalScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalScore: %w", err)
+	}
+	return oldValue.TotalScore, nil
+}
+
+// AddTotalScore adds f to the "total_score" field.
+func (m *ScoreRunMutation) AddTotalScore(f float64) {
+	if m.addtotal_score != nil {
+		*m.addtotal_score += f
+	} else {
+		m.addtotal_score = &f
+	}
+}
+
+// AddedTotalScore returns the value that was added to the "total_score" field in this mutation.
+func (m *ScoreRunMutation) AddedTotalScore() (r float64, exists bool) {
+	v := m.addtotal_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalScore resets all changes to the "total_score" field.
+func (m *ScoreRunMutation) ResetTotalScore() {
+	m.total_score = nil
+	m.addtotal_score = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ScoreRunMutation) SetStatus(s scorerun.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ScoreRunMutation) Status() (r scorerun.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ScoreRun entity.
+// If the ScoreRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreRunMutation) OldStatus(ctx context.Context) (v scorerun.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ScoreRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetFailures sets the "failures" field.
+func (m *ScoreRunMutation) SetFailures(value []map[string]string) {
+	m.failures = &value
+	m.appendfailures = nil
+}
+
+// Failures returns the value of the "failures" field in the mutation.
+func (m *ScoreRunMutation) Failures() (r []map[string]string, exists bool) {
+	v := m.failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailures returns the old "failures" field's value of the ScoreRun entity.
+// If the ScoreRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreRunMutation) OldFailures(ctx context.Context) (v []map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailures: %w", err)
+	}
+	return oldValue.Failures, nil
+}
+
+// AppendFailures adds value to the "failures" field.
+func (m *ScoreRunMutation) AppendFailures(value []map[string]string) {
+	m.appendfailures = append(m.appendfailures, value...)
+}
+
+// AppendedFailures returns the list of values that were appended to the "failures" field in this mutation.
+func (m *ScoreRunMutation) AppendedFailures() ([]map[string]string, bool) {
+	if len(m.appendfailures) == 0 {
+		return nil, false
+	}
+	return m.appendfailures, true
+}
+
+// ClearFailures clears the value of the "failures" field.
+func (m *ScoreRunMutation) ClearFailures() {
+	m.failures = nil
+	m.appendfailures = nil
+	m.clearedFields[scorerun.FieldFailures] = struct{}{}
+}
+
+// FailuresCleared returns if the "failures" field was cleared in this mutation.
+func (m *ScoreRunMutation) FailuresCleared() bool {
+	_, ok := m.clearedFields[scorerun.FieldFailures]
+	return ok
+}
+
+// ResetFailures resets all changes to the "failures" field.
+func (m *ScoreRunMutation) ResetFailures() {
+	m.failures = nil
+	m.appendfailures = nil
+	delete(m.clearedFields, scorerun.FieldFailures)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ScoreRunMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ScoreRunMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ScoreRun entity.
+// If the ScoreRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScoreRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ScoreRunMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearResume clears the "resume" edge to the Resume entity.
+func (m *ScoreRunMutation) ClearResume() {
+	m.clearedresume = true
+	m.clearedFields[scorerun.FieldResumeID] = struct{}{}
+}
+
+// ResumeCleared reports if the "resume" edge to the Resume entity was cleared.
+func (m *ScoreRunMutation) ResumeCleared() bool {
+	return m.clearedresume
+}
+
+// ResumeIDs returns the "resume" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResumeID instead. It exists only for internal usage by the builders.
+func (m *ScoreRunMutation) ResumeIDs() (ids []int64) {
+	if id := m.resume; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResume resets all changes to the "resume" edge.
+func (m *ScoreRunMutation) ResetResume() {
+	m.resume = nil
+	m.clearedresume = false
+}
+
+// AddModuleIDs adds the "modules" edge to the ScoreHistory entity by ids.
+func (m *ScoreRunMutation) AddModuleIDs(ids ...int64) {
+	if m.modules == nil {
+		m.modules = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.modules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearModules clears the "modules" edge to the ScoreHistory entity.
+func (m *ScoreRunMutation) ClearModules() {
+	m.clearedmodules = true
+}
+
+// ModulesCleared reports if the "modules" edge to the ScoreHistory entity was cleared.
+func (m *ScoreRunMutation) ModulesCleared() bool {
+	return m.clearedmodules
+}
+
+// RemoveModuleIDs removes the "modules" edge to the ScoreHistory entity by IDs.
+func (m *ScoreRunMutation) RemoveModuleIDs(ids ...int64) {
+	if m.removedmodules == nil {
+		m.removedmodules = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.modules, ids[i])
+		m.removedmodules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedModules returns the removed IDs of the "modules" edge to the ScoreHistory entity.
+func (m *ScoreRunMutation) RemovedModulesIDs() (ids []int64) {
+	for id := range m.removedmodules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ModulesIDs returns the "modules" edge IDs in the mutation.
+func (m *ScoreRunMutation) ModulesIDs() (ids []int64) {
+	for id := range m.modules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetModules resets all changes to the "modules" edge.
+func (m *ScoreRunMutation) ResetModules() {
+	m.modules = nil
+	m.clearedmodules = false
+	m.removedmodules = nil
+}
+
+// Where appends a list predicates to the ScoreRunMutation builder.
+func (m *ScoreRunMutation) Where(ps ...predicate.ScoreRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScoreRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScoreRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScoreRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScoreRunMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ResumeSlotMutation) SetOp(op Op) {
+func (m *ScoreRunMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ResumeSlot).
-func (m *ResumeSlotMutation) Type() string {
+// Type returns the node type of this mutation (ScoreRun).
+func (m *ScoreRunMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ResumeSlotMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.user_id != nil {
-		fields = append(fields, resumeslot.FieldUserID)
+func (m *ScoreRunMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.resume != nil {
+		fields = append(fields, scorerun.FieldResumeID)
 	}
-	if m.max_slots != nil {
-		fields = append(fields, resumeslot.FieldMaxSlots)
+	if m.trigger != nil {
+		fields = append(fields, scorerun.FieldTrigger)
 	}
-	if m.created_at != nil {
-		fields = append(fields, resumeslot.FieldCreatedAt)
+	if m.rule_version != nil {
+		fields = append(fields, scorerun.FieldRuleVersion)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, resumeslot.FieldUpdatedAt)
+	if m.total_score != nil {
+		fields = append(fields, scorerun.FieldTotalScore)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, resumeslot.FieldDeletedAt)
+	if m.status != nil {
+		fields = append(fields, scorerun.FieldStatus)
+	}
+	if m.failures != nil {
+		fields = append(fields, scorerun.FieldFailures)
+	}
+	if m.created_at != nil {
+		fields = append(fields, scorerun.FieldCreatedAt)
 	}
 	return fields
 }
@@ -6662,18 +11417,22 @@ func (m *ResumeSlotMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ResumeSlotMutation) Field(name string) (ent.Value, bool) {
+func (m *ScoreRunMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case resumeslot.FieldUserID:
-		return m.UserID()
-	case resumeslot.FieldMaxSlots:
-		return m.MaxSlots()
-	case resumeslot.FieldCreatedAt:
+	case scorerun.FieldResumeID:
+		return m.ResumeID()
+	case scorerun.FieldTrigger:
+		return m.Trigger()
+	case scorerun.FieldRuleVersion:
+		return m.RuleVersion()
+	case scorerun.FieldTotalScore:
+		return m.TotalScore()
+	case scorerun.FieldStatus:
+		return m.Status()
+	case scorerun.FieldFailures:
+		return m.Failures()
+	case scorerun.FieldCreatedAt:
 		return m.CreatedAt()
-	case resumeslot.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case resumeslot.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -6681,72 +11440,90 @@ func (m *ResumeSlotMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ResumeSlotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ScoreRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case resumeslot.FieldUserID:
-		return m.OldUserID(ctx)
-	case resumeslot.FieldMaxSlots:
-		return m.OldMaxSlots(ctx)
-	case resumeslot.FieldCreatedAt:
+	case scorerun.FieldResumeID:
+		return m.OldResumeID(ctx)
+	case scorerun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case scorerun.FieldRuleVersion:
+		return m.OldRuleVersion(ctx)
+	case scorerun.FieldTotalScore:
+		return m.OldTotalScore(ctx)
+	case scorerun.FieldStatus:
+		return m.OldStatus(ctx)
+	case scorerun.FieldFailures:
+		return m.OldFailures(ctx)
+	case scorerun.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case resumeslot.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case resumeslot.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ResumeSlot field %s", name)
+	return nil, fmt.Errorf("unknown ScoreRun field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ResumeSlotMutation) SetField(name string, value ent.Value) error {
+func (m *ScoreRunMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case resumeslot.FieldUserID:
+	case scorerun.FieldResumeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumeID(v)
+		return nil
+	case scorerun.FieldTrigger:
+		v, ok := value.(scorerun.Trigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case scorerun.FieldRuleVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetRuleVersion(v)
 		return nil
-	case resumeslot.FieldMaxSlots:
-		v, ok := value.(int32)
+	case scorerun.FieldTotalScore:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxSlots(v)
+		m.SetTotalScore(v)
 		return nil
-	case resumeslot.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case scorerun.FieldStatus:
+		v, ok := value.(scorerun.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetStatus(v)
 		return nil
-	case resumeslot.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case scorerun.FieldFailures:
+		v, ok := value.([]map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetFailures(v)
 		return nil
-	case resumeslot.FieldDeletedAt:
+	case scorerun.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ResumeSlot field %s", name)
+	return fmt.Errorf("unknown ScoreRun field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ResumeSlotMutation) AddedFields() []string {
+func (m *ScoreRunMutation) AddedFields() []string {
 	var fields []string
-	if m.addmax_slots != nil {
-		fields = append(fields, resumeslot.FieldMaxSlots)
+	if m.addtotal_score != nil {
+		fields = append(fields, scorerun.FieldTotalScore)
 	}
 	return fields
 }
@@ -6754,10 +11531,10 @@ func (m *ResumeSlotMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ResumeSlotMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ScoreRunMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case resumeslot.FieldMaxSlots:
-		return m.AddedMaxSlots()
+	case scorerun.FieldTotalScore:
+		return m.AddedTotalScore()
 	}
 	return nil, false
 }
@@ -6765,114 +11542,174 @@ func (m *ResumeSlotMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ResumeSlotMutation) AddField(name string, value ent.Value) error {
+func (m *ScoreRunMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case resumeslot.FieldMaxSlots:
-		v, ok := value.(int32)
+	case scorerun.FieldTotalScore:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxSlots(v)
+		m.AddTotalScore(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ResumeSlot numeric field %s", name)
+	return fmt.Errorf("unknown ScoreRun numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ResumeSlotMutation) ClearedFields() []string {
+func (m *ScoreRunMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(resumeslot.FieldDeletedAt) {
-		fields = append(fields, resumeslot.FieldDeletedAt)
+	if m.FieldCleared(scorerun.FieldFailures) {
+		fields = append(fields, scorerun.FieldFailures)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ResumeSlotMutation) FieldCleared(name string) bool {
+func (m *ScoreRunMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ResumeSlotMutation) ClearField(name string) error {
+func (m *ScoreRunMutation) ClearField(name string) error {
 	switch name {
-	case resumeslot.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case scorerun.FieldFailures:
+		m.ClearFailures()
 		return nil
 	}
-	return fmt.Errorf("unknown ResumeSlot nullable field %s", name)
+	return fmt.Errorf("unknown ScoreRun nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ResumeSlotMutation) ResetField(name string) error {
+func (m *ScoreRunMutation) ResetField(name string) error {
 	switch name {
-	case resumeslot.FieldUserID:
-		m.ResetUserID()
+	case scorerun.FieldResumeID:
+		m.ResetResumeID()
 		return nil
-	case resumeslot.FieldMaxSlots:
-		m.ResetMaxSlots()
+	case scorerun.FieldTrigger:
+		m.ResetTrigger()
 		return nil
-	case resumeslot.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case scorerun.FieldRuleVersion:
+		m.ResetRuleVersion()
 		return nil
-	case resumeslot.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case scorerun.FieldTotalScore:
+		m.ResetTotalScore()
 		return nil
-	case resumeslot.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case scorerun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case scorerun.FieldFailures:
+		m.ResetFailures()
+		return nil
+	case scorerun.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ResumeSlot field %s", name)
+	return fmt.Errorf("unknown ScoreRun field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ResumeSlotMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ScoreRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.resume != nil {
+		edges = append(edges, scorerun.EdgeResume)
+	}
+	if m.modules != nil {
+		edges = append(edges, scorerun.EdgeModules)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ResumeSlotMutation) AddedIDs(name string) []ent.Value {
+func (m *ScoreRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scorerun.EdgeResume:
+		if id := m.resume; id != nil {
+			return []ent.Value{*id}
+		}
+	case scorerun.EdgeModules:
+		ids := make([]ent.Value, 0, len(m.modules))
+		for id := range m.modules {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ResumeSlotMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ScoreRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmodules != nil {
+		edges = append(edges, scorerun.EdgeModules)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ResumeSlotMutation) RemovedIDs(name string) []ent.Value {
+func (m *ScoreRunMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case scorerun.EdgeModules:
+		ids := make([]ent.Value, 0, len(m.removedmodules))
+		for id := range m.removedmodules {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ResumeSlotMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ScoreRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedresume {
+		edges = append(edges, scorerun.EdgeResume)
+	}
+	if m.clearedmodules {
+		edges = append(edges, scorerun.EdgeModules)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ResumeSlotMutation) EdgeCleared(name string) bool {
+func (m *ScoreRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scorerun.EdgeResume:
+		return m.clearedresume
+	case scorerun.EdgeModules:
+		return m.clearedmodules
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ResumeSlotMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ResumeSlot unique edge %s", name)
+func (m *ScoreRunMutation) ClearEdge(name string) error {
+	switch name {
+	case scorerun.EdgeResume:
+		m.ClearResume()
+		return nil
+	}
+	return fmt.Errorf("unknown ScoreRun unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ResumeSlotMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ResumeSlot edge %s", name)
+func (m *ScoreRunMutation) ResetEdge(name string) error {
+	switch name {
+	case scorerun.EdgeResume:
+		m.ResetResume()
+		return nil
+	case scorerun.EdgeModules:
+		m.ResetModules()
+		return nil
+	}
+	return fmt.Errorf("unknown ScoreRun edge %s", name)
 }
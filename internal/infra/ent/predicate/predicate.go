@@ -30,5 +30,17 @@ type Resume func(*sql.Selector)
 // ResumeScore is the predicate function for resumescore builders.
 type ResumeScore func(*sql.Selector)
 
+// ResumeShare is the predicate function for resumeshare builders.
+type ResumeShare func(*sql.Selector)
+
+// ResumeShareView is the predicate function for resumeshareview builders.
+type ResumeShareView func(*sql.Selector)
+
 // ResumeSlot is the predicate function for resumeslot builders.
 type ResumeSlot func(*sql.Selector)
+
+// ScoreHistory is the predicate function for scorehistory builders.
+type ScoreHistory func(*sql.Selector)
+
+// ScoreRun is the predicate function for scorerun builders.
+type ScoreRun func(*sql.Selector)
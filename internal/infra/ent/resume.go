@@ -18,24 +18,32 @@ type Resume struct {
 	// ID of the ent.
 	// 简历ID
 	ID int64 `json:"id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// 用户ID
 	UserID int64 `json:"user_id,omitempty"`
 	// 租户ID
-	TenantID int64 `json:"tenant_id,omitempty"`
+	TenantID string `json:"tenant_id,omitempty"`
 	// 文件路径
 	FilePath string `json:"file_path,omitempty"`
+	// 原始文件路径（用户上传或首次生成的文件），重新渲染后 file_path 指向渲染文件，彻底删除时按此清理原始文件
+	SourceFilePath string `json:"source_file_path,omitempty"`
 	// 文件名
 	FileName string `json:"file_name,omitempty"`
 	// 状态: 1=pending, 2=processing, 3=completed
 	Status int32 `json:"status,omitempty"`
 	// 封面图URL
 	CoverImage string `json:"cover_image,omitempty"`
+	// PDF 渲染状态: pending=等待渲染, rendering=渲染中, done=已是最新, failed=渲染失败
+	RenderStatus resume.RenderStatus `json:"render_status,omitempty"`
+	// file_path 对应的简历内容版本号
+	RenderedRevision int64 `json:"rendered_revision,omitempty"`
+	// PDF 模板: classic=经典, modern=现代, compact=紧凑单页
+	Template resume.Template `json:"template,omitempty"`
 	// 创建时间
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新时间
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// 删除时间
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ResumeQuery when eager-loading is set.
 	Edges        ResumeEdges `json:"edges"`
@@ -46,9 +54,13 @@ type Resume struct {
 type ResumeEdges struct {
 	// 简历得分
 	Scores []*ResumeScore `json:"scores,omitempty"`
+	// 分享链接
+	Shares []*ResumeShare `json:"shares,omitempty"`
+	// 评分记录
+	ScoreRuns []*ScoreRun `json:"score_runs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // ScoresOrErr returns the Scores value or an error if the edge
@@ -60,16 +72,34 @@ func (e ResumeEdges) ScoresOrErr() ([]*ResumeScore, error) {
 	return nil, &NotLoadedError{edge: "scores"}
 }
 
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResumeEdges) SharesOrErr() ([]*ResumeShare, error) {
+	if e.loadedTypes[1] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
+// ScoreRunsOrErr returns the ScoreRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResumeEdges) ScoreRunsOrErr() ([]*ScoreRun, error) {
+	if e.loadedTypes[2] {
+		return e.ScoreRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "score_runs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Resume) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case resume.FieldID, resume.FieldUserID, resume.FieldTenantID, resume.FieldStatus:
+		case resume.FieldID, resume.FieldUserID, resume.FieldStatus, resume.FieldRenderedRevision:
 			values[i] = new(sql.NullInt64)
-		case resume.FieldFilePath, resume.FieldFileName, resume.FieldCoverImage:
+		case resume.FieldTenantID, resume.FieldFilePath, resume.FieldSourceFilePath, resume.FieldFileName, resume.FieldCoverImage, resume.FieldRenderStatus, resume.FieldTemplate:
 			values[i] = new(sql.NullString)
-		case resume.FieldCreatedAt, resume.FieldUpdatedAt, resume.FieldDeletedAt:
+		case resume.FieldDeletedAt, resume.FieldCreatedAt, resume.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -92,6 +122,13 @@ func (_m *Resume) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int64(value.Int64)
+		case resume.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case resume.FieldUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
@@ -99,10 +136,10 @@ func (_m *Resume) assignValues(columns []string, values []any) error {
 				_m.UserID = value.Int64
 			}
 		case resume.FieldTenantID:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
+			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
 			} else if value.Valid {
-				_m.TenantID = value.Int64
+				_m.TenantID = value.String
 			}
 		case resume.FieldFilePath:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -110,6 +147,12 @@ func (_m *Resume) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.FilePath = value.String
 			}
+		case resume.FieldSourceFilePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_file_path", values[i])
+			} else if value.Valid {
+				_m.SourceFilePath = value.String
+			}
 		case resume.FieldFileName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field file_name", values[i])
@@ -128,6 +171,24 @@ func (_m *Resume) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CoverImage = value.String
 			}
+		case resume.FieldRenderStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field render_status", values[i])
+			} else if value.Valid {
+				_m.RenderStatus = resume.RenderStatus(value.String)
+			}
+		case resume.FieldRenderedRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rendered_revision", values[i])
+			} else if value.Valid {
+				_m.RenderedRevision = value.Int64
+			}
+		case resume.FieldTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template", values[i])
+			} else if value.Valid {
+				_m.Template = resume.Template(value.String)
+			}
 		case resume.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -140,13 +201,6 @@ func (_m *Resume) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
-		case resume.FieldDeletedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
-			} else if value.Valid {
-				_m.DeletedAt = new(time.Time)
-				*_m.DeletedAt = value.Time
-			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -165,6 +219,16 @@ func (_m *Resume) QueryScores() *ResumeScoreQuery {
 	return NewResumeClient(_m.config).QueryScores(_m)
 }
 
+// QueryShares queries the "shares" edge of the Resume entity.
+func (_m *Resume) QueryShares() *ResumeShareQuery {
+	return NewResumeClient(_m.config).QueryShares(_m)
+}
+
+// QueryScoreRuns queries the "score_runs" edge of the Resume entity.
+func (_m *Resume) QueryScoreRuns() *ScoreRunQuery {
+	return NewResumeClient(_m.config).QueryScoreRuns(_m)
+}
+
 // Update returns a builder for updating this Resume.
 // Note that you need to call Resume.Unwrap() before calling this method if this Resume
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -188,15 +252,23 @@ func (_m *Resume) String() string {
 	var builder strings.Builder
 	builder.WriteString("Resume(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
 	builder.WriteString("tenant_id=")
-	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(_m.TenantID)
 	builder.WriteString(", ")
 	builder.WriteString("file_path=")
 	builder.WriteString(_m.FilePath)
 	builder.WriteString(", ")
+	builder.WriteString("source_file_path=")
+	builder.WriteString(_m.SourceFilePath)
+	builder.WriteString(", ")
 	builder.WriteString("file_name=")
 	builder.WriteString(_m.FileName)
 	builder.WriteString(", ")
@@ -206,16 +278,20 @@ func (_m *Resume) String() string {
 	builder.WriteString("cover_image=")
 	builder.WriteString(_m.CoverImage)
 	builder.WriteString(", ")
+	builder.WriteString("render_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RenderStatus))
+	builder.WriteString(", ")
+	builder.WriteString("rendered_revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RenderedRevision))
+	builder.WriteString(", ")
+	builder.WriteString("template=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Template))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
-	if v := _m.DeletedAt; v != nil {
-		builder.WriteString("deleted_at=")
-		builder.WriteString(v.Format(time.ANSIC))
-	}
 	builder.WriteByte(')')
 	return builder.String()
 }
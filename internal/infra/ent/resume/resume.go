@@ -3,8 +3,10 @@
 package resume
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -14,26 +16,38 @@ const (
 	Label = "resume"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// FieldTenantID holds the string denoting the tenant_id field in the database.
 	FieldTenantID = "tenant_id"
 	// FieldFilePath holds the string denoting the file_path field in the database.
 	FieldFilePath = "file_path"
+	// FieldSourceFilePath holds the string denoting the source_file_path field in the database.
+	FieldSourceFilePath = "source_file_path"
 	// FieldFileName holds the string denoting the file_name field in the database.
 	FieldFileName = "file_name"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldCoverImage holds the string denoting the cover_image field in the database.
 	FieldCoverImage = "cover_image"
+	// FieldRenderStatus holds the string denoting the render_status field in the database.
+	FieldRenderStatus = "render_status"
+	// FieldRenderedRevision holds the string denoting the rendered_revision field in the database.
+	FieldRenderedRevision = "rendered_revision"
+	// FieldTemplate holds the string denoting the template field in the database.
+	FieldTemplate = "template"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
-	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
-	FieldDeletedAt = "deleted_at"
 	// EdgeScores holds the string denoting the scores edge name in mutations.
 	EdgeScores = "scores"
+	// EdgeShares holds the string denoting the shares edge name in mutations.
+	EdgeShares = "shares"
+	// EdgeScoreRuns holds the string denoting the score_runs edge name in mutations.
+	EdgeScoreRuns = "score_runs"
 	// Table holds the table name of the resume in the database.
 	Table = "cv_resume"
 	// ScoresTable is the table that holds the scores relation/edge.
@@ -43,20 +57,38 @@ const (
 	ScoresInverseTable = "cv_resume_score"
 	// ScoresColumn is the table column denoting the scores relation/edge.
 	ScoresColumn = "resume_id"
+	// SharesTable is the table that holds the shares relation/edge.
+	SharesTable = "cv_resume_share"
+	// SharesInverseTable is the table name for the ResumeShare entity.
+	// It exists in this package in order to avoid circular dependency with the "resumeshare" package.
+	SharesInverseTable = "cv_resume_share"
+	// SharesColumn is the table column denoting the shares relation/edge.
+	SharesColumn = "resume_id"
+	// ScoreRunsTable is the table that holds the score_runs relation/edge.
+	ScoreRunsTable = "cv_score_run"
+	// ScoreRunsInverseTable is the table name for the ScoreRun entity.
+	// It exists in this package in order to avoid circular dependency with the "scorerun" package.
+	ScoreRunsInverseTable = "cv_score_run"
+	// ScoreRunsColumn is the table column denoting the score_runs relation/edge.
+	ScoreRunsColumn = "resume_id"
 )
 
 // Columns holds all SQL columns for resume fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
 	FieldUserID,
 	FieldTenantID,
 	FieldFilePath,
+	FieldSourceFilePath,
 	FieldFileName,
 	FieldStatus,
 	FieldCoverImage,
+	FieldRenderStatus,
+	FieldRenderedRevision,
+	FieldTemplate,
 	FieldCreatedAt,
 	FieldUpdatedAt,
-	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -69,15 +101,26 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "cv2/internal/infra/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// FilePathValidator is a validator for the "file_path" field. It is called by the builders before save.
 	FilePathValidator func(string) error
+	// DefaultSourceFilePath holds the default value on creation for the "source_file_path" field.
+	DefaultSourceFilePath string
 	// FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
 	FileNameValidator func(string) error
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus int32
 	// DefaultCoverImage holds the default value on creation for the "cover_image" field.
 	DefaultCoverImage string
+	// DefaultRenderedRevision holds the default value on creation for the "rendered_revision" field.
+	DefaultRenderedRevision int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -88,6 +131,61 @@ var (
 	DefaultID func() int64
 )
 
+// RenderStatus defines the type for the "render_status" enum field.
+type RenderStatus string
+
+// RenderStatusDone is the default value of the RenderStatus enum.
+const DefaultRenderStatus = RenderStatusDone
+
+// RenderStatus values.
+const (
+	RenderStatusPending   RenderStatus = "pending"
+	RenderStatusRendering RenderStatus = "rendering"
+	RenderStatusDone      RenderStatus = "done"
+	RenderStatusFailed    RenderStatus = "failed"
+)
+
+func (rs RenderStatus) String() string {
+	return string(rs)
+}
+
+// RenderStatusValidator is a validator for the "render_status" field enum values. It is called by the builders before save.
+func RenderStatusValidator(rs RenderStatus) error {
+	switch rs {
+	case RenderStatusPending, RenderStatusRendering, RenderStatusDone, RenderStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("resume: invalid enum value for render_status field: %q", rs)
+	}
+}
+
+// Template defines the type for the "template" enum field.
+type Template string
+
+// TemplateClassic is the default value of the Template enum.
+const DefaultTemplate = TemplateClassic
+
+// Template values.
+const (
+	TemplateClassic Template = "classic"
+	TemplateModern  Template = "modern"
+	TemplateCompact Template = "compact"
+)
+
+func (t Template) String() string {
+	return string(t)
+}
+
+// TemplateValidator is a validator for the "template" field enum values. It is called by the builders before save.
+func TemplateValidator(t Template) error {
+	switch t {
+	case TemplateClassic, TemplateModern, TemplateCompact:
+		return nil
+	default:
+		return fmt.Errorf("resume: invalid enum value for template field: %q", t)
+	}
+}
+
 // OrderOption defines the ordering options for the Resume queries.
 type OrderOption func(*sql.Selector)
 
@@ -96,6 +194,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByUserID orders the results by the user_id field.
 func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
@@ -111,6 +214,11 @@ func ByFilePath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFilePath, opts...).ToFunc()
 }
 
+// BySourceFilePath orders the results by the source_file_path field.
+func BySourceFilePath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceFilePath, opts...).ToFunc()
+}
+
 // ByFileName orders the results by the file_name field.
 func ByFileName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFileName, opts...).ToFunc()
@@ -126,6 +234,21 @@ func ByCoverImage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCoverImage, opts...).ToFunc()
 }
 
+// ByRenderStatus orders the results by the render_status field.
+func ByRenderStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenderStatus, opts...).ToFunc()
+}
+
+// ByRenderedRevision orders the results by the rendered_revision field.
+func ByRenderedRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenderedRevision, opts...).ToFunc()
+}
+
+// ByTemplate orders the results by the template field.
+func ByTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplate, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -136,11 +259,6 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
-// ByDeletedAt orders the results by the deleted_at field.
-func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
-}
-
 // ByScoresCount orders the results by scores count.
 func ByScoresCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -154,6 +272,34 @@ func ByScores(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newScoresStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySharesCount orders the results by shares count.
+func BySharesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSharesStep(), opts...)
+	}
+}
+
+// ByShares orders the results by shares terms.
+func ByShares(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSharesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScoreRunsCount orders the results by score_runs count.
+func ByScoreRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScoreRunsStep(), opts...)
+	}
+}
+
+// ByScoreRuns orders the results by score_runs terms.
+func ByScoreRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScoreRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newScoresStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -161,3 +307,17 @@ func newScoresStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ScoresTable, ScoresColumn),
 	)
 }
+func newSharesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SharesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SharesTable, SharesColumn),
+	)
+}
+func newScoreRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScoreRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScoreRunsTable, ScoreRunsColumn),
+	)
+}
@@ -55,13 +55,18 @@ func IDLTE(id int64) predicate.Resume {
 	return predicate.Resume(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
 func UserID(v int64) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldUserID, v))
 }
 
 // TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
-func TenantID(v int64) predicate.Resume {
+func TenantID(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldTenantID, v))
 }
 
@@ -70,6 +75,11 @@ func FilePath(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldFilePath, v))
 }
 
+// SourceFilePath applies equality check predicate on the "source_file_path" field. It's identical to SourceFilePathEQ.
+func SourceFilePath(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldSourceFilePath, v))
+}
+
 // FileName applies equality check predicate on the "file_name" field. It's identical to FileNameEQ.
 func FileName(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldFileName, v))
@@ -85,6 +95,11 @@ func CoverImage(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldCoverImage, v))
 }
 
+// RenderedRevision applies equality check predicate on the "rendered_revision" field. It's identical to RenderedRevisionEQ.
+func RenderedRevision(v int64) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldRenderedRevision, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldCreatedAt, v))
@@ -95,11 +110,56 @@ func UpdatedAt(v time.Time) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
-// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
-func DeletedAt(v time.Time) predicate.Resume {
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Resume {
+	return predicate.Resume(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Resume {
+	return predicate.Resume(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Resume {
+	return predicate.Resume(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v int64) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldUserID, v))
@@ -141,45 +201,70 @@ func UserIDLTE(v int64) predicate.Resume {
 }
 
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
-func TenantIDEQ(v int64) predicate.Resume {
+func TenantIDEQ(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldTenantID, v))
 }
 
 // TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
-func TenantIDNEQ(v int64) predicate.Resume {
+func TenantIDNEQ(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldNEQ(FieldTenantID, v))
 }
 
 // TenantIDIn applies the In predicate on the "tenant_id" field.
-func TenantIDIn(vs ...int64) predicate.Resume {
+func TenantIDIn(vs ...string) predicate.Resume {
 	return predicate.Resume(sql.FieldIn(FieldTenantID, vs...))
 }
 
 // TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
-func TenantIDNotIn(vs ...int64) predicate.Resume {
+func TenantIDNotIn(vs ...string) predicate.Resume {
 	return predicate.Resume(sql.FieldNotIn(FieldTenantID, vs...))
 }
 
 // TenantIDGT applies the GT predicate on the "tenant_id" field.
-func TenantIDGT(v int64) predicate.Resume {
+func TenantIDGT(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldGT(FieldTenantID, v))
 }
 
 // TenantIDGTE applies the GTE predicate on the "tenant_id" field.
-func TenantIDGTE(v int64) predicate.Resume {
+func TenantIDGTE(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldGTE(FieldTenantID, v))
 }
 
 // TenantIDLT applies the LT predicate on the "tenant_id" field.
-func TenantIDLT(v int64) predicate.Resume {
+func TenantIDLT(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldLT(FieldTenantID, v))
 }
 
 // TenantIDLTE applies the LTE predicate on the "tenant_id" field.
-func TenantIDLTE(v int64) predicate.Resume {
+func TenantIDLTE(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldLTE(FieldTenantID, v))
 }
 
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContainsFold(FieldTenantID, v))
+}
+
 // FilePathEQ applies the EQ predicate on the "file_path" field.
 func FilePathEQ(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldFilePath, v))
@@ -245,6 +330,81 @@ func FilePathContainsFold(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldContainsFold(FieldFilePath, v))
 }
 
+// SourceFilePathEQ applies the EQ predicate on the "source_file_path" field.
+func SourceFilePathEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldSourceFilePath, v))
+}
+
+// SourceFilePathNEQ applies the NEQ predicate on the "source_file_path" field.
+func SourceFilePathNEQ(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldSourceFilePath, v))
+}
+
+// SourceFilePathIn applies the In predicate on the "source_file_path" field.
+func SourceFilePathIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldSourceFilePath, vs...))
+}
+
+// SourceFilePathNotIn applies the NotIn predicate on the "source_file_path" field.
+func SourceFilePathNotIn(vs ...string) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldSourceFilePath, vs...))
+}
+
+// SourceFilePathGT applies the GT predicate on the "source_file_path" field.
+func SourceFilePathGT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGT(FieldSourceFilePath, v))
+}
+
+// SourceFilePathGTE applies the GTE predicate on the "source_file_path" field.
+func SourceFilePathGTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldGTE(FieldSourceFilePath, v))
+}
+
+// SourceFilePathLT applies the LT predicate on the "source_file_path" field.
+func SourceFilePathLT(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLT(FieldSourceFilePath, v))
+}
+
+// SourceFilePathLTE applies the LTE predicate on the "source_file_path" field.
+func SourceFilePathLTE(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldLTE(FieldSourceFilePath, v))
+}
+
+// SourceFilePathContains applies the Contains predicate on the "source_file_path" field.
+func SourceFilePathContains(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContains(FieldSourceFilePath, v))
+}
+
+// SourceFilePathHasPrefix applies the HasPrefix predicate on the "source_file_path" field.
+func SourceFilePathHasPrefix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasPrefix(FieldSourceFilePath, v))
+}
+
+// SourceFilePathHasSuffix applies the HasSuffix predicate on the "source_file_path" field.
+func SourceFilePathHasSuffix(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldHasSuffix(FieldSourceFilePath, v))
+}
+
+// SourceFilePathIsNil applies the IsNil predicate on the "source_file_path" field.
+func SourceFilePathIsNil() predicate.Resume {
+	return predicate.Resume(sql.FieldIsNull(FieldSourceFilePath))
+}
+
+// SourceFilePathNotNil applies the NotNil predicate on the "source_file_path" field.
+func SourceFilePathNotNil() predicate.Resume {
+	return predicate.Resume(sql.FieldNotNull(FieldSourceFilePath))
+}
+
+// SourceFilePathEqualFold applies the EqualFold predicate on the "source_file_path" field.
+func SourceFilePathEqualFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldEqualFold(FieldSourceFilePath, v))
+}
+
+// SourceFilePathContainsFold applies the ContainsFold predicate on the "source_file_path" field.
+func SourceFilePathContainsFold(v string) predicate.Resume {
+	return predicate.Resume(sql.FieldContainsFold(FieldSourceFilePath, v))
+}
+
 // FileNameEQ applies the EQ predicate on the "file_name" field.
 func FileNameEQ(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldFileName, v))
@@ -425,6 +585,86 @@ func CoverImageContainsFold(v string) predicate.Resume {
 	return predicate.Resume(sql.FieldContainsFold(FieldCoverImage, v))
 }
 
+// RenderStatusEQ applies the EQ predicate on the "render_status" field.
+func RenderStatusEQ(v RenderStatus) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldRenderStatus, v))
+}
+
+// RenderStatusNEQ applies the NEQ predicate on the "render_status" field.
+func RenderStatusNEQ(v RenderStatus) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldRenderStatus, v))
+}
+
+// RenderStatusIn applies the In predicate on the "render_status" field.
+func RenderStatusIn(vs ...RenderStatus) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldRenderStatus, vs...))
+}
+
+// RenderStatusNotIn applies the NotIn predicate on the "render_status" field.
+func RenderStatusNotIn(vs ...RenderStatus) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldRenderStatus, vs...))
+}
+
+// RenderedRevisionEQ applies the EQ predicate on the "rendered_revision" field.
+func RenderedRevisionEQ(v int64) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldRenderedRevision, v))
+}
+
+// RenderedRevisionNEQ applies the NEQ predicate on the "rendered_revision" field.
+func RenderedRevisionNEQ(v int64) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldRenderedRevision, v))
+}
+
+// RenderedRevisionIn applies the In predicate on the "rendered_revision" field.
+func RenderedRevisionIn(vs ...int64) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldRenderedRevision, vs...))
+}
+
+// RenderedRevisionNotIn applies the NotIn predicate on the "rendered_revision" field.
+func RenderedRevisionNotIn(vs ...int64) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldRenderedRevision, vs...))
+}
+
+// RenderedRevisionGT applies the GT predicate on the "rendered_revision" field.
+func RenderedRevisionGT(v int64) predicate.Resume {
+	return predicate.Resume(sql.FieldGT(FieldRenderedRevision, v))
+}
+
+// RenderedRevisionGTE applies the GTE predicate on the "rendered_revision" field.
+func RenderedRevisionGTE(v int64) predicate.Resume {
+	return predicate.Resume(sql.FieldGTE(FieldRenderedRevision, v))
+}
+
+// RenderedRevisionLT applies the LT predicate on the "rendered_revision" field.
+func RenderedRevisionLT(v int64) predicate.Resume {
+	return predicate.Resume(sql.FieldLT(FieldRenderedRevision, v))
+}
+
+// RenderedRevisionLTE applies the LTE predicate on the "rendered_revision" field.
+func RenderedRevisionLTE(v int64) predicate.Resume {
+	return predicate.Resume(sql.FieldLTE(FieldRenderedRevision, v))
+}
+
+// TemplateEQ applies the EQ predicate on the "template" field.
+func TemplateEQ(v Template) predicate.Resume {
+	return predicate.Resume(sql.FieldEQ(FieldTemplate, v))
+}
+
+// TemplateNEQ applies the NEQ predicate on the "template" field.
+func TemplateNEQ(v Template) predicate.Resume {
+	return predicate.Resume(sql.FieldNEQ(FieldTemplate, v))
+}
+
+// TemplateIn applies the In predicate on the "template" field.
+func TemplateIn(vs ...Template) predicate.Resume {
+	return predicate.Resume(sql.FieldIn(FieldTemplate, vs...))
+}
+
+// TemplateNotIn applies the NotIn predicate on the "template" field.
+func TemplateNotIn(vs ...Template) predicate.Resume {
+	return predicate.Resume(sql.FieldNotIn(FieldTemplate, vs...))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Resume {
 	return predicate.Resume(sql.FieldEQ(FieldCreatedAt, v))
@@ -505,71 +745,67 @@ func UpdatedAtLTE(v time.Time) predicate.Resume {
 	return predicate.Resume(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
-// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
-func DeletedAtEQ(v time.Time) predicate.Resume {
-	return predicate.Resume(sql.FieldEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
-func DeletedAtNEQ(v time.Time) predicate.Resume {
-	return predicate.Resume(sql.FieldNEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtIn applies the In predicate on the "deleted_at" field.
-func DeletedAtIn(vs ...time.Time) predicate.Resume {
-	return predicate.Resume(sql.FieldIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
-func DeletedAtNotIn(vs ...time.Time) predicate.Resume {
-	return predicate.Resume(sql.FieldNotIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtGT applies the GT predicate on the "deleted_at" field.
-func DeletedAtGT(v time.Time) predicate.Resume {
-	return predicate.Resume(sql.FieldGT(FieldDeletedAt, v))
-}
-
-// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
-func DeletedAtGTE(v time.Time) predicate.Resume {
-	return predicate.Resume(sql.FieldGTE(FieldDeletedAt, v))
-}
-
-// DeletedAtLT applies the LT predicate on the "deleted_at" field.
-func DeletedAtLT(v time.Time) predicate.Resume {
-	return predicate.Resume(sql.FieldLT(FieldDeletedAt, v))
+// HasScores applies the HasEdge predicate on the "scores" edge.
+func HasScores() predicate.Resume {
+	return predicate.Resume(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScoresTable, ScoresColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
 }
 
-// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
-func DeletedAtLTE(v time.Time) predicate.Resume {
-	return predicate.Resume(sql.FieldLTE(FieldDeletedAt, v))
+// HasScoresWith applies the HasEdge predicate on the "scores" edge with a given conditions (other predicates).
+func HasScoresWith(preds ...predicate.ResumeScore) predicate.Resume {
+	return predicate.Resume(func(s *sql.Selector) {
+		step := newScoresStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
 }
 
-// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
-func DeletedAtIsNil() predicate.Resume {
-	return predicate.Resume(sql.FieldIsNull(FieldDeletedAt))
+// HasShares applies the HasEdge predicate on the "shares" edge.
+func HasShares() predicate.Resume {
+	return predicate.Resume(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SharesTable, SharesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
 }
 
-// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
-func DeletedAtNotNil() predicate.Resume {
-	return predicate.Resume(sql.FieldNotNull(FieldDeletedAt))
+// HasSharesWith applies the HasEdge predicate on the "shares" edge with a given conditions (other predicates).
+func HasSharesWith(preds ...predicate.ResumeShare) predicate.Resume {
+	return predicate.Resume(func(s *sql.Selector) {
+		step := newSharesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
 }
 
-// HasScores applies the HasEdge predicate on the "scores" edge.
-func HasScores() predicate.Resume {
+// HasScoreRuns applies the HasEdge predicate on the "score_runs" edge.
+func HasScoreRuns() predicate.Resume {
 	return predicate.Resume(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ScoresTable, ScoresColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScoreRunsTable, ScoreRunsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasScoresWith applies the HasEdge predicate on the "scores" edge with a given conditions (other predicates).
-func HasScoresWith(preds ...predicate.ResumeScore) predicate.Resume {
+// HasScoreRunsWith applies the HasEdge predicate on the "score_runs" edge with a given conditions (other predicates).
+func HasScoreRunsWith(preds ...predicate.ScoreRun) predicate.Resume {
 	return predicate.Resume(func(s *sql.Selector) {
-		step := newScoresStep()
+		step := newScoreRunsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
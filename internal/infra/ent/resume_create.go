@@ -6,6 +6,8 @@ import (
 	"context"
 	"cv2/internal/infra/ent/resume"
 	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/scorerun"
 	"errors"
 	"fmt"
 	"time"
@@ -21,6 +23,20 @@ type ResumeCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ResumeCreate) SetDeletedAt(v time.Time) *ResumeCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ResumeCreate) SetNillableDeletedAt(v *time.Time) *ResumeCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user_id" field.
 func (_c *ResumeCreate) SetUserID(v int64) *ResumeCreate {
 	_c.mutation.SetUserID(v)
@@ -28,7 +44,7 @@ func (_c *ResumeCreate) SetUserID(v int64) *ResumeCreate {
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (_c *ResumeCreate) SetTenantID(v int64) *ResumeCreate {
+func (_c *ResumeCreate) SetTenantID(v string) *ResumeCreate {
 	_c.mutation.SetTenantID(v)
 	return _c
 }
@@ -39,6 +55,20 @@ func (_c *ResumeCreate) SetFilePath(v string) *ResumeCreate {
 	return _c
 }
 
+// SetSourceFilePath sets the "source_file_path" field.
+func (_c *ResumeCreate) SetSourceFilePath(v string) *ResumeCreate {
+	_c.mutation.SetSourceFilePath(v)
+	return _c
+}
+
+// SetNillableSourceFilePath sets the "source_file_path" field if the given value is not nil.
+func (_c *ResumeCreate) SetNillableSourceFilePath(v *string) *ResumeCreate {
+	if v != nil {
+		_c.SetSourceFilePath(*v)
+	}
+	return _c
+}
+
 // SetFileName sets the "file_name" field.
 func (_c *ResumeCreate) SetFileName(v string) *ResumeCreate {
 	_c.mutation.SetFileName(v)
@@ -73,6 +103,48 @@ func (_c *ResumeCreate) SetNillableCoverImage(v *string) *ResumeCreate {
 	return _c
 }
 
+// SetRenderStatus sets the "render_status" field.
+func (_c *ResumeCreate) SetRenderStatus(v resume.RenderStatus) *ResumeCreate {
+	_c.mutation.SetRenderStatus(v)
+	return _c
+}
+
+// SetNillableRenderStatus sets the "render_status" field if the given value is not nil.
+func (_c *ResumeCreate) SetNillableRenderStatus(v *resume.RenderStatus) *ResumeCreate {
+	if v != nil {
+		_c.SetRenderStatus(*v)
+	}
+	return _c
+}
+
+// SetRenderedRevision sets the "rendered_revision" field.
+func (_c *ResumeCreate) SetRenderedRevision(v int64) *ResumeCreate {
+	_c.mutation.SetRenderedRevision(v)
+	return _c
+}
+
+// SetNillableRenderedRevision sets the "rendered_revision" field if the given value is not nil.
+func (_c *ResumeCreate) SetNillableRenderedRevision(v *int64) *ResumeCreate {
+	if v != nil {
+		_c.SetRenderedRevision(*v)
+	}
+	return _c
+}
+
+// SetTemplate sets the "template" field.
+func (_c *ResumeCreate) SetTemplate(v resume.Template) *ResumeCreate {
+	_c.mutation.SetTemplate(v)
+	return _c
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_c *ResumeCreate) SetNillableTemplate(v *resume.Template) *ResumeCreate {
+	if v != nil {
+		_c.SetTemplate(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ResumeCreate) SetCreatedAt(v time.Time) *ResumeCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -101,20 +173,6 @@ func (_c *ResumeCreate) SetNillableUpdatedAt(v *time.Time) *ResumeCreate {
 	return _c
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_c *ResumeCreate) SetDeletedAt(v time.Time) *ResumeCreate {
-	_c.mutation.SetDeletedAt(v)
-	return _c
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_c *ResumeCreate) SetNillableDeletedAt(v *time.Time) *ResumeCreate {
-	if v != nil {
-		_c.SetDeletedAt(*v)
-	}
-	return _c
-}
-
 // SetID sets the "id" field.
 func (_c *ResumeCreate) SetID(v int64) *ResumeCreate {
 	_c.mutation.SetID(v)
@@ -144,6 +202,36 @@ func (_c *ResumeCreate) AddScores(v ...*ResumeScore) *ResumeCreate {
 	return _c.AddScoreIDs(ids...)
 }
 
+// AddShareIDs adds the "shares" edge to the ResumeShare entity by IDs.
+func (_c *ResumeCreate) AddShareIDs(ids ...int64) *ResumeCreate {
+	_c.mutation.AddShareIDs(ids...)
+	return _c
+}
+
+// AddShares adds the "shares" edges to the ResumeShare entity.
+func (_c *ResumeCreate) AddShares(v ...*ResumeShare) *ResumeCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShareIDs(ids...)
+}
+
+// AddScoreRunIDs adds the "score_runs" edge to the ScoreRun entity by IDs.
+func (_c *ResumeCreate) AddScoreRunIDs(ids ...int64) *ResumeCreate {
+	_c.mutation.AddScoreRunIDs(ids...)
+	return _c
+}
+
+// AddScoreRuns adds the "score_runs" edges to the ScoreRun entity.
+func (_c *ResumeCreate) AddScoreRuns(v ...*ScoreRun) *ResumeCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScoreRunIDs(ids...)
+}
+
 // Mutation returns the ResumeMutation object of the builder.
 func (_c *ResumeCreate) Mutation() *ResumeMutation {
 	return _c.mutation
@@ -151,7 +239,9 @@ func (_c *ResumeCreate) Mutation() *ResumeMutation {
 
 // Save creates the Resume in the database.
 func (_c *ResumeCreate) Save(ctx context.Context) (*Resume, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -178,7 +268,11 @@ func (_c *ResumeCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ResumeCreate) defaults() {
+func (_c *ResumeCreate) defaults() error {
+	if _, ok := _c.mutation.SourceFilePath(); !ok {
+		v := resume.DefaultSourceFilePath
+		_c.mutation.SetSourceFilePath(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := resume.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -187,18 +281,40 @@ func (_c *ResumeCreate) defaults() {
 		v := resume.DefaultCoverImage
 		_c.mutation.SetCoverImage(v)
 	}
+	if _, ok := _c.mutation.RenderStatus(); !ok {
+		v := resume.DefaultRenderStatus
+		_c.mutation.SetRenderStatus(v)
+	}
+	if _, ok := _c.mutation.RenderedRevision(); !ok {
+		v := resume.DefaultRenderedRevision
+		_c.mutation.SetRenderedRevision(v)
+	}
+	if _, ok := _c.mutation.Template(); !ok {
+		v := resume.DefaultTemplate
+		_c.mutation.SetTemplate(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if resume.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized resume.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := resume.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if resume.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized resume.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := resume.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if resume.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized resume.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := resume.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -228,6 +344,25 @@ func (_c *ResumeCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Resume.status"`)}
 	}
+	if _, ok := _c.mutation.RenderStatus(); !ok {
+		return &ValidationError{Name: "render_status", err: errors.New(`ent: missing required field "Resume.render_status"`)}
+	}
+	if v, ok := _c.mutation.RenderStatus(); ok {
+		if err := resume.RenderStatusValidator(v); err != nil {
+			return &ValidationError{Name: "render_status", err: fmt.Errorf(`ent: validator failed for field "Resume.render_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RenderedRevision(); !ok {
+		return &ValidationError{Name: "rendered_revision", err: errors.New(`ent: missing required field "Resume.rendered_revision"`)}
+	}
+	if _, ok := _c.mutation.Template(); !ok {
+		return &ValidationError{Name: "template", err: errors.New(`ent: missing required field "Resume.template"`)}
+	}
+	if v, ok := _c.mutation.Template(); ok {
+		if err := resume.TemplateValidator(v); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "Resume.template": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Resume.created_at"`)}
 	}
@@ -266,18 +401,26 @@ func (_c *ResumeCreate) createSpec() (*Resume, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(resume.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.UserID(); ok {
 		_spec.SetField(resume.FieldUserID, field.TypeInt64, value)
 		_node.UserID = value
 	}
 	if value, ok := _c.mutation.TenantID(); ok {
-		_spec.SetField(resume.FieldTenantID, field.TypeInt64, value)
+		_spec.SetField(resume.FieldTenantID, field.TypeString, value)
 		_node.TenantID = value
 	}
 	if value, ok := _c.mutation.FilePath(); ok {
 		_spec.SetField(resume.FieldFilePath, field.TypeString, value)
 		_node.FilePath = value
 	}
+	if value, ok := _c.mutation.SourceFilePath(); ok {
+		_spec.SetField(resume.FieldSourceFilePath, field.TypeString, value)
+		_node.SourceFilePath = value
+	}
 	if value, ok := _c.mutation.FileName(); ok {
 		_spec.SetField(resume.FieldFileName, field.TypeString, value)
 		_node.FileName = value
@@ -290,6 +433,18 @@ func (_c *ResumeCreate) createSpec() (*Resume, *sqlgraph.CreateSpec) {
 		_spec.SetField(resume.FieldCoverImage, field.TypeString, value)
 		_node.CoverImage = value
 	}
+	if value, ok := _c.mutation.RenderStatus(); ok {
+		_spec.SetField(resume.FieldRenderStatus, field.TypeEnum, value)
+		_node.RenderStatus = value
+	}
+	if value, ok := _c.mutation.RenderedRevision(); ok {
+		_spec.SetField(resume.FieldRenderedRevision, field.TypeInt64, value)
+		_node.RenderedRevision = value
+	}
+	if value, ok := _c.mutation.Template(); ok {
+		_spec.SetField(resume.FieldTemplate, field.TypeEnum, value)
+		_node.Template = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(resume.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -298,10 +453,6 @@ func (_c *ResumeCreate) createSpec() (*Resume, *sqlgraph.CreateSpec) {
 		_spec.SetField(resume.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := _c.mutation.DeletedAt(); ok {
-		_spec.SetField(resume.FieldDeletedAt, field.TypeTime, value)
-		_node.DeletedAt = &value
-	}
 	if nodes := _c.mutation.ScoresIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -318,6 +469,38 @@ func (_c *ResumeCreate) createSpec() (*Resume, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.SharesTable,
+			Columns: []string{resume.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScoreRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ScoreRunsTable,
+			Columns: []string{resume.ScoreRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scorerun.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,6 +7,8 @@ import (
 	"cv2/internal/infra/ent/predicate"
 	"cv2/internal/infra/ent/resume"
 	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/scorerun"
 	"database/sql/driver"
 	"fmt"
 	"math"
@@ -20,11 +22,13 @@ import (
 // ResumeQuery is the builder for querying Resume entities.
 type ResumeQuery struct {
 	config
-	ctx        *QueryContext
-	order      []resume.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Resume
-	withScores *ResumeScoreQuery
+	ctx           *QueryContext
+	order         []resume.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Resume
+	withScores    *ResumeScoreQuery
+	withShares    *ResumeShareQuery
+	withScoreRuns *ScoreRunQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +87,50 @@ func (_q *ResumeQuery) QueryScores() *ResumeScoreQuery {
 	return query
 }
 
+// QueryShares chains the current query on the "shares" edge.
+func (_q *ResumeQuery) QueryShares() *ResumeShareQuery {
+	query := (&ResumeShareClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resume.Table, resume.FieldID, selector),
+			sqlgraph.To(resumeshare.Table, resumeshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resume.SharesTable, resume.SharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScoreRuns chains the current query on the "score_runs" edge.
+func (_q *ResumeQuery) QueryScoreRuns() *ScoreRunQuery {
+	query := (&ScoreRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resume.Table, resume.FieldID, selector),
+			sqlgraph.To(scorerun.Table, scorerun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resume.ScoreRunsTable, resume.ScoreRunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Resume entity from the query.
 // Returns a *NotFoundError when no Resume was found.
 func (_q *ResumeQuery) First(ctx context.Context) (*Resume, error) {
@@ -270,12 +318,14 @@ func (_q *ResumeQuery) Clone() *ResumeQuery {
 		return nil
 	}
 	return &ResumeQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]resume.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Resume{}, _q.predicates...),
-		withScores: _q.withScores.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]resume.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.Resume{}, _q.predicates...),
+		withScores:    _q.withScores.Clone(),
+		withShares:    _q.withShares.Clone(),
+		withScoreRuns: _q.withScoreRuns.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,18 +343,40 @@ func (_q *ResumeQuery) WithScores(opts ...func(*ResumeScoreQuery)) *ResumeQuery
 	return _q
 }
 
+// WithShares tells the query-builder to eager-load the nodes that are connected to
+// the "shares" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ResumeQuery) WithShares(opts ...func(*ResumeShareQuery)) *ResumeQuery {
+	query := (&ResumeShareClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShares = query
+	return _q
+}
+
+// WithScoreRuns tells the query-builder to eager-load the nodes that are connected to
+// the "score_runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ResumeQuery) WithScoreRuns(opts ...func(*ScoreRunQuery)) *ResumeQuery {
+	query := (&ScoreRunClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScoreRuns = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		UserID int64 `json:"user_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Resume.Query().
-//		GroupBy(resume.FieldUserID).
+//		GroupBy(resume.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ResumeQuery) GroupBy(field string, fields ...string) *ResumeGroupBy {
@@ -322,11 +394,11 @@ func (_q *ResumeQuery) GroupBy(field string, fields ...string) *ResumeGroupBy {
 // Example:
 //
 //	var v []struct {
-//		UserID int64 `json:"user_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Resume.Query().
-//		Select(resume.FieldUserID).
+//		Select(resume.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (_q *ResumeQuery) Select(fields ...string) *ResumeSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -371,8 +443,10 @@ func (_q *ResumeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Resum
 	var (
 		nodes       = []*Resume{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			_q.withScores != nil,
+			_q.withShares != nil,
+			_q.withScoreRuns != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +474,20 @@ func (_q *ResumeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Resum
 			return nil, err
 		}
 	}
+	if query := _q.withShares; query != nil {
+		if err := _q.loadShares(ctx, query, nodes,
+			func(n *Resume) { n.Edges.Shares = []*ResumeShare{} },
+			func(n *Resume, e *ResumeShare) { n.Edges.Shares = append(n.Edges.Shares, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScoreRuns; query != nil {
+		if err := _q.loadScoreRuns(ctx, query, nodes,
+			func(n *Resume) { n.Edges.ScoreRuns = []*ScoreRun{} },
+			func(n *Resume, e *ScoreRun) { n.Edges.ScoreRuns = append(n.Edges.ScoreRuns, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,6 +521,66 @@ func (_q *ResumeQuery) loadScores(ctx context.Context, query *ResumeScoreQuery,
 	}
 	return nil
 }
+func (_q *ResumeQuery) loadShares(ctx context.Context, query *ResumeShareQuery, nodes []*Resume, init func(*Resume), assign func(*Resume, *ResumeShare)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Resume)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resumeshare.FieldResumeID)
+	}
+	query.Where(predicate.ResumeShare(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(resume.SharesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ResumeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "resume_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ResumeQuery) loadScoreRuns(ctx context.Context, query *ScoreRunQuery, nodes []*Resume, init func(*Resume), assign func(*Resume, *ScoreRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Resume)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scorerun.FieldResumeID)
+	}
+	query.Where(predicate.ScoreRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(resume.ScoreRunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ResumeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "resume_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ResumeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -7,6 +7,8 @@ import (
 	"cv2/internal/infra/ent/predicate"
 	"cv2/internal/infra/ent/resume"
 	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/scorerun"
 	"errors"
 	"fmt"
 	"time"
@@ -29,6 +31,26 @@ func (_u *ResumeUpdate) Where(ps ...predicate.Resume) *ResumeUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ResumeUpdate) SetDeletedAt(v time.Time) *ResumeUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ResumeUpdate) SetNillableDeletedAt(v *time.Time) *ResumeUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ResumeUpdate) ClearDeletedAt() *ResumeUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *ResumeUpdate) SetUserID(v int64) *ResumeUpdate {
 	_u.mutation.ResetUserID()
@@ -51,26 +73,19 @@ func (_u *ResumeUpdate) AddUserID(v int64) *ResumeUpdate {
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (_u *ResumeUpdate) SetTenantID(v int64) *ResumeUpdate {
-	_u.mutation.ResetTenantID()
+func (_u *ResumeUpdate) SetTenantID(v string) *ResumeUpdate {
 	_u.mutation.SetTenantID(v)
 	return _u
 }
 
 // SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
-func (_u *ResumeUpdate) SetNillableTenantID(v *int64) *ResumeUpdate {
+func (_u *ResumeUpdate) SetNillableTenantID(v *string) *ResumeUpdate {
 	if v != nil {
 		_u.SetTenantID(*v)
 	}
 	return _u
 }
 
-// AddTenantID adds value to the "tenant_id" field.
-func (_u *ResumeUpdate) AddTenantID(v int64) *ResumeUpdate {
-	_u.mutation.AddTenantID(v)
-	return _u
-}
-
 // SetFilePath sets the "file_path" field.
 func (_u *ResumeUpdate) SetFilePath(v string) *ResumeUpdate {
 	_u.mutation.SetFilePath(v)
@@ -85,6 +100,26 @@ func (_u *ResumeUpdate) SetNillableFilePath(v *string) *ResumeUpdate {
 	return _u
 }
 
+// SetSourceFilePath sets the "source_file_path" field.
+func (_u *ResumeUpdate) SetSourceFilePath(v string) *ResumeUpdate {
+	_u.mutation.SetSourceFilePath(v)
+	return _u
+}
+
+// SetNillableSourceFilePath sets the "source_file_path" field if the given value is not nil.
+func (_u *ResumeUpdate) SetNillableSourceFilePath(v *string) *ResumeUpdate {
+	if v != nil {
+		_u.SetSourceFilePath(*v)
+	}
+	return _u
+}
+
+// ClearSourceFilePath clears the value of the "source_file_path" field.
+func (_u *ResumeUpdate) ClearSourceFilePath() *ResumeUpdate {
+	_u.mutation.ClearSourceFilePath()
+	return _u
+}
+
 // SetFileName sets the "file_name" field.
 func (_u *ResumeUpdate) SetFileName(v string) *ResumeUpdate {
 	_u.mutation.SetFileName(v)
@@ -140,29 +175,58 @@ func (_u *ResumeUpdate) ClearCoverImage() *ResumeUpdate {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *ResumeUpdate) SetUpdatedAt(v time.Time) *ResumeUpdate {
-	_u.mutation.SetUpdatedAt(v)
+// SetRenderStatus sets the "render_status" field.
+func (_u *ResumeUpdate) SetRenderStatus(v resume.RenderStatus) *ResumeUpdate {
+	_u.mutation.SetRenderStatus(v)
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *ResumeUpdate) SetDeletedAt(v time.Time) *ResumeUpdate {
-	_u.mutation.SetDeletedAt(v)
+// SetNillableRenderStatus sets the "render_status" field if the given value is not nil.
+func (_u *ResumeUpdate) SetNillableRenderStatus(v *resume.RenderStatus) *ResumeUpdate {
+	if v != nil {
+		_u.SetRenderStatus(*v)
+	}
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *ResumeUpdate) SetNillableDeletedAt(v *time.Time) *ResumeUpdate {
+// SetRenderedRevision sets the "rendered_revision" field.
+func (_u *ResumeUpdate) SetRenderedRevision(v int64) *ResumeUpdate {
+	_u.mutation.ResetRenderedRevision()
+	_u.mutation.SetRenderedRevision(v)
+	return _u
+}
+
+// SetNillableRenderedRevision sets the "rendered_revision" field if the given value is not nil.
+func (_u *ResumeUpdate) SetNillableRenderedRevision(v *int64) *ResumeUpdate {
 	if v != nil {
-		_u.SetDeletedAt(*v)
+		_u.SetRenderedRevision(*v)
 	}
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *ResumeUpdate) ClearDeletedAt() *ResumeUpdate {
-	_u.mutation.ClearDeletedAt()
+// AddRenderedRevision adds value to the "rendered_revision" field.
+func (_u *ResumeUpdate) AddRenderedRevision(v int64) *ResumeUpdate {
+	_u.mutation.AddRenderedRevision(v)
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *ResumeUpdate) SetTemplate(v resume.Template) *ResumeUpdate {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *ResumeUpdate) SetNillableTemplate(v *resume.Template) *ResumeUpdate {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ResumeUpdate) SetUpdatedAt(v time.Time) *ResumeUpdate {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
@@ -181,6 +245,36 @@ func (_u *ResumeUpdate) AddScores(v ...*ResumeScore) *ResumeUpdate {
 	return _u.AddScoreIDs(ids...)
 }
 
+// AddShareIDs adds the "shares" edge to the ResumeShare entity by IDs.
+func (_u *ResumeUpdate) AddShareIDs(ids ...int64) *ResumeUpdate {
+	_u.mutation.AddShareIDs(ids...)
+	return _u
+}
+
+// AddShares adds the "shares" edges to the ResumeShare entity.
+func (_u *ResumeUpdate) AddShares(v ...*ResumeShare) *ResumeUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareIDs(ids...)
+}
+
+// AddScoreRunIDs adds the "score_runs" edge to the ScoreRun entity by IDs.
+func (_u *ResumeUpdate) AddScoreRunIDs(ids ...int64) *ResumeUpdate {
+	_u.mutation.AddScoreRunIDs(ids...)
+	return _u
+}
+
+// AddScoreRuns adds the "score_runs" edges to the ScoreRun entity.
+func (_u *ResumeUpdate) AddScoreRuns(v ...*ScoreRun) *ResumeUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScoreRunIDs(ids...)
+}
+
 // Mutation returns the ResumeMutation object of the builder.
 func (_u *ResumeUpdate) Mutation() *ResumeMutation {
 	return _u.mutation
@@ -207,9 +301,53 @@ func (_u *ResumeUpdate) RemoveScores(v ...*ResumeScore) *ResumeUpdate {
 	return _u.RemoveScoreIDs(ids...)
 }
 
+// ClearShares clears all "shares" edges to the ResumeShare entity.
+func (_u *ResumeUpdate) ClearShares() *ResumeUpdate {
+	_u.mutation.ClearShares()
+	return _u
+}
+
+// RemoveShareIDs removes the "shares" edge to ResumeShare entities by IDs.
+func (_u *ResumeUpdate) RemoveShareIDs(ids ...int64) *ResumeUpdate {
+	_u.mutation.RemoveShareIDs(ids...)
+	return _u
+}
+
+// RemoveShares removes "shares" edges to ResumeShare entities.
+func (_u *ResumeUpdate) RemoveShares(v ...*ResumeShare) *ResumeUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareIDs(ids...)
+}
+
+// ClearScoreRuns clears all "score_runs" edges to the ScoreRun entity.
+func (_u *ResumeUpdate) ClearScoreRuns() *ResumeUpdate {
+	_u.mutation.ClearScoreRuns()
+	return _u
+}
+
+// RemoveScoreRunIDs removes the "score_runs" edge to ScoreRun entities by IDs.
+func (_u *ResumeUpdate) RemoveScoreRunIDs(ids ...int64) *ResumeUpdate {
+	_u.mutation.RemoveScoreRunIDs(ids...)
+	return _u
+}
+
+// RemoveScoreRuns removes "score_runs" edges to ScoreRun entities.
+func (_u *ResumeUpdate) RemoveScoreRuns(v ...*ScoreRun) *ResumeUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScoreRunIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ResumeUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -236,11 +374,15 @@ func (_u *ResumeUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ResumeUpdate) defaults() {
+func (_u *ResumeUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if resume.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized resume.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := resume.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -255,6 +397,16 @@ func (_u *ResumeUpdate) check() error {
 			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "Resume.file_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RenderStatus(); ok {
+		if err := resume.RenderStatusValidator(v); err != nil {
+			return &ValidationError{Name: "render_status", err: fmt.Errorf(`ent: validator failed for field "Resume.render_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Template(); ok {
+		if err := resume.TemplateValidator(v); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "Resume.template": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -270,6 +422,12 @@ func (_u *ResumeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(resume.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(resume.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UserID(); ok {
 		_spec.SetField(resume.FieldUserID, field.TypeInt64, value)
 	}
@@ -277,14 +435,17 @@ func (_u *ResumeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.AddField(resume.FieldUserID, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.TenantID(); ok {
-		_spec.SetField(resume.FieldTenantID, field.TypeInt64, value)
-	}
-	if value, ok := _u.mutation.AddedTenantID(); ok {
-		_spec.AddField(resume.FieldTenantID, field.TypeInt64, value)
+		_spec.SetField(resume.FieldTenantID, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.FilePath(); ok {
 		_spec.SetField(resume.FieldFilePath, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SourceFilePath(); ok {
+		_spec.SetField(resume.FieldSourceFilePath, field.TypeString, value)
+	}
+	if _u.mutation.SourceFilePathCleared() {
+		_spec.ClearField(resume.FieldSourceFilePath, field.TypeString)
+	}
 	if value, ok := _u.mutation.FileName(); ok {
 		_spec.SetField(resume.FieldFileName, field.TypeString, value)
 	}
@@ -300,14 +461,20 @@ func (_u *ResumeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.CoverImageCleared() {
 		_spec.ClearField(resume.FieldCoverImage, field.TypeString)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(resume.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.RenderStatus(); ok {
+		_spec.SetField(resume.FieldRenderStatus, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(resume.FieldDeletedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.RenderedRevision(); ok {
+		_spec.SetField(resume.FieldRenderedRevision, field.TypeInt64, value)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(resume.FieldDeletedAt, field.TypeTime)
+	if value, ok := _u.mutation.AddedRenderedRevision(); ok {
+		_spec.AddField(resume.FieldRenderedRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(resume.FieldTemplate, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(resume.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if _u.mutation.ScoresCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -354,6 +521,96 @@ func (_u *ResumeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.SharesTable,
+			Columns: []string{resume.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharesIDs(); len(nodes) > 0 && !_u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.SharesTable,
+			Columns: []string{resume.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.SharesTable,
+			Columns: []string{resume.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScoreRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ScoreRunsTable,
+			Columns: []string{resume.ScoreRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scorerun.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScoreRunsIDs(); len(nodes) > 0 && !_u.mutation.ScoreRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ScoreRunsTable,
+			Columns: []string{resume.ScoreRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scorerun.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScoreRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ScoreRunsTable,
+			Columns: []string{resume.ScoreRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scorerun.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{resume.Label}
@@ -374,6 +631,26 @@ type ResumeUpdateOne struct {
 	mutation *ResumeMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ResumeUpdateOne) SetDeletedAt(v time.Time) *ResumeUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ResumeUpdateOne) SetNillableDeletedAt(v *time.Time) *ResumeUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ResumeUpdateOne) ClearDeletedAt() *ResumeUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *ResumeUpdateOne) SetUserID(v int64) *ResumeUpdateOne {
 	_u.mutation.ResetUserID()
@@ -396,26 +673,19 @@ func (_u *ResumeUpdateOne) AddUserID(v int64) *ResumeUpdateOne {
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (_u *ResumeUpdateOne) SetTenantID(v int64) *ResumeUpdateOne {
-	_u.mutation.ResetTenantID()
+func (_u *ResumeUpdateOne) SetTenantID(v string) *ResumeUpdateOne {
 	_u.mutation.SetTenantID(v)
 	return _u
 }
 
 // SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
-func (_u *ResumeUpdateOne) SetNillableTenantID(v *int64) *ResumeUpdateOne {
+func (_u *ResumeUpdateOne) SetNillableTenantID(v *string) *ResumeUpdateOne {
 	if v != nil {
 		_u.SetTenantID(*v)
 	}
 	return _u
 }
 
-// AddTenantID adds value to the "tenant_id" field.
-func (_u *ResumeUpdateOne) AddTenantID(v int64) *ResumeUpdateOne {
-	_u.mutation.AddTenantID(v)
-	return _u
-}
-
 // SetFilePath sets the "file_path" field.
 func (_u *ResumeUpdateOne) SetFilePath(v string) *ResumeUpdateOne {
 	_u.mutation.SetFilePath(v)
@@ -430,6 +700,26 @@ func (_u *ResumeUpdateOne) SetNillableFilePath(v *string) *ResumeUpdateOne {
 	return _u
 }
 
+// SetSourceFilePath sets the "source_file_path" field.
+func (_u *ResumeUpdateOne) SetSourceFilePath(v string) *ResumeUpdateOne {
+	_u.mutation.SetSourceFilePath(v)
+	return _u
+}
+
+// SetNillableSourceFilePath sets the "source_file_path" field if the given value is not nil.
+func (_u *ResumeUpdateOne) SetNillableSourceFilePath(v *string) *ResumeUpdateOne {
+	if v != nil {
+		_u.SetSourceFilePath(*v)
+	}
+	return _u
+}
+
+// ClearSourceFilePath clears the value of the "source_file_path" field.
+func (_u *ResumeUpdateOne) ClearSourceFilePath() *ResumeUpdateOne {
+	_u.mutation.ClearSourceFilePath()
+	return _u
+}
+
 // SetFileName sets the "file_name" field.
 func (_u *ResumeUpdateOne) SetFileName(v string) *ResumeUpdateOne {
 	_u.mutation.SetFileName(v)
@@ -485,29 +775,58 @@ func (_u *ResumeUpdateOne) ClearCoverImage() *ResumeUpdateOne {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *ResumeUpdateOne) SetUpdatedAt(v time.Time) *ResumeUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// SetRenderStatus sets the "render_status" field.
+func (_u *ResumeUpdateOne) SetRenderStatus(v resume.RenderStatus) *ResumeUpdateOne {
+	_u.mutation.SetRenderStatus(v)
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *ResumeUpdateOne) SetDeletedAt(v time.Time) *ResumeUpdateOne {
-	_u.mutation.SetDeletedAt(v)
+// SetNillableRenderStatus sets the "render_status" field if the given value is not nil.
+func (_u *ResumeUpdateOne) SetNillableRenderStatus(v *resume.RenderStatus) *ResumeUpdateOne {
+	if v != nil {
+		_u.SetRenderStatus(*v)
+	}
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *ResumeUpdateOne) SetNillableDeletedAt(v *time.Time) *ResumeUpdateOne {
+// SetRenderedRevision sets the "rendered_revision" field.
+func (_u *ResumeUpdateOne) SetRenderedRevision(v int64) *ResumeUpdateOne {
+	_u.mutation.ResetRenderedRevision()
+	_u.mutation.SetRenderedRevision(v)
+	return _u
+}
+
+// SetNillableRenderedRevision sets the "rendered_revision" field if the given value is not nil.
+func (_u *ResumeUpdateOne) SetNillableRenderedRevision(v *int64) *ResumeUpdateOne {
 	if v != nil {
-		_u.SetDeletedAt(*v)
+		_u.SetRenderedRevision(*v)
 	}
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *ResumeUpdateOne) ClearDeletedAt() *ResumeUpdateOne {
-	_u.mutation.ClearDeletedAt()
+// AddRenderedRevision adds value to the "rendered_revision" field.
+func (_u *ResumeUpdateOne) AddRenderedRevision(v int64) *ResumeUpdateOne {
+	_u.mutation.AddRenderedRevision(v)
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *ResumeUpdateOne) SetTemplate(v resume.Template) *ResumeUpdateOne {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *ResumeUpdateOne) SetNillableTemplate(v *resume.Template) *ResumeUpdateOne {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ResumeUpdateOne) SetUpdatedAt(v time.Time) *ResumeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
@@ -526,6 +845,36 @@ func (_u *ResumeUpdateOne) AddScores(v ...*ResumeScore) *ResumeUpdateOne {
 	return _u.AddScoreIDs(ids...)
 }
 
+// AddShareIDs adds the "shares" edge to the ResumeShare entity by IDs.
+func (_u *ResumeUpdateOne) AddShareIDs(ids ...int64) *ResumeUpdateOne {
+	_u.mutation.AddShareIDs(ids...)
+	return _u
+}
+
+// AddShares adds the "shares" edges to the ResumeShare entity.
+func (_u *ResumeUpdateOne) AddShares(v ...*ResumeShare) *ResumeUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareIDs(ids...)
+}
+
+// AddScoreRunIDs adds the "score_runs" edge to the ScoreRun entity by IDs.
+func (_u *ResumeUpdateOne) AddScoreRunIDs(ids ...int64) *ResumeUpdateOne {
+	_u.mutation.AddScoreRunIDs(ids...)
+	return _u
+}
+
+// AddScoreRuns adds the "score_runs" edges to the ScoreRun entity.
+func (_u *ResumeUpdateOne) AddScoreRuns(v ...*ScoreRun) *ResumeUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScoreRunIDs(ids...)
+}
+
 // Mutation returns the ResumeMutation object of the builder.
 func (_u *ResumeUpdateOne) Mutation() *ResumeMutation {
 	return _u.mutation
@@ -552,6 +901,48 @@ func (_u *ResumeUpdateOne) RemoveScores(v ...*ResumeScore) *ResumeUpdateOne {
 	return _u.RemoveScoreIDs(ids...)
 }
 
+// ClearShares clears all "shares" edges to the ResumeShare entity.
+func (_u *ResumeUpdateOne) ClearShares() *ResumeUpdateOne {
+	_u.mutation.ClearShares()
+	return _u
+}
+
+// RemoveShareIDs removes the "shares" edge to ResumeShare entities by IDs.
+func (_u *ResumeUpdateOne) RemoveShareIDs(ids ...int64) *ResumeUpdateOne {
+	_u.mutation.RemoveShareIDs(ids...)
+	return _u
+}
+
+// RemoveShares removes "shares" edges to ResumeShare entities.
+func (_u *ResumeUpdateOne) RemoveShares(v ...*ResumeShare) *ResumeUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareIDs(ids...)
+}
+
+// ClearScoreRuns clears all "score_runs" edges to the ScoreRun entity.
+func (_u *ResumeUpdateOne) ClearScoreRuns() *ResumeUpdateOne {
+	_u.mutation.ClearScoreRuns()
+	return _u
+}
+
+// RemoveScoreRunIDs removes the "score_runs" edge to ScoreRun entities by IDs.
+func (_u *ResumeUpdateOne) RemoveScoreRunIDs(ids ...int64) *ResumeUpdateOne {
+	_u.mutation.RemoveScoreRunIDs(ids...)
+	return _u
+}
+
+// RemoveScoreRuns removes "score_runs" edges to ScoreRun entities.
+func (_u *ResumeUpdateOne) RemoveScoreRuns(v ...*ScoreRun) *ResumeUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScoreRunIDs(ids...)
+}
+
 // Where appends a list predicates to the ResumeUpdate builder.
 func (_u *ResumeUpdateOne) Where(ps ...predicate.Resume) *ResumeUpdateOne {
 	_u.mutation.Where(ps...)
@@ -567,7 +958,9 @@ func (_u *ResumeUpdateOne) Select(field string, fields ...string) *ResumeUpdateO
 
 // Save executes the query and returns the updated Resume entity.
 func (_u *ResumeUpdateOne) Save(ctx context.Context) (*Resume, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -594,11 +987,15 @@ func (_u *ResumeUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ResumeUpdateOne) defaults() {
+func (_u *ResumeUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if resume.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized resume.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := resume.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -613,6 +1010,16 @@ func (_u *ResumeUpdateOne) check() error {
 			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "Resume.file_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RenderStatus(); ok {
+		if err := resume.RenderStatusValidator(v); err != nil {
+			return &ValidationError{Name: "render_status", err: fmt.Errorf(`ent: validator failed for field "Resume.render_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Template(); ok {
+		if err := resume.TemplateValidator(v); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "Resume.template": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -645,6 +1052,12 @@ func (_u *ResumeUpdateOne) sqlSave(ctx context.Context) (_node *Resume, err erro
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(resume.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(resume.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UserID(); ok {
 		_spec.SetField(resume.FieldUserID, field.TypeInt64, value)
 	}
@@ -652,14 +1065,17 @@ func (_u *ResumeUpdateOne) sqlSave(ctx context.Context) (_node *Resume, err erro
 		_spec.AddField(resume.FieldUserID, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.TenantID(); ok {
-		_spec.SetField(resume.FieldTenantID, field.TypeInt64, value)
-	}
-	if value, ok := _u.mutation.AddedTenantID(); ok {
-		_spec.AddField(resume.FieldTenantID, field.TypeInt64, value)
+		_spec.SetField(resume.FieldTenantID, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.FilePath(); ok {
 		_spec.SetField(resume.FieldFilePath, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SourceFilePath(); ok {
+		_spec.SetField(resume.FieldSourceFilePath, field.TypeString, value)
+	}
+	if _u.mutation.SourceFilePathCleared() {
+		_spec.ClearField(resume.FieldSourceFilePath, field.TypeString)
+	}
 	if value, ok := _u.mutation.FileName(); ok {
 		_spec.SetField(resume.FieldFileName, field.TypeString, value)
 	}
@@ -675,14 +1091,20 @@ func (_u *ResumeUpdateOne) sqlSave(ctx context.Context) (_node *Resume, err erro
 	if _u.mutation.CoverImageCleared() {
 		_spec.ClearField(resume.FieldCoverImage, field.TypeString)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(resume.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.RenderStatus(); ok {
+		_spec.SetField(resume.FieldRenderStatus, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(resume.FieldDeletedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.RenderedRevision(); ok {
+		_spec.SetField(resume.FieldRenderedRevision, field.TypeInt64, value)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(resume.FieldDeletedAt, field.TypeTime)
+	if value, ok := _u.mutation.AddedRenderedRevision(); ok {
+		_spec.AddField(resume.FieldRenderedRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(resume.FieldTemplate, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(resume.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if _u.mutation.ScoresCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -729,6 +1151,96 @@ func (_u *ResumeUpdateOne) sqlSave(ctx context.Context) (_node *Resume, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.SharesTable,
+			Columns: []string{resume.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharesIDs(); len(nodes) > 0 && !_u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.SharesTable,
+			Columns: []string{resume.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.SharesTable,
+			Columns: []string{resume.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScoreRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ScoreRunsTable,
+			Columns: []string{resume.ScoreRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scorerun.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScoreRunsIDs(); len(nodes) > 0 && !_u.mutation.ScoreRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ScoreRunsTable,
+			Columns: []string{resume.ScoreRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scorerun.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScoreRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resume.ScoreRunsTable,
+			Columns: []string{resume.ScoreRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scorerun.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Resume{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
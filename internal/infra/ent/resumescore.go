@@ -19,22 +19,30 @@ type ResumeScore struct {
 	// ID of the ent.
 	// 得分ID
 	ID int64 `json:"id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// 关联简历ID
 	ResumeID int64 `json:"resume_id,omitempty"`
 	// 关联的模块ID或维度ID
 	TargetID int64 `json:"target_id,omitempty"`
 	// 类型: 0=module, 1=dimension
 	TargetType int32 `json:"target_type,omitempty"`
+	// 维度得分所属的自定义模块ID，内置模块为0
+	ScopeID int64 `json:"scope_id,omitempty"`
 	// 得分
 	Score float64 `json:"score,omitempty"`
 	// 权重
 	Weight float64 `json:"weight,omitempty"`
+	// 评分状态（模块得分）: pending=等待评分, done=已评分, failed=评分失败, stale=数据已修改但未重新评分
+	State resumescore.State `json:"state,omitempty"`
+	// 请求评分时的简历内容版本号，用于丢弃过期的评分结果
+	Revision int64 `json:"revision,omitempty"`
+	// 是否已有得分，首次评分完成前的占位记录为 false
+	Scored bool `json:"scored,omitempty"`
 	// 创建时间
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新时间
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// 删除时间
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ResumeScoreQuery when eager-loading is set.
 	Edges        ResumeScoreEdges `json:"edges"`
@@ -66,11 +74,15 @@ func (*ResumeScore) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case resumescore.FieldScored:
+			values[i] = new(sql.NullBool)
 		case resumescore.FieldScore, resumescore.FieldWeight:
 			values[i] = new(sql.NullFloat64)
-		case resumescore.FieldID, resumescore.FieldResumeID, resumescore.FieldTargetID, resumescore.FieldTargetType:
+		case resumescore.FieldID, resumescore.FieldResumeID, resumescore.FieldTargetID, resumescore.FieldTargetType, resumescore.FieldScopeID, resumescore.FieldRevision:
 			values[i] = new(sql.NullInt64)
-		case resumescore.FieldCreatedAt, resumescore.FieldUpdatedAt, resumescore.FieldDeletedAt:
+		case resumescore.FieldState:
+			values[i] = new(sql.NullString)
+		case resumescore.FieldDeletedAt, resumescore.FieldCreatedAt, resumescore.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -93,6 +105,13 @@ func (_m *ResumeScore) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int64(value.Int64)
+		case resumescore.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case resumescore.FieldResumeID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field resume_id", values[i])
@@ -111,6 +130,12 @@ func (_m *ResumeScore) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TargetType = int32(value.Int64)
 			}
+		case resumescore.FieldScopeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_id", values[i])
+			} else if value.Valid {
+				_m.ScopeID = value.Int64
+			}
 		case resumescore.FieldScore:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field score", values[i])
@@ -123,6 +148,24 @@ func (_m *ResumeScore) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Weight = value.Float64
 			}
+		case resumescore.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = resumescore.State(value.String)
+			}
+		case resumescore.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = value.Int64
+			}
+		case resumescore.FieldScored:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field scored", values[i])
+			} else if value.Valid {
+				_m.Scored = value.Bool
+			}
 		case resumescore.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -135,13 +178,6 @@ func (_m *ResumeScore) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
-		case resumescore.FieldDeletedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
-			} else if value.Valid {
-				_m.DeletedAt = new(time.Time)
-				*_m.DeletedAt = value.Time
-			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -183,6 +219,11 @@ func (_m *ResumeScore) String() string {
 	var builder strings.Builder
 	builder.WriteString("ResumeScore(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("resume_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ResumeID))
 	builder.WriteString(", ")
@@ -192,22 +233,29 @@ func (_m *ResumeScore) String() string {
 	builder.WriteString("target_type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TargetType))
 	builder.WriteString(", ")
+	builder.WriteString("scope_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScopeID))
+	builder.WriteString(", ")
 	builder.WriteString("score=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Score))
 	builder.WriteString(", ")
 	builder.WriteString("weight=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Weight))
 	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", _m.State))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("scored=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scored))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
-	if v := _m.DeletedAt; v != nil {
-		builder.WriteString("deleted_at=")
-		builder.WriteString(v.Format(time.ANSIC))
-	}
 	builder.WriteByte(')')
 	return builder.String()
 }
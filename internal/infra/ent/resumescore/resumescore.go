@@ -3,8 +3,10 @@
 package resumescore
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -14,22 +16,30 @@ const (
 	Label = "resume_score"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldResumeID holds the string denoting the resume_id field in the database.
 	FieldResumeID = "resume_id"
 	// FieldTargetID holds the string denoting the target_id field in the database.
 	FieldTargetID = "target_id"
 	// FieldTargetType holds the string denoting the target_type field in the database.
 	FieldTargetType = "target_type"
+	// FieldScopeID holds the string denoting the scope_id field in the database.
+	FieldScopeID = "scope_id"
 	// FieldScore holds the string denoting the score field in the database.
 	FieldScore = "score"
 	// FieldWeight holds the string denoting the weight field in the database.
 	FieldWeight = "weight"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldScored holds the string denoting the scored field in the database.
+	FieldScored = "scored"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
-	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
-	FieldDeletedAt = "deleted_at"
 	// EdgeResume holds the string denoting the resume edge name in mutations.
 	EdgeResume = "resume"
 	// Table holds the table name of the resumescore in the database.
@@ -46,14 +56,18 @@ const (
 // Columns holds all SQL columns for resumescore fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
 	FieldResumeID,
 	FieldTargetID,
 	FieldTargetType,
+	FieldScopeID,
 	FieldScore,
 	FieldWeight,
+	FieldState,
+	FieldRevision,
+	FieldScored,
 	FieldCreatedAt,
 	FieldUpdatedAt,
-	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -66,11 +80,24 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "cv2/internal/infra/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultScopeID holds the default value on creation for the "scope_id" field.
+	DefaultScopeID int64
 	// DefaultScore holds the default value on creation for the "score" field.
 	DefaultScore float64
 	// DefaultWeight holds the default value on creation for the "weight" field.
 	DefaultWeight float64
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int64
+	// DefaultScored holds the default value on creation for the "scored" field.
+	DefaultScored bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -81,6 +108,34 @@ var (
 	DefaultID func() int64
 )
 
+// State defines the type for the "state" enum field.
+type State string
+
+// StateDone is the default value of the State enum.
+const DefaultState = StateDone
+
+// State values.
+const (
+	StatePending State = "pending"
+	StateDone    State = "done"
+	StateFailed  State = "failed"
+	StateStale   State = "stale"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StatePending, StateDone, StateFailed, StateStale:
+		return nil
+	default:
+		return fmt.Errorf("resumescore: invalid enum value for state field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the ResumeScore queries.
 type OrderOption func(*sql.Selector)
 
@@ -89,6 +144,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByResumeID orders the results by the resume_id field.
 func ByResumeID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResumeID, opts...).ToFunc()
@@ -104,6 +164,11 @@ func ByTargetType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTargetType, opts...).ToFunc()
 }
 
+// ByScopeID orders the results by the scope_id field.
+func ByScopeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopeID, opts...).ToFunc()
+}
+
 // ByScore orders the results by the score field.
 func ByScore(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldScore, opts...).ToFunc()
@@ -114,6 +179,21 @@ func ByWeight(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWeight, opts...).ToFunc()
 }
 
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// ByScored orders the results by the scored field.
+func ByScored(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScored, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -124,11 +204,6 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
-// ByDeletedAt orders the results by the deleted_at field.
-func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
-}
-
 // ByResumeField orders the results by resume field.
 func ByResumeField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -55,6 +55,11 @@ func IDLTE(id int64) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // ResumeID applies equality check predicate on the "resume_id" field. It's identical to ResumeIDEQ.
 func ResumeID(v int64) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldEQ(FieldResumeID, v))
@@ -70,6 +75,11 @@ func TargetType(v int32) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldEQ(FieldTargetType, v))
 }
 
+// ScopeID applies equality check predicate on the "scope_id" field. It's identical to ScopeIDEQ.
+func ScopeID(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldEQ(FieldScopeID, v))
+}
+
 // Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
 func Score(v float64) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldEQ(FieldScore, v))
@@ -80,6 +90,16 @@ func Weight(v float64) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldEQ(FieldWeight, v))
 }
 
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldEQ(FieldRevision, v))
+}
+
+// Scored applies equality check predicate on the "scored" field. It's identical to ScoredEQ.
+func Scored(v bool) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldEQ(FieldScored, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldEQ(FieldCreatedAt, v))
@@ -90,11 +110,56 @@ func UpdatedAt(v time.Time) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
-// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
-func DeletedAt(v time.Time) predicate.ResumeScore {
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // ResumeIDEQ applies the EQ predicate on the "resume_id" field.
 func ResumeIDEQ(v int64) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldEQ(FieldResumeID, v))
@@ -195,6 +260,46 @@ func TargetTypeLTE(v int32) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldLTE(FieldTargetType, v))
 }
 
+// ScopeIDEQ applies the EQ predicate on the "scope_id" field.
+func ScopeIDEQ(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldEQ(FieldScopeID, v))
+}
+
+// ScopeIDNEQ applies the NEQ predicate on the "scope_id" field.
+func ScopeIDNEQ(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldNEQ(FieldScopeID, v))
+}
+
+// ScopeIDIn applies the In predicate on the "scope_id" field.
+func ScopeIDIn(vs ...int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldIn(FieldScopeID, vs...))
+}
+
+// ScopeIDNotIn applies the NotIn predicate on the "scope_id" field.
+func ScopeIDNotIn(vs ...int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldNotIn(FieldScopeID, vs...))
+}
+
+// ScopeIDGT applies the GT predicate on the "scope_id" field.
+func ScopeIDGT(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldGT(FieldScopeID, v))
+}
+
+// ScopeIDGTE applies the GTE predicate on the "scope_id" field.
+func ScopeIDGTE(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldGTE(FieldScopeID, v))
+}
+
+// ScopeIDLT applies the LT predicate on the "scope_id" field.
+func ScopeIDLT(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldLT(FieldScopeID, v))
+}
+
+// ScopeIDLTE applies the LTE predicate on the "scope_id" field.
+func ScopeIDLTE(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldLTE(FieldScopeID, v))
+}
+
 // ScoreEQ applies the EQ predicate on the "score" field.
 func ScoreEQ(v float64) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldEQ(FieldScore, v))
@@ -275,6 +380,76 @@ func WeightLTE(v float64) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldLTE(FieldWeight, v))
 }
 
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldNotIn(FieldState, vs...))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int64) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldLTE(FieldRevision, v))
+}
+
+// ScoredEQ applies the EQ predicate on the "scored" field.
+func ScoredEQ(v bool) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldEQ(FieldScored, v))
+}
+
+// ScoredNEQ applies the NEQ predicate on the "scored" field.
+func ScoredNEQ(v bool) predicate.ResumeScore {
+	return predicate.ResumeScore(sql.FieldNEQ(FieldScored, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldEQ(FieldCreatedAt, v))
@@ -355,56 +530,6 @@ func UpdatedAtLTE(v time.Time) predicate.ResumeScore {
 	return predicate.ResumeScore(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
-// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
-func DeletedAtEQ(v time.Time) predicate.ResumeScore {
-	return predicate.ResumeScore(sql.FieldEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
-func DeletedAtNEQ(v time.Time) predicate.ResumeScore {
-	return predicate.ResumeScore(sql.FieldNEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtIn applies the In predicate on the "deleted_at" field.
-func DeletedAtIn(vs ...time.Time) predicate.ResumeScore {
-	return predicate.ResumeScore(sql.FieldIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
-func DeletedAtNotIn(vs ...time.Time) predicate.ResumeScore {
-	return predicate.ResumeScore(sql.FieldNotIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtGT applies the GT predicate on the "deleted_at" field.
-func DeletedAtGT(v time.Time) predicate.ResumeScore {
-	return predicate.ResumeScore(sql.FieldGT(FieldDeletedAt, v))
-}
-
-// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
-func DeletedAtGTE(v time.Time) predicate.ResumeScore {
-	return predicate.ResumeScore(sql.FieldGTE(FieldDeletedAt, v))
-}
-
-// DeletedAtLT applies the LT predicate on the "deleted_at" field.
-func DeletedAtLT(v time.Time) predicate.ResumeScore {
-	return predicate.ResumeScore(sql.FieldLT(FieldDeletedAt, v))
-}
-
-// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
-func DeletedAtLTE(v time.Time) predicate.ResumeScore {
-	return predicate.ResumeScore(sql.FieldLTE(FieldDeletedAt, v))
-}
-
-// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
-func DeletedAtIsNil() predicate.ResumeScore {
-	return predicate.ResumeScore(sql.FieldIsNull(FieldDeletedAt))
-}
-
-// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
-func DeletedAtNotNil() predicate.ResumeScore {
-	return predicate.ResumeScore(sql.FieldNotNull(FieldDeletedAt))
-}
-
 // HasResume applies the HasEdge predicate on the "resume" edge.
 func HasResume() predicate.ResumeScore {
 	return predicate.ResumeScore(func(s *sql.Selector) {
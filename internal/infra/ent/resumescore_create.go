@@ -21,6 +21,20 @@ type ResumeScoreCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ResumeScoreCreate) SetDeletedAt(v time.Time) *ResumeScoreCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ResumeScoreCreate) SetNillableDeletedAt(v *time.Time) *ResumeScoreCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetResumeID sets the "resume_id" field.
 func (_c *ResumeScoreCreate) SetResumeID(v int64) *ResumeScoreCreate {
 	_c.mutation.SetResumeID(v)
@@ -39,6 +53,20 @@ func (_c *ResumeScoreCreate) SetTargetType(v int32) *ResumeScoreCreate {
 	return _c
 }
 
+// SetScopeID sets the "scope_id" field.
+func (_c *ResumeScoreCreate) SetScopeID(v int64) *ResumeScoreCreate {
+	_c.mutation.SetScopeID(v)
+	return _c
+}
+
+// SetNillableScopeID sets the "scope_id" field if the given value is not nil.
+func (_c *ResumeScoreCreate) SetNillableScopeID(v *int64) *ResumeScoreCreate {
+	if v != nil {
+		_c.SetScopeID(*v)
+	}
+	return _c
+}
+
 // SetScore sets the "score" field.
 func (_c *ResumeScoreCreate) SetScore(v float64) *ResumeScoreCreate {
 	_c.mutation.SetScore(v)
@@ -67,6 +95,48 @@ func (_c *ResumeScoreCreate) SetNillableWeight(v *float64) *ResumeScoreCreate {
 	return _c
 }
 
+// SetState sets the "state" field.
+func (_c *ResumeScoreCreate) SetState(v resumescore.State) *ResumeScoreCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *ResumeScoreCreate) SetNillableState(v *resumescore.State) *ResumeScoreCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *ResumeScoreCreate) SetRevision(v int64) *ResumeScoreCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *ResumeScoreCreate) SetNillableRevision(v *int64) *ResumeScoreCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
+// SetScored sets the "scored" field.
+func (_c *ResumeScoreCreate) SetScored(v bool) *ResumeScoreCreate {
+	_c.mutation.SetScored(v)
+	return _c
+}
+
+// SetNillableScored sets the "scored" field if the given value is not nil.
+func (_c *ResumeScoreCreate) SetNillableScored(v *bool) *ResumeScoreCreate {
+	if v != nil {
+		_c.SetScored(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ResumeScoreCreate) SetCreatedAt(v time.Time) *ResumeScoreCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -95,20 +165,6 @@ func (_c *ResumeScoreCreate) SetNillableUpdatedAt(v *time.Time) *ResumeScoreCrea
 	return _c
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_c *ResumeScoreCreate) SetDeletedAt(v time.Time) *ResumeScoreCreate {
-	_c.mutation.SetDeletedAt(v)
-	return _c
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_c *ResumeScoreCreate) SetNillableDeletedAt(v *time.Time) *ResumeScoreCreate {
-	if v != nil {
-		_c.SetDeletedAt(*v)
-	}
-	return _c
-}
-
 // SetID sets the "id" field.
 func (_c *ResumeScoreCreate) SetID(v int64) *ResumeScoreCreate {
 	_c.mutation.SetID(v)
@@ -135,7 +191,9 @@ func (_c *ResumeScoreCreate) Mutation() *ResumeScoreMutation {
 
 // Save creates the ResumeScore in the database.
 func (_c *ResumeScoreCreate) Save(ctx context.Context) (*ResumeScore, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -162,7 +220,11 @@ func (_c *ResumeScoreCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ResumeScoreCreate) defaults() {
+func (_c *ResumeScoreCreate) defaults() error {
+	if _, ok := _c.mutation.ScopeID(); !ok {
+		v := resumescore.DefaultScopeID
+		_c.mutation.SetScopeID(v)
+	}
 	if _, ok := _c.mutation.Score(); !ok {
 		v := resumescore.DefaultScore
 		_c.mutation.SetScore(v)
@@ -171,18 +233,40 @@ func (_c *ResumeScoreCreate) defaults() {
 		v := resumescore.DefaultWeight
 		_c.mutation.SetWeight(v)
 	}
+	if _, ok := _c.mutation.State(); !ok {
+		v := resumescore.DefaultState
+		_c.mutation.SetState(v)
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		v := resumescore.DefaultRevision
+		_c.mutation.SetRevision(v)
+	}
+	if _, ok := _c.mutation.Scored(); !ok {
+		v := resumescore.DefaultScored
+		_c.mutation.SetScored(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if resumescore.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized resumescore.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := resumescore.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if resumescore.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized resumescore.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := resumescore.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if resumescore.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized resumescore.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := resumescore.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -196,12 +280,29 @@ func (_c *ResumeScoreCreate) check() error {
 	if _, ok := _c.mutation.TargetType(); !ok {
 		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "ResumeScore.target_type"`)}
 	}
+	if _, ok := _c.mutation.ScopeID(); !ok {
+		return &ValidationError{Name: "scope_id", err: errors.New(`ent: missing required field "ResumeScore.scope_id"`)}
+	}
 	if _, ok := _c.mutation.Score(); !ok {
 		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "ResumeScore.score"`)}
 	}
 	if _, ok := _c.mutation.Weight(); !ok {
 		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "ResumeScore.weight"`)}
 	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "ResumeScore.state"`)}
+	}
+	if v, ok := _c.mutation.State(); ok {
+		if err := resumescore.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "ResumeScore.state": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "ResumeScore.revision"`)}
+	}
+	if _, ok := _c.mutation.Scored(); !ok {
+		return &ValidationError{Name: "scored", err: errors.New(`ent: missing required field "ResumeScore.scored"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResumeScore.created_at"`)}
 	}
@@ -243,6 +344,10 @@ func (_c *ResumeScoreCreate) createSpec() (*ResumeScore, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(resumescore.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.TargetID(); ok {
 		_spec.SetField(resumescore.FieldTargetID, field.TypeInt64, value)
 		_node.TargetID = value
@@ -251,6 +356,10 @@ func (_c *ResumeScoreCreate) createSpec() (*ResumeScore, *sqlgraph.CreateSpec) {
 		_spec.SetField(resumescore.FieldTargetType, field.TypeInt32, value)
 		_node.TargetType = value
 	}
+	if value, ok := _c.mutation.ScopeID(); ok {
+		_spec.SetField(resumescore.FieldScopeID, field.TypeInt64, value)
+		_node.ScopeID = value
+	}
 	if value, ok := _c.mutation.Score(); ok {
 		_spec.SetField(resumescore.FieldScore, field.TypeFloat64, value)
 		_node.Score = value
@@ -259,6 +368,18 @@ func (_c *ResumeScoreCreate) createSpec() (*ResumeScore, *sqlgraph.CreateSpec) {
 		_spec.SetField(resumescore.FieldWeight, field.TypeFloat64, value)
 		_node.Weight = value
 	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(resumescore.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(resumescore.FieldRevision, field.TypeInt64, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Scored(); ok {
+		_spec.SetField(resumescore.FieldScored, field.TypeBool, value)
+		_node.Scored = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(resumescore.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -267,10 +388,6 @@ func (_c *ResumeScoreCreate) createSpec() (*ResumeScore, *sqlgraph.CreateSpec) {
 		_spec.SetField(resumescore.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := _c.mutation.DeletedAt(); ok {
-		_spec.SetField(resumescore.FieldDeletedAt, field.TypeTime, value)
-		_node.DeletedAt = &value
-	}
 	if nodes := _c.mutation.ResumeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -298,12 +298,12 @@ func (_q *ResumeScoreQuery) WithResume(opts ...func(*ResumeQuery)) *ResumeScoreQ
 // Example:
 //
 //	var v []struct {
-//		ResumeID int64 `json:"resume_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.ResumeScore.Query().
-//		GroupBy(resumescore.FieldResumeID).
+//		GroupBy(resumescore.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ResumeScoreQuery) GroupBy(field string, fields ...string) *ResumeScoreGroupBy {
@@ -321,11 +321,11 @@ func (_q *ResumeScoreQuery) GroupBy(field string, fields ...string) *ResumeScore
 // Example:
 //
 //	var v []struct {
-//		ResumeID int64 `json:"resume_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.ResumeScore.Query().
-//		Select(resumescore.FieldResumeID).
+//		Select(resumescore.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (_q *ResumeScoreQuery) Select(fields ...string) *ResumeScoreSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
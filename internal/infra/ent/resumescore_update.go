@@ -29,6 +29,26 @@ func (_u *ResumeScoreUpdate) Where(ps ...predicate.ResumeScore) *ResumeScoreUpda
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ResumeScoreUpdate) SetDeletedAt(v time.Time) *ResumeScoreUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ResumeScoreUpdate) SetNillableDeletedAt(v *time.Time) *ResumeScoreUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ResumeScoreUpdate) ClearDeletedAt() *ResumeScoreUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetResumeID sets the "resume_id" field.
 func (_u *ResumeScoreUpdate) SetResumeID(v int64) *ResumeScoreUpdate {
 	_u.mutation.SetResumeID(v)
@@ -85,6 +105,27 @@ func (_u *ResumeScoreUpdate) AddTargetType(v int32) *ResumeScoreUpdate {
 	return _u
 }
 
+// SetScopeID sets the "scope_id" field.
+func (_u *ResumeScoreUpdate) SetScopeID(v int64) *ResumeScoreUpdate {
+	_u.mutation.ResetScopeID()
+	_u.mutation.SetScopeID(v)
+	return _u
+}
+
+// SetNillableScopeID sets the "scope_id" field if the given value is not nil.
+func (_u *ResumeScoreUpdate) SetNillableScopeID(v *int64) *ResumeScoreUpdate {
+	if v != nil {
+		_u.SetScopeID(*v)
+	}
+	return _u
+}
+
+// AddScopeID adds value to the "scope_id" field.
+func (_u *ResumeScoreUpdate) AddScopeID(v int64) *ResumeScoreUpdate {
+	_u.mutation.AddScopeID(v)
+	return _u
+}
+
 // SetScore sets the "score" field.
 func (_u *ResumeScoreUpdate) SetScore(v float64) *ResumeScoreUpdate {
 	_u.mutation.ResetScore()
@@ -127,29 +168,58 @@ func (_u *ResumeScoreUpdate) AddWeight(v float64) *ResumeScoreUpdate {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *ResumeScoreUpdate) SetUpdatedAt(v time.Time) *ResumeScoreUpdate {
-	_u.mutation.SetUpdatedAt(v)
+// SetState sets the "state" field.
+func (_u *ResumeScoreUpdate) SetState(v resumescore.State) *ResumeScoreUpdate {
+	_u.mutation.SetState(v)
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *ResumeScoreUpdate) SetDeletedAt(v time.Time) *ResumeScoreUpdate {
-	_u.mutation.SetDeletedAt(v)
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *ResumeScoreUpdate) SetNillableState(v *resumescore.State) *ResumeScoreUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *ResumeScoreUpdate) SetNillableDeletedAt(v *time.Time) *ResumeScoreUpdate {
+// SetRevision sets the "revision" field.
+func (_u *ResumeScoreUpdate) SetRevision(v int64) *ResumeScoreUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *ResumeScoreUpdate) SetNillableRevision(v *int64) *ResumeScoreUpdate {
 	if v != nil {
-		_u.SetDeletedAt(*v)
+		_u.SetRevision(*v)
 	}
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *ResumeScoreUpdate) ClearDeletedAt() *ResumeScoreUpdate {
-	_u.mutation.ClearDeletedAt()
+// AddRevision adds value to the "revision" field.
+func (_u *ResumeScoreUpdate) AddRevision(v int64) *ResumeScoreUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetScored sets the "scored" field.
+func (_u *ResumeScoreUpdate) SetScored(v bool) *ResumeScoreUpdate {
+	_u.mutation.SetScored(v)
+	return _u
+}
+
+// SetNillableScored sets the "scored" field if the given value is not nil.
+func (_u *ResumeScoreUpdate) SetNillableScored(v *bool) *ResumeScoreUpdate {
+	if v != nil {
+		_u.SetScored(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ResumeScoreUpdate) SetUpdatedAt(v time.Time) *ResumeScoreUpdate {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
@@ -171,7 +241,9 @@ func (_u *ResumeScoreUpdate) ClearResume() *ResumeScoreUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ResumeScoreUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -198,15 +270,24 @@ func (_u *ResumeScoreUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ResumeScoreUpdate) defaults() {
+func (_u *ResumeScoreUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if resumescore.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized resumescore.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := resumescore.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ResumeScoreUpdate) check() error {
+	if v, ok := _u.mutation.State(); ok {
+		if err := resumescore.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "ResumeScore.state": %w`, err)}
+		}
+	}
 	if _u.mutation.ResumeCleared() && len(_u.mutation.ResumeIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ResumeScore.resume"`)
 	}
@@ -225,6 +306,12 @@ func (_u *ResumeScoreUpdate) sqlSave(ctx context.Context) (_node int, err error)
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(resumescore.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(resumescore.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.TargetID(); ok {
 		_spec.SetField(resumescore.FieldTargetID, field.TypeInt64, value)
 	}
@@ -237,6 +324,12 @@ func (_u *ResumeScoreUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedTargetType(); ok {
 		_spec.AddField(resumescore.FieldTargetType, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.ScopeID(); ok {
+		_spec.SetField(resumescore.FieldScopeID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedScopeID(); ok {
+		_spec.AddField(resumescore.FieldScopeID, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.Score(); ok {
 		_spec.SetField(resumescore.FieldScore, field.TypeFloat64, value)
 	}
@@ -249,14 +342,20 @@ func (_u *ResumeScoreUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedWeight(); ok {
 		_spec.AddField(resumescore.FieldWeight, field.TypeFloat64, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(resumescore.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(resumescore.FieldState, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(resumescore.FieldDeletedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(resumescore.FieldRevision, field.TypeInt64, value)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(resumescore.FieldDeletedAt, field.TypeTime)
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(resumescore.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Scored(); ok {
+		_spec.SetField(resumescore.FieldScored, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumescore.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if _u.mutation.ResumeCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -307,6 +406,26 @@ type ResumeScoreUpdateOne struct {
 	mutation *ResumeScoreMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ResumeScoreUpdateOne) SetDeletedAt(v time.Time) *ResumeScoreUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ResumeScoreUpdateOne) SetNillableDeletedAt(v *time.Time) *ResumeScoreUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ResumeScoreUpdateOne) ClearDeletedAt() *ResumeScoreUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetResumeID sets the "resume_id" field.
 func (_u *ResumeScoreUpdateOne) SetResumeID(v int64) *ResumeScoreUpdateOne {
 	_u.mutation.SetResumeID(v)
@@ -363,6 +482,27 @@ func (_u *ResumeScoreUpdateOne) AddTargetType(v int32) *ResumeScoreUpdateOne {
 	return _u
 }
 
+// SetScopeID sets the "scope_id" field.
+func (_u *ResumeScoreUpdateOne) SetScopeID(v int64) *ResumeScoreUpdateOne {
+	_u.mutation.ResetScopeID()
+	_u.mutation.SetScopeID(v)
+	return _u
+}
+
+// SetNillableScopeID sets the "scope_id" field if the given value is not nil.
+func (_u *ResumeScoreUpdateOne) SetNillableScopeID(v *int64) *ResumeScoreUpdateOne {
+	if v != nil {
+		_u.SetScopeID(*v)
+	}
+	return _u
+}
+
+// AddScopeID adds value to the "scope_id" field.
+func (_u *ResumeScoreUpdateOne) AddScopeID(v int64) *ResumeScoreUpdateOne {
+	_u.mutation.AddScopeID(v)
+	return _u
+}
+
 // SetScore sets the "score" field.
 func (_u *ResumeScoreUpdateOne) SetScore(v float64) *ResumeScoreUpdateOne {
 	_u.mutation.ResetScore()
@@ -405,29 +545,58 @@ func (_u *ResumeScoreUpdateOne) AddWeight(v float64) *ResumeScoreUpdateOne {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *ResumeScoreUpdateOne) SetUpdatedAt(v time.Time) *ResumeScoreUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// SetState sets the "state" field.
+func (_u *ResumeScoreUpdateOne) SetState(v resumescore.State) *ResumeScoreUpdateOne {
+	_u.mutation.SetState(v)
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *ResumeScoreUpdateOne) SetDeletedAt(v time.Time) *ResumeScoreUpdateOne {
-	_u.mutation.SetDeletedAt(v)
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *ResumeScoreUpdateOne) SetNillableState(v *resumescore.State) *ResumeScoreUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *ResumeScoreUpdateOne) SetNillableDeletedAt(v *time.Time) *ResumeScoreUpdateOne {
+// SetRevision sets the "revision" field.
+func (_u *ResumeScoreUpdateOne) SetRevision(v int64) *ResumeScoreUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *ResumeScoreUpdateOne) SetNillableRevision(v *int64) *ResumeScoreUpdateOne {
 	if v != nil {
-		_u.SetDeletedAt(*v)
+		_u.SetRevision(*v)
 	}
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *ResumeScoreUpdateOne) ClearDeletedAt() *ResumeScoreUpdateOne {
-	_u.mutation.ClearDeletedAt()
+// AddRevision adds value to the "revision" field.
+func (_u *ResumeScoreUpdateOne) AddRevision(v int64) *ResumeScoreUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetScored sets the "scored" field.
+func (_u *ResumeScoreUpdateOne) SetScored(v bool) *ResumeScoreUpdateOne {
+	_u.mutation.SetScored(v)
+	return _u
+}
+
+// SetNillableScored sets the "scored" field if the given value is not nil.
+func (_u *ResumeScoreUpdateOne) SetNillableScored(v *bool) *ResumeScoreUpdateOne {
+	if v != nil {
+		_u.SetScored(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ResumeScoreUpdateOne) SetUpdatedAt(v time.Time) *ResumeScoreUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
@@ -462,7 +631,9 @@ func (_u *ResumeScoreUpdateOne) Select(field string, fields ...string) *ResumeSc
 
 // Save executes the query and returns the updated ResumeScore entity.
 func (_u *ResumeScoreUpdateOne) Save(ctx context.Context) (*ResumeScore, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -489,15 +660,24 @@ func (_u *ResumeScoreUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ResumeScoreUpdateOne) defaults() {
+func (_u *ResumeScoreUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if resumescore.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized resumescore.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := resumescore.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ResumeScoreUpdateOne) check() error {
+	if v, ok := _u.mutation.State(); ok {
+		if err := resumescore.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "ResumeScore.state": %w`, err)}
+		}
+	}
 	if _u.mutation.ResumeCleared() && len(_u.mutation.ResumeIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ResumeScore.resume"`)
 	}
@@ -533,6 +713,12 @@ func (_u *ResumeScoreUpdateOne) sqlSave(ctx context.Context) (_node *ResumeScore
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(resumescore.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(resumescore.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.TargetID(); ok {
 		_spec.SetField(resumescore.FieldTargetID, field.TypeInt64, value)
 	}
@@ -545,6 +731,12 @@ func (_u *ResumeScoreUpdateOne) sqlSave(ctx context.Context) (_node *ResumeScore
 	if value, ok := _u.mutation.AddedTargetType(); ok {
 		_spec.AddField(resumescore.FieldTargetType, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.ScopeID(); ok {
+		_spec.SetField(resumescore.FieldScopeID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedScopeID(); ok {
+		_spec.AddField(resumescore.FieldScopeID, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.Score(); ok {
 		_spec.SetField(resumescore.FieldScore, field.TypeFloat64, value)
 	}
@@ -557,14 +749,20 @@ func (_u *ResumeScoreUpdateOne) sqlSave(ctx context.Context) (_node *ResumeScore
 	if value, ok := _u.mutation.AddedWeight(); ok {
 		_spec.AddField(resumescore.FieldWeight, field.TypeFloat64, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(resumescore.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(resumescore.FieldState, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(resumescore.FieldDeletedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(resumescore.FieldRevision, field.TypeInt64, value)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(resumescore.FieldDeletedAt, field.TypeTime)
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(resumescore.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Scored(); ok {
+		_spec.SetField(resumescore.FieldScored, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumescore.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if _u.mutation.ResumeCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/resumeshare"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ResumeShare is the model entity for the ResumeShare schema.
+type ResumeShare struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 分享ID
+	ID int64 `json:"id,omitempty"`
+	// 关联简历ID
+	ResumeID int64 `json:"resume_id,omitempty"`
+	// 创建人用户ID
+	UserID int64 `json:"user_id,omitempty"`
+	// 租户ID
+	TenantID string `json:"tenant_id,omitempty"`
+	// 分享令牌
+	Token string `json:"token,omitempty"`
+	// 权限: read=只能在线查看, download=可下载 PDF
+	Permission resumeshare.Permission `json:"permission,omitempty"`
+	// 是否脱敏分享
+	Redact bool `json:"redact,omitempty"`
+	// 访问密码的 bcrypt 哈希，为空表示不需要密码
+	PasswordHash string `json:"-"`
+	// 过期时间，为空表示永不过期
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// 撤销时间
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// 访问次数
+	ViewCount int64 `json:"view_count,omitempty"`
+	// 最近访问时间
+	LastViewedAt *time.Time `json:"last_viewed_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResumeShareQuery when eager-loading is set.
+	Edges        ResumeShareEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ResumeShareEdges holds the relations/edges for other nodes in the graph.
+type ResumeShareEdges struct {
+	// 关联简历
+	Resume *Resume `json:"resume,omitempty"`
+	// 访问记录
+	Views []*ResumeShareView `json:"views,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ResumeOrErr returns the Resume value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResumeShareEdges) ResumeOrErr() (*Resume, error) {
+	if e.Resume != nil {
+		return e.Resume, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: resume.Label}
+	}
+	return nil, &NotLoadedError{edge: "resume"}
+}
+
+// ViewsOrErr returns the Views value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResumeShareEdges) ViewsOrErr() ([]*ResumeShareView, error) {
+	if e.loadedTypes[1] {
+		return e.Views, nil
+	}
+	return nil, &NotLoadedError{edge: "views"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResumeShare) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resumeshare.FieldRedact:
+			values[i] = new(sql.NullBool)
+		case resumeshare.FieldID, resumeshare.FieldResumeID, resumeshare.FieldUserID, resumeshare.FieldViewCount:
+			values[i] = new(sql.NullInt64)
+		case resumeshare.FieldTenantID, resumeshare.FieldToken, resumeshare.FieldPermission, resumeshare.FieldPasswordHash:
+			values[i] = new(sql.NullString)
+		case resumeshare.FieldExpiresAt, resumeshare.FieldRevokedAt, resumeshare.FieldLastViewedAt, resumeshare.FieldCreatedAt, resumeshare.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResumeShare fields.
+func (_m *ResumeShare) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resumeshare.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case resumeshare.FieldResumeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_id", values[i])
+			} else if value.Valid {
+				_m.ResumeID = value.Int64
+			}
+		case resumeshare.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.Int64
+			}
+		case resumeshare.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case resumeshare.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case resumeshare.FieldPermission:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field permission", values[i])
+			} else if value.Valid {
+				_m.Permission = resumeshare.Permission(value.String)
+			}
+		case resumeshare.FieldRedact:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field redact", values[i])
+			} else if value.Valid {
+				_m.Redact = value.Bool
+			}
+		case resumeshare.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				_m.PasswordHash = value.String
+			}
+		case resumeshare.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case resumeshare.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(time.Time)
+				*_m.RevokedAt = value.Time
+			}
+		case resumeshare.FieldViewCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field view_count", values[i])
+			} else if value.Valid {
+				_m.ViewCount = value.Int64
+			}
+		case resumeshare.FieldLastViewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_viewed_at", values[i])
+			} else if value.Valid {
+				_m.LastViewedAt = new(time.Time)
+				*_m.LastViewedAt = value.Time
+			}
+		case resumeshare.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case resumeshare.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResumeShare.
+// This includes values selected through modifiers, order, etc.
+func (_m *ResumeShare) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryResume queries the "resume" edge of the ResumeShare entity.
+func (_m *ResumeShare) QueryResume() *ResumeQuery {
+	return NewResumeShareClient(_m.config).QueryResume(_m)
+}
+
+// QueryViews queries the "views" edge of the ResumeShare entity.
+func (_m *ResumeShare) QueryViews() *ResumeShareViewQuery {
+	return NewResumeShareClient(_m.config).QueryViews(_m)
+}
+
+// Update returns a builder for updating this ResumeShare.
+// Note that you need to call ResumeShare.Unwrap() before calling this method if this ResumeShare
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ResumeShare) Update() *ResumeShareUpdateOne {
+	return NewResumeShareClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ResumeShare entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ResumeShare) Unwrap() *ResumeShare {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ResumeShare is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ResumeShare) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResumeShare(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("resume_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResumeID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(_m.Token)
+	builder.WriteString(", ")
+	builder.WriteString("permission=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Permission))
+	builder.WriteString(", ")
+	builder.WriteString("redact=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Redact))
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("view_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ViewCount))
+	builder.WriteString(", ")
+	if v := _m.LastViewedAt; v != nil {
+		builder.WriteString("last_viewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResumeShares is a parsable slice of ResumeShare.
+type ResumeShares []*ResumeShare
@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ResumeShareCreate is the builder for creating a ResumeShare entity.
+type ResumeShareCreate struct {
+	config
+	mutation *ResumeShareMutation
+	hooks    []Hook
+}
+
+// SetResumeID sets the "resume_id" field.
+func (_c *ResumeShareCreate) SetResumeID(v int64) *ResumeShareCreate {
+	_c.mutation.SetResumeID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ResumeShareCreate) SetUserID(v int64) *ResumeShareCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ResumeShareCreate) SetTenantID(v string) *ResumeShareCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *ResumeShareCreate) SetToken(v string) *ResumeShareCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetPermission sets the "permission" field.
+func (_c *ResumeShareCreate) SetPermission(v resumeshare.Permission) *ResumeShareCreate {
+	_c.mutation.SetPermission(v)
+	return _c
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (_c *ResumeShareCreate) SetNillablePermission(v *resumeshare.Permission) *ResumeShareCreate {
+	if v != nil {
+		_c.SetPermission(*v)
+	}
+	return _c
+}
+
+// SetRedact sets the "redact" field.
+func (_c *ResumeShareCreate) SetRedact(v bool) *ResumeShareCreate {
+	_c.mutation.SetRedact(v)
+	return _c
+}
+
+// SetNillableRedact sets the "redact" field if the given value is not nil.
+func (_c *ResumeShareCreate) SetNillableRedact(v *bool) *ResumeShareCreate {
+	if v != nil {
+		_c.SetRedact(*v)
+	}
+	return _c
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_c *ResumeShareCreate) SetPasswordHash(v string) *ResumeShareCreate {
+	_c.mutation.SetPasswordHash(v)
+	return _c
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_c *ResumeShareCreate) SetNillablePasswordHash(v *string) *ResumeShareCreate {
+	if v != nil {
+		_c.SetPasswordHash(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ResumeShareCreate) SetExpiresAt(v time.Time) *ResumeShareCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ResumeShareCreate) SetNillableExpiresAt(v *time.Time) *ResumeShareCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *ResumeShareCreate) SetRevokedAt(v time.Time) *ResumeShareCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *ResumeShareCreate) SetNillableRevokedAt(v *time.Time) *ResumeShareCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetViewCount sets the "view_count" field.
+func (_c *ResumeShareCreate) SetViewCount(v int64) *ResumeShareCreate {
+	_c.mutation.SetViewCount(v)
+	return _c
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_c *ResumeShareCreate) SetNillableViewCount(v *int64) *ResumeShareCreate {
+	if v != nil {
+		_c.SetViewCount(*v)
+	}
+	return _c
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (_c *ResumeShareCreate) SetLastViewedAt(v time.Time) *ResumeShareCreate {
+	_c.mutation.SetLastViewedAt(v)
+	return _c
+}
+
+// SetNillableLastViewedAt sets the "last_viewed_at" field if the given value is not nil.
+func (_c *ResumeShareCreate) SetNillableLastViewedAt(v *time.Time) *ResumeShareCreate {
+	if v != nil {
+		_c.SetLastViewedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ResumeShareCreate) SetCreatedAt(v time.Time) *ResumeShareCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ResumeShareCreate) SetNillableCreatedAt(v *time.Time) *ResumeShareCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ResumeShareCreate) SetUpdatedAt(v time.Time) *ResumeShareCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ResumeShareCreate) SetNillableUpdatedAt(v *time.Time) *ResumeShareCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ResumeShareCreate) SetID(v int64) *ResumeShareCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ResumeShareCreate) SetNillableID(v *int64) *ResumeShareCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetResume sets the "resume" edge to the Resume entity.
+func (_c *ResumeShareCreate) SetResume(v *Resume) *ResumeShareCreate {
+	return _c.SetResumeID(v.ID)
+}
+
+// AddViewIDs adds the "views" edge to the ResumeShareView entity by IDs.
+func (_c *ResumeShareCreate) AddViewIDs(ids ...int64) *ResumeShareCreate {
+	_c.mutation.AddViewIDs(ids...)
+	return _c
+}
+
+// AddViews adds the "views" edges to the ResumeShareView entity.
+func (_c *ResumeShareCreate) AddViews(v ...*ResumeShareView) *ResumeShareCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddViewIDs(ids...)
+}
+
+// Mutation returns the ResumeShareMutation object of the builder.
+func (_c *ResumeShareCreate) Mutation() *ResumeShareMutation {
+	return _c.mutation
+}
+
+// Save creates the ResumeShare in the database.
+func (_c *ResumeShareCreate) Save(ctx context.Context) (*ResumeShare, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ResumeShareCreate) SaveX(ctx context.Context) *ResumeShare {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ResumeShareCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ResumeShareCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ResumeShareCreate) defaults() {
+	if _, ok := _c.mutation.Permission(); !ok {
+		v := resumeshare.DefaultPermission
+		_c.mutation.SetPermission(v)
+	}
+	if _, ok := _c.mutation.Redact(); !ok {
+		v := resumeshare.DefaultRedact
+		_c.mutation.SetRedact(v)
+	}
+	if _, ok := _c.mutation.PasswordHash(); !ok {
+		v := resumeshare.DefaultPasswordHash
+		_c.mutation.SetPasswordHash(v)
+	}
+	if _, ok := _c.mutation.ViewCount(); !ok {
+		v := resumeshare.DefaultViewCount
+		_c.mutation.SetViewCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := resumeshare.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := resumeshare.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := resumeshare.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ResumeShareCreate) check() error {
+	if _, ok := _c.mutation.ResumeID(); !ok {
+		return &ValidationError{Name: "resume_id", err: errors.New(`ent: missing required field "ResumeShare.resume_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ResumeShare.user_id"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ResumeShare.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "ResumeShare.token"`)}
+	}
+	if v, ok := _c.mutation.Token(); ok {
+		if err := resumeshare.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "ResumeShare.token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Permission(); !ok {
+		return &ValidationError{Name: "permission", err: errors.New(`ent: missing required field "ResumeShare.permission"`)}
+	}
+	if v, ok := _c.mutation.Permission(); ok {
+		if err := resumeshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "ResumeShare.permission": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Redact(); !ok {
+		return &ValidationError{Name: "redact", err: errors.New(`ent: missing required field "ResumeShare.redact"`)}
+	}
+	if _, ok := _c.mutation.ViewCount(); !ok {
+		return &ValidationError{Name: "view_count", err: errors.New(`ent: missing required field "ResumeShare.view_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResumeShare.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ResumeShare.updated_at"`)}
+	}
+	if len(_c.mutation.ResumeIDs()) == 0 {
+		return &ValidationError{Name: "resume", err: errors.New(`ent: missing required edge "ResumeShare.resume"`)}
+	}
+	return nil
+}
+
+func (_c *ResumeShareCreate) sqlSave(ctx context.Context) (*ResumeShare, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ResumeShareCreate) createSpec() (*ResumeShare, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResumeShare{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(resumeshare.Table, sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(resumeshare.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(resumeshare.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(resumeshare.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.Permission(); ok {
+		_spec.SetField(resumeshare.FieldPermission, field.TypeEnum, value)
+		_node.Permission = value
+	}
+	if value, ok := _c.mutation.Redact(); ok {
+		_spec.SetField(resumeshare.FieldRedact, field.TypeBool, value)
+		_node.Redact = value
+	}
+	if value, ok := _c.mutation.PasswordHash(); ok {
+		_spec.SetField(resumeshare.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(resumeshare.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(resumeshare.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.ViewCount(); ok {
+		_spec.SetField(resumeshare.FieldViewCount, field.TypeInt64, value)
+		_node.ViewCount = value
+	}
+	if value, ok := _c.mutation.LastViewedAt(); ok {
+		_spec.SetField(resumeshare.FieldLastViewedAt, field.TypeTime, value)
+		_node.LastViewedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(resumeshare.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumeshare.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeshare.ResumeTable,
+			Columns: []string{resumeshare.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResumeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resumeshare.ViewsTable,
+			Columns: []string{resumeshare.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ResumeShareCreateBulk is the builder for creating many ResumeShare entities in bulk.
+type ResumeShareCreateBulk struct {
+	config
+	err      error
+	builders []*ResumeShareCreate
+}
+
+// Save creates the ResumeShare entities in the database.
+func (_c *ResumeShareCreateBulk) Save(ctx context.Context) ([]*ResumeShare, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ResumeShare, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResumeShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ResumeShareCreateBulk) SaveX(ctx context.Context) []*ResumeShare {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ResumeShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ResumeShareCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
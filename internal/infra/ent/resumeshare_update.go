@@ -0,0 +1,703 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cv2/internal/infra/ent/predicate"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ResumeShareUpdate is the builder for updating ResumeShare entities.
+type ResumeShareUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ResumeShareMutation
+}
+
+// Where appends a list predicates to the ResumeShareUpdate builder.
+func (_u *ResumeShareUpdate) Where(ps ...predicate.ResumeShare) *ResumeShareUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPermission sets the "permission" field.
+func (_u *ResumeShareUpdate) SetPermission(v resumeshare.Permission) *ResumeShareUpdate {
+	_u.mutation.SetPermission(v)
+	return _u
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (_u *ResumeShareUpdate) SetNillablePermission(v *resumeshare.Permission) *ResumeShareUpdate {
+	if v != nil {
+		_u.SetPermission(*v)
+	}
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *ResumeShareUpdate) SetPasswordHash(v string) *ResumeShareUpdate {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *ResumeShareUpdate) SetNillablePasswordHash(v *string) *ResumeShareUpdate {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (_u *ResumeShareUpdate) ClearPasswordHash() *ResumeShareUpdate {
+	_u.mutation.ClearPasswordHash()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ResumeShareUpdate) SetExpiresAt(v time.Time) *ResumeShareUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ResumeShareUpdate) SetNillableExpiresAt(v *time.Time) *ResumeShareUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ResumeShareUpdate) ClearExpiresAt() *ResumeShareUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *ResumeShareUpdate) SetRevokedAt(v time.Time) *ResumeShareUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *ResumeShareUpdate) SetNillableRevokedAt(v *time.Time) *ResumeShareUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *ResumeShareUpdate) ClearRevokedAt() *ResumeShareUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetViewCount sets the "view_count" field.
+func (_u *ResumeShareUpdate) SetViewCount(v int64) *ResumeShareUpdate {
+	_u.mutation.ResetViewCount()
+	_u.mutation.SetViewCount(v)
+	return _u
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_u *ResumeShareUpdate) SetNillableViewCount(v *int64) *ResumeShareUpdate {
+	if v != nil {
+		_u.SetViewCount(*v)
+	}
+	return _u
+}
+
+// AddViewCount adds value to the "view_count" field.
+func (_u *ResumeShareUpdate) AddViewCount(v int64) *ResumeShareUpdate {
+	_u.mutation.AddViewCount(v)
+	return _u
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (_u *ResumeShareUpdate) SetLastViewedAt(v time.Time) *ResumeShareUpdate {
+	_u.mutation.SetLastViewedAt(v)
+	return _u
+}
+
+// SetNillableLastViewedAt sets the "last_viewed_at" field if the given value is not nil.
+func (_u *ResumeShareUpdate) SetNillableLastViewedAt(v *time.Time) *ResumeShareUpdate {
+	if v != nil {
+		_u.SetLastViewedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (_u *ResumeShareUpdate) ClearLastViewedAt() *ResumeShareUpdate {
+	_u.mutation.ClearLastViewedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ResumeShareUpdate) SetUpdatedAt(v time.Time) *ResumeShareUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddViewIDs adds the "views" edge to the ResumeShareView entity by IDs.
+func (_u *ResumeShareUpdate) AddViewIDs(ids ...int64) *ResumeShareUpdate {
+	_u.mutation.AddViewIDs(ids...)
+	return _u
+}
+
+// AddViews adds the "views" edges to the ResumeShareView entity.
+func (_u *ResumeShareUpdate) AddViews(v ...*ResumeShareView) *ResumeShareUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddViewIDs(ids...)
+}
+
+// Mutation returns the ResumeShareMutation object of the builder.
+func (_u *ResumeShareUpdate) Mutation() *ResumeShareMutation {
+	return _u.mutation
+}
+
+// ClearViews clears all "views" edges to the ResumeShareView entity.
+func (_u *ResumeShareUpdate) ClearViews() *ResumeShareUpdate {
+	_u.mutation.ClearViews()
+	return _u
+}
+
+// RemoveViewIDs removes the "views" edge to ResumeShareView entities by IDs.
+func (_u *ResumeShareUpdate) RemoveViewIDs(ids ...int64) *ResumeShareUpdate {
+	_u.mutation.RemoveViewIDs(ids...)
+	return _u
+}
+
+// RemoveViews removes "views" edges to ResumeShareView entities.
+func (_u *ResumeShareUpdate) RemoveViews(v ...*ResumeShareView) *ResumeShareUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveViewIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ResumeShareUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ResumeShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ResumeShareUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ResumeShareUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ResumeShareUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := resumeshare.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ResumeShareUpdate) check() error {
+	if v, ok := _u.mutation.Permission(); ok {
+		if err := resumeshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "ResumeShare.permission": %w`, err)}
+		}
+	}
+	if _u.mutation.ResumeCleared() && len(_u.mutation.ResumeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResumeShare.resume"`)
+	}
+	return nil
+}
+
+func (_u *ResumeShareUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumeshare.Table, resumeshare.Columns, sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Permission(); ok {
+		_spec.SetField(resumeshare.FieldPermission, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(resumeshare.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(resumeshare.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(resumeshare.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(resumeshare.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(resumeshare.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(resumeshare.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ViewCount(); ok {
+		_spec.SetField(resumeshare.FieldViewCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedViewCount(); ok {
+		_spec.AddField(resumeshare.FieldViewCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastViewedAt(); ok {
+		_spec.SetField(resumeshare.FieldLastViewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastViewedAtCleared() {
+		_spec.ClearField(resumeshare.FieldLastViewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumeshare.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resumeshare.ViewsTable,
+			Columns: []string{resumeshare.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedViewsIDs(); len(nodes) > 0 && !_u.mutation.ViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resumeshare.ViewsTable,
+			Columns: []string{resumeshare.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resumeshare.ViewsTable,
+			Columns: []string{resumeshare.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumeshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ResumeShareUpdateOne is the builder for updating a single ResumeShare entity.
+type ResumeShareUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResumeShareMutation
+}
+
+// SetPermission sets the "permission" field.
+func (_u *ResumeShareUpdateOne) SetPermission(v resumeshare.Permission) *ResumeShareUpdateOne {
+	_u.mutation.SetPermission(v)
+	return _u
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (_u *ResumeShareUpdateOne) SetNillablePermission(v *resumeshare.Permission) *ResumeShareUpdateOne {
+	if v != nil {
+		_u.SetPermission(*v)
+	}
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *ResumeShareUpdateOne) SetPasswordHash(v string) *ResumeShareUpdateOne {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *ResumeShareUpdateOne) SetNillablePasswordHash(v *string) *ResumeShareUpdateOne {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (_u *ResumeShareUpdateOne) ClearPasswordHash() *ResumeShareUpdateOne {
+	_u.mutation.ClearPasswordHash()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ResumeShareUpdateOne) SetExpiresAt(v time.Time) *ResumeShareUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ResumeShareUpdateOne) SetNillableExpiresAt(v *time.Time) *ResumeShareUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ResumeShareUpdateOne) ClearExpiresAt() *ResumeShareUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *ResumeShareUpdateOne) SetRevokedAt(v time.Time) *ResumeShareUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *ResumeShareUpdateOne) SetNillableRevokedAt(v *time.Time) *ResumeShareUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *ResumeShareUpdateOne) ClearRevokedAt() *ResumeShareUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetViewCount sets the "view_count" field.
+func (_u *ResumeShareUpdateOne) SetViewCount(v int64) *ResumeShareUpdateOne {
+	_u.mutation.ResetViewCount()
+	_u.mutation.SetViewCount(v)
+	return _u
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_u *ResumeShareUpdateOne) SetNillableViewCount(v *int64) *ResumeShareUpdateOne {
+	if v != nil {
+		_u.SetViewCount(*v)
+	}
+	return _u
+}
+
+// AddViewCount adds value to the "view_count" field.
+func (_u *ResumeShareUpdateOne) AddViewCount(v int64) *ResumeShareUpdateOne {
+	_u.mutation.AddViewCount(v)
+	return _u
+}
+
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (_u *ResumeShareUpdateOne) SetLastViewedAt(v time.Time) *ResumeShareUpdateOne {
+	_u.mutation.SetLastViewedAt(v)
+	return _u
+}
+
+// SetNillableLastViewedAt sets the "last_viewed_at" field if the given value is not nil.
+func (_u *ResumeShareUpdateOne) SetNillableLastViewedAt(v *time.Time) *ResumeShareUpdateOne {
+	if v != nil {
+		_u.SetLastViewedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (_u *ResumeShareUpdateOne) ClearLastViewedAt() *ResumeShareUpdateOne {
+	_u.mutation.ClearLastViewedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ResumeShareUpdateOne) SetUpdatedAt(v time.Time) *ResumeShareUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddViewIDs adds the "views" edge to the ResumeShareView entity by IDs.
+func (_u *ResumeShareUpdateOne) AddViewIDs(ids ...int64) *ResumeShareUpdateOne {
+	_u.mutation.AddViewIDs(ids...)
+	return _u
+}
+
+// AddViews adds the "views" edges to the ResumeShareView entity.
+func (_u *ResumeShareUpdateOne) AddViews(v ...*ResumeShareView) *ResumeShareUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddViewIDs(ids...)
+}
+
+// Mutation returns the ResumeShareMutation object of the builder.
+func (_u *ResumeShareUpdateOne) Mutation() *ResumeShareMutation {
+	return _u.mutation
+}
+
+// ClearViews clears all "views" edges to the ResumeShareView entity.
+func (_u *ResumeShareUpdateOne) ClearViews() *ResumeShareUpdateOne {
+	_u.mutation.ClearViews()
+	return _u
+}
+
+// RemoveViewIDs removes the "views" edge to ResumeShareView entities by IDs.
+func (_u *ResumeShareUpdateOne) RemoveViewIDs(ids ...int64) *ResumeShareUpdateOne {
+	_u.mutation.RemoveViewIDs(ids...)
+	return _u
+}
+
+// RemoveViews removes "views" edges to ResumeShareView entities.
+func (_u *ResumeShareUpdateOne) RemoveViews(v ...*ResumeShareView) *ResumeShareUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveViewIDs(ids...)
+}
+
+// Where appends a list predicates to the ResumeShareUpdate builder.
+func (_u *ResumeShareUpdateOne) Where(ps ...predicate.ResumeShare) *ResumeShareUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ResumeShareUpdateOne) Select(field string, fields ...string) *ResumeShareUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ResumeShare entity.
+func (_u *ResumeShareUpdateOne) Save(ctx context.Context) (*ResumeShare, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ResumeShareUpdateOne) SaveX(ctx context.Context) *ResumeShare {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ResumeShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ResumeShareUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ResumeShareUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := resumeshare.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ResumeShareUpdateOne) check() error {
+	if v, ok := _u.mutation.Permission(); ok {
+		if err := resumeshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "ResumeShare.permission": %w`, err)}
+		}
+	}
+	if _u.mutation.ResumeCleared() && len(_u.mutation.ResumeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResumeShare.resume"`)
+	}
+	return nil
+}
+
+func (_u *ResumeShareUpdateOne) sqlSave(ctx context.Context) (_node *ResumeShare, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumeshare.Table, resumeshare.Columns, sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ResumeShare.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumeshare.FieldID)
+		for _, f := range fields {
+			if !resumeshare.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != resumeshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Permission(); ok {
+		_spec.SetField(resumeshare.FieldPermission, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(resumeshare.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(resumeshare.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(resumeshare.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(resumeshare.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(resumeshare.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(resumeshare.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ViewCount(); ok {
+		_spec.SetField(resumeshare.FieldViewCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedViewCount(); ok {
+		_spec.AddField(resumeshare.FieldViewCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastViewedAt(); ok {
+		_spec.SetField(resumeshare.FieldLastViewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastViewedAtCleared() {
+		_spec.ClearField(resumeshare.FieldLastViewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(resumeshare.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resumeshare.ViewsTable,
+			Columns: []string{resumeshare.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedViewsIDs(); len(nodes) > 0 && !_u.mutation.ViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resumeshare.ViewsTable,
+			Columns: []string{resumeshare.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resumeshare.ViewsTable,
+			Columns: []string{resumeshare.ViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ResumeShare{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumeshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ResumeShareView is the model entity for the ResumeShareView schema.
+type ResumeShareView struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 访问记录ID
+	ID int64 `json:"id,omitempty"`
+	// 关联分享ID
+	ShareID int64 `json:"share_id,omitempty"`
+	// 简历ID（冗余，便于按简历清理）
+	ResumeID int64 `json:"resume_id,omitempty"`
+	// 操作: view=在线查看, download=下载 PDF
+	Action resumeshareview.Action `json:"action,omitempty"`
+	// 来源页面（不含查询参数）
+	Referrer string `json:"referrer,omitempty"`
+	// 粗粒度客户端类型，如 Chrome/Windows
+	UserAgent string `json:"user_agent,omitempty"`
+	// 访问时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResumeShareViewQuery when eager-loading is set.
+	Edges        ResumeShareViewEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ResumeShareViewEdges holds the relations/edges for other nodes in the graph.
+type ResumeShareViewEdges struct {
+	// 关联分享
+	Share *ResumeShare `json:"share,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ShareOrErr returns the Share value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResumeShareViewEdges) ShareOrErr() (*ResumeShare, error) {
+	if e.Share != nil {
+		return e.Share, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: resumeshare.Label}
+	}
+	return nil, &NotLoadedError{edge: "share"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResumeShareView) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resumeshareview.FieldID, resumeshareview.FieldShareID, resumeshareview.FieldResumeID:
+			values[i] = new(sql.NullInt64)
+		case resumeshareview.FieldAction, resumeshareview.FieldReferrer, resumeshareview.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case resumeshareview.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResumeShareView fields.
+func (_m *ResumeShareView) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resumeshareview.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case resumeshareview.FieldShareID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field share_id", values[i])
+			} else if value.Valid {
+				_m.ShareID = value.Int64
+			}
+		case resumeshareview.FieldResumeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_id", values[i])
+			} else if value.Valid {
+				_m.ResumeID = value.Int64
+			}
+		case resumeshareview.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = resumeshareview.Action(value.String)
+			}
+		case resumeshareview.FieldReferrer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referrer", values[i])
+			} else if value.Valid {
+				_m.Referrer = value.String
+			}
+		case resumeshareview.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case resumeshareview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResumeShareView.
+// This includes values selected through modifiers, order, etc.
+func (_m *ResumeShareView) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryShare queries the "share" edge of the ResumeShareView entity.
+func (_m *ResumeShareView) QueryShare() *ResumeShareQuery {
+	return NewResumeShareViewClient(_m.config).QueryShare(_m)
+}
+
+// Update returns a builder for updating this ResumeShareView.
+// Note that you need to call ResumeShareView.Unwrap() before calling this method if this ResumeShareView
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ResumeShareView) Update() *ResumeShareViewUpdateOne {
+	return NewResumeShareViewClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ResumeShareView entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ResumeShareView) Unwrap() *ResumeShareView {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ResumeShareView is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ResumeShareView) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResumeShareView(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("share_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ShareID))
+	builder.WriteString(", ")
+	builder.WriteString("resume_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResumeID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("referrer=")
+	builder.WriteString(_m.Referrer)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResumeShareViews is a parsable slice of ResumeShareView.
+type ResumeShareViews []*ResumeShareView
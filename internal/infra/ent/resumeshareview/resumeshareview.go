@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package resumeshareview
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the resumeshareview type in the database.
+	Label = "resume_share_view"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldShareID holds the string denoting the share_id field in the database.
+	FieldShareID = "share_id"
+	// FieldResumeID holds the string denoting the resume_id field in the database.
+	FieldResumeID = "resume_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldReferrer holds the string denoting the referrer field in the database.
+	FieldReferrer = "referrer"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeShare holds the string denoting the share edge name in mutations.
+	EdgeShare = "share"
+	// Table holds the table name of the resumeshareview in the database.
+	Table = "cv_resume_share_view"
+	// ShareTable is the table that holds the share relation/edge.
+	ShareTable = "cv_resume_share_view"
+	// ShareInverseTable is the table name for the ResumeShare entity.
+	// It exists in this package in order to avoid circular dependency with the "resumeshare" package.
+	ShareInverseTable = "cv_resume_share"
+	// ShareColumn is the table column denoting the share relation/edge.
+	ShareColumn = "share_id"
+)
+
+// Columns holds all SQL columns for resumeshareview fields.
+var Columns = []string{
+	FieldID,
+	FieldShareID,
+	FieldResumeID,
+	FieldAction,
+	FieldReferrer,
+	FieldUserAgent,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReferrer holds the default value on creation for the "referrer" field.
+	DefaultReferrer string
+	// ReferrerValidator is a validator for the "referrer" field. It is called by the builders before save.
+	ReferrerValidator func(string) error
+	// DefaultUserAgent holds the default value on creation for the "user_agent" field.
+	DefaultUserAgent string
+	// UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	UserAgentValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() int64
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionView     Action = "view"
+	ActionDownload Action = "download"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionView, ActionDownload:
+		return nil
+	default:
+		return fmt.Errorf("resumeshareview: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the ResumeShareView queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByShareID orders the results by the share_id field.
+func ByShareID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareID, opts...).ToFunc()
+}
+
+// ByResumeID orders the results by the resume_id field.
+func ByResumeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumeID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByReferrer orders the results by the referrer field.
+func ByReferrer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferrer, opts...).ToFunc()
+}
+
+// ByUserAgent orders the results by the user_agent field.
+func ByUserAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAgent, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByShareField orders the results by share field.
+func ByShareField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newShareStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ShareTable, ShareColumn),
+	)
+}
@@ -0,0 +1,389 @@
+// Code generated by ent, DO NOT EDIT.
+
+package resumeshareview
+
+import (
+	"cv2/internal/infra/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldLTE(FieldID, id))
+}
+
+// ShareID applies equality check predicate on the "share_id" field. It's identical to ShareIDEQ.
+func ShareID(v int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldShareID, v))
+}
+
+// ResumeID applies equality check predicate on the "resume_id" field. It's identical to ResumeIDEQ.
+func ResumeID(v int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldResumeID, v))
+}
+
+// Referrer applies equality check predicate on the "referrer" field. It's identical to ReferrerEQ.
+func Referrer(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldReferrer, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ShareIDEQ applies the EQ predicate on the "share_id" field.
+func ShareIDEQ(v int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldShareID, v))
+}
+
+// ShareIDNEQ applies the NEQ predicate on the "share_id" field.
+func ShareIDNEQ(v int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNEQ(FieldShareID, v))
+}
+
+// ShareIDIn applies the In predicate on the "share_id" field.
+func ShareIDIn(vs ...int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldIn(FieldShareID, vs...))
+}
+
+// ShareIDNotIn applies the NotIn predicate on the "share_id" field.
+func ShareIDNotIn(vs ...int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNotIn(FieldShareID, vs...))
+}
+
+// ResumeIDEQ applies the EQ predicate on the "resume_id" field.
+func ResumeIDEQ(v int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldResumeID, v))
+}
+
+// ResumeIDNEQ applies the NEQ predicate on the "resume_id" field.
+func ResumeIDNEQ(v int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNEQ(FieldResumeID, v))
+}
+
+// ResumeIDIn applies the In predicate on the "resume_id" field.
+func ResumeIDIn(vs ...int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldIn(FieldResumeID, vs...))
+}
+
+// ResumeIDNotIn applies the NotIn predicate on the "resume_id" field.
+func ResumeIDNotIn(vs ...int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNotIn(FieldResumeID, vs...))
+}
+
+// ResumeIDGT applies the GT predicate on the "resume_id" field.
+func ResumeIDGT(v int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldGT(FieldResumeID, v))
+}
+
+// ResumeIDGTE applies the GTE predicate on the "resume_id" field.
+func ResumeIDGTE(v int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldGTE(FieldResumeID, v))
+}
+
+// ResumeIDLT applies the LT predicate on the "resume_id" field.
+func ResumeIDLT(v int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldLT(FieldResumeID, v))
+}
+
+// ResumeIDLTE applies the LTE predicate on the "resume_id" field.
+func ResumeIDLTE(v int64) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldLTE(FieldResumeID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ReferrerEQ applies the EQ predicate on the "referrer" field.
+func ReferrerEQ(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldReferrer, v))
+}
+
+// ReferrerNEQ applies the NEQ predicate on the "referrer" field.
+func ReferrerNEQ(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNEQ(FieldReferrer, v))
+}
+
+// ReferrerIn applies the In predicate on the "referrer" field.
+func ReferrerIn(vs ...string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldIn(FieldReferrer, vs...))
+}
+
+// ReferrerNotIn applies the NotIn predicate on the "referrer" field.
+func ReferrerNotIn(vs ...string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNotIn(FieldReferrer, vs...))
+}
+
+// ReferrerGT applies the GT predicate on the "referrer" field.
+func ReferrerGT(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldGT(FieldReferrer, v))
+}
+
+// ReferrerGTE applies the GTE predicate on the "referrer" field.
+func ReferrerGTE(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldGTE(FieldReferrer, v))
+}
+
+// ReferrerLT applies the LT predicate on the "referrer" field.
+func ReferrerLT(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldLT(FieldReferrer, v))
+}
+
+// ReferrerLTE applies the LTE predicate on the "referrer" field.
+func ReferrerLTE(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldLTE(FieldReferrer, v))
+}
+
+// ReferrerContains applies the Contains predicate on the "referrer" field.
+func ReferrerContains(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldContains(FieldReferrer, v))
+}
+
+// ReferrerHasPrefix applies the HasPrefix predicate on the "referrer" field.
+func ReferrerHasPrefix(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldHasPrefix(FieldReferrer, v))
+}
+
+// ReferrerHasSuffix applies the HasSuffix predicate on the "referrer" field.
+func ReferrerHasSuffix(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldHasSuffix(FieldReferrer, v))
+}
+
+// ReferrerIsNil applies the IsNil predicate on the "referrer" field.
+func ReferrerIsNil() predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldIsNull(FieldReferrer))
+}
+
+// ReferrerNotNil applies the NotNil predicate on the "referrer" field.
+func ReferrerNotNil() predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNotNull(FieldReferrer))
+}
+
+// ReferrerEqualFold applies the EqualFold predicate on the "referrer" field.
+func ReferrerEqualFold(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEqualFold(FieldReferrer, v))
+}
+
+// ReferrerContainsFold applies the ContainsFold predicate on the "referrer" field.
+func ReferrerContainsFold(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldContainsFold(FieldReferrer, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentIsNil applies the IsNil predicate on the "user_agent" field.
+func UserAgentIsNil() predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldIsNull(FieldUserAgent))
+}
+
+// UserAgentNotNil applies the NotNil predicate on the "user_agent" field.
+func UserAgentNotNil() predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNotNull(FieldUserAgent))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasShare applies the HasEdge predicate on the "share" edge.
+func HasShare() predicate.ResumeShareView {
+	return predicate.ResumeShareView(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ShareTable, ShareColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareWith applies the HasEdge predicate on the "share" edge with a given conditions (other predicates).
+func HasShareWith(preds ...predicate.ResumeShare) predicate.ResumeShareView {
+	return predicate.ResumeShareView(func(s *sql.Selector) {
+		step := newShareStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResumeShareView) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResumeShareView) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResumeShareView) predicate.ResumeShareView {
+	return predicate.ResumeShareView(sql.NotPredicates(p))
+}
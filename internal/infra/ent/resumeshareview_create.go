@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ResumeShareViewCreate is the builder for creating a ResumeShareView entity.
+type ResumeShareViewCreate struct {
+	config
+	mutation *ResumeShareViewMutation
+	hooks    []Hook
+}
+
+// SetShareID sets the "share_id" field.
+func (_c *ResumeShareViewCreate) SetShareID(v int64) *ResumeShareViewCreate {
+	_c.mutation.SetShareID(v)
+	return _c
+}
+
+// SetResumeID sets the "resume_id" field.
+func (_c *ResumeShareViewCreate) SetResumeID(v int64) *ResumeShareViewCreate {
+	_c.mutation.SetResumeID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *ResumeShareViewCreate) SetAction(v resumeshareview.Action) *ResumeShareViewCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetReferrer sets the "referrer" field.
+func (_c *ResumeShareViewCreate) SetReferrer(v string) *ResumeShareViewCreate {
+	_c.mutation.SetReferrer(v)
+	return _c
+}
+
+// SetNillableReferrer sets the "referrer" field if the given value is not nil.
+func (_c *ResumeShareViewCreate) SetNillableReferrer(v *string) *ResumeShareViewCreate {
+	if v != nil {
+		_c.SetReferrer(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *ResumeShareViewCreate) SetUserAgent(v string) *ResumeShareViewCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *ResumeShareViewCreate) SetNillableUserAgent(v *string) *ResumeShareViewCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ResumeShareViewCreate) SetCreatedAt(v time.Time) *ResumeShareViewCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ResumeShareViewCreate) SetNillableCreatedAt(v *time.Time) *ResumeShareViewCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ResumeShareViewCreate) SetID(v int64) *ResumeShareViewCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ResumeShareViewCreate) SetNillableID(v *int64) *ResumeShareViewCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetShare sets the "share" edge to the ResumeShare entity.
+func (_c *ResumeShareViewCreate) SetShare(v *ResumeShare) *ResumeShareViewCreate {
+	return _c.SetShareID(v.ID)
+}
+
+// Mutation returns the ResumeShareViewMutation object of the builder.
+func (_c *ResumeShareViewCreate) Mutation() *ResumeShareViewMutation {
+	return _c.mutation
+}
+
+// Save creates the ResumeShareView in the database.
+func (_c *ResumeShareViewCreate) Save(ctx context.Context) (*ResumeShareView, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ResumeShareViewCreate) SaveX(ctx context.Context) *ResumeShareView {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ResumeShareViewCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ResumeShareViewCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ResumeShareViewCreate) defaults() {
+	if _, ok := _c.mutation.Referrer(); !ok {
+		v := resumeshareview.DefaultReferrer
+		_c.mutation.SetReferrer(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := resumeshareview.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := resumeshareview.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := resumeshareview.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ResumeShareViewCreate) check() error {
+	if _, ok := _c.mutation.ShareID(); !ok {
+		return &ValidationError{Name: "share_id", err: errors.New(`ent: missing required field "ResumeShareView.share_id"`)}
+	}
+	if _, ok := _c.mutation.ResumeID(); !ok {
+		return &ValidationError{Name: "resume_id", err: errors.New(`ent: missing required field "ResumeShareView.resume_id"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ResumeShareView.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := resumeshareview.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ResumeShareView.action": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Referrer(); ok {
+		if err := resumeshareview.ReferrerValidator(v); err != nil {
+			return &ValidationError{Name: "referrer", err: fmt.Errorf(`ent: validator failed for field "ResumeShareView.referrer": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := resumeshareview.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "ResumeShareView.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResumeShareView.created_at"`)}
+	}
+	if len(_c.mutation.ShareIDs()) == 0 {
+		return &ValidationError{Name: "share", err: errors.New(`ent: missing required edge "ResumeShareView.share"`)}
+	}
+	return nil
+}
+
+func (_c *ResumeShareViewCreate) sqlSave(ctx context.Context) (*ResumeShareView, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ResumeShareViewCreate) createSpec() (*ResumeShareView, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResumeShareView{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(resumeshareview.Table, sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ResumeID(); ok {
+		_spec.SetField(resumeshareview.FieldResumeID, field.TypeInt64, value)
+		_node.ResumeID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(resumeshareview.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Referrer(); ok {
+		_spec.SetField(resumeshareview.FieldReferrer, field.TypeString, value)
+		_node.Referrer = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(resumeshareview.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(resumeshareview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ShareIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumeshareview.ShareTable,
+			Columns: []string{resumeshareview.ShareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumeshare.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ShareID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ResumeShareViewCreateBulk is the builder for creating many ResumeShareView entities in bulk.
+type ResumeShareViewCreateBulk struct {
+	config
+	err      error
+	builders []*ResumeShareViewCreate
+}
+
+// Save creates the ResumeShareView entities in the database.
+func (_c *ResumeShareViewCreateBulk) Save(ctx context.Context) ([]*ResumeShareView, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ResumeShareView, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResumeShareViewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ResumeShareViewCreateBulk) SaveX(ctx context.Context) []*ResumeShareView {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ResumeShareViewCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ResumeShareViewCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cv2/internal/infra/ent/predicate"
+	"cv2/internal/infra/ent/resumeshareview"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ResumeShareViewDelete is the builder for deleting a ResumeShareView entity.
+type ResumeShareViewDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResumeShareViewMutation
+}
+
+// Where appends a list predicates to the ResumeShareViewDelete builder.
+func (_d *ResumeShareViewDelete) Where(ps ...predicate.ResumeShareView) *ResumeShareViewDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ResumeShareViewDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ResumeShareViewDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ResumeShareViewDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(resumeshareview.Table, sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ResumeShareViewDeleteOne is the builder for deleting a single ResumeShareView entity.
+type ResumeShareViewDeleteOne struct {
+	_d *ResumeShareViewDelete
+}
+
+// Where appends a list predicates to the ResumeShareViewDelete builder.
+func (_d *ResumeShareViewDeleteOne) Where(ps ...predicate.ResumeShareView) *ResumeShareViewDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ResumeShareViewDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{resumeshareview.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ResumeShareViewDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
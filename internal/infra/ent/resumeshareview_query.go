@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cv2/internal/infra/ent/predicate"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ResumeShareViewQuery is the builder for querying ResumeShareView entities.
+type ResumeShareViewQuery struct {
+	config
+	ctx        *QueryContext
+	order      []resumeshareview.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ResumeShareView
+	withShare  *ResumeShareQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResumeShareViewQuery builder.
+func (_q *ResumeShareViewQuery) Where(ps ...predicate.ResumeShareView) *ResumeShareViewQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ResumeShareViewQuery) Limit(limit int) *ResumeShareViewQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ResumeShareViewQuery) Offset(offset int) *ResumeShareViewQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ResumeShareViewQuery) Unique(unique bool) *ResumeShareViewQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ResumeShareViewQuery) Order(o ...resumeshareview.OrderOption) *ResumeShareViewQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryShare chains the current query on the "share" edge.
+func (_q *ResumeShareViewQuery) QueryShare() *ResumeShareQuery {
+	query := (&ResumeShareClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumeshareview.Table, resumeshareview.FieldID, selector),
+			sqlgraph.To(resumeshare.Table, resumeshare.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumeshareview.ShareTable, resumeshareview.ShareColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ResumeShareView entity from the query.
+// Returns a *NotFoundError when no ResumeShareView was found.
+func (_q *ResumeShareViewQuery) First(ctx context.Context) (*ResumeShareView, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{resumeshareview.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ResumeShareViewQuery) FirstX(ctx context.Context) *ResumeShareView {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResumeShareView ID from the query.
+// Returns a *NotFoundError when no ResumeShareView ID was found.
+func (_q *ResumeShareViewQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{resumeshareview.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *ResumeShareViewQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResumeShareView entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResumeShareView entity is found.
+// Returns a *NotFoundError when no ResumeShareView entities are found.
+func (_q *ResumeShareViewQuery) Only(ctx context.Context) (*ResumeShareView, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{resumeshareview.Label}
+	default:
+		return nil, &NotSingularError{resumeshareview.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ResumeShareViewQuery) OnlyX(ctx context.Context) *ResumeShareView {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResumeShareView ID in the query.
+// Returns a *NotSingularError when more than one ResumeShareView ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *ResumeShareViewQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{resumeshareview.Label}
+	default:
+		err = &NotSingularError{resumeshareview.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *ResumeShareViewQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResumeShareViews.
+func (_q *ResumeShareViewQuery) All(ctx context.Context) ([]*ResumeShareView, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ResumeShareView, *ResumeShareViewQuery]()
+	return withInterceptors[[]*ResumeShareView](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ResumeShareViewQuery) AllX(ctx context.Context) []*ResumeShareView {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResumeShareView IDs.
+func (_q *ResumeShareViewQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(resumeshareview.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *ResumeShareViewQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *ResumeShareViewQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ResumeShareViewQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ResumeShareViewQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ResumeShareViewQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ResumeShareViewQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResumeShareViewQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ResumeShareViewQuery) Clone() *ResumeShareViewQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ResumeShareViewQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]resumeshareview.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.ResumeShareView{}, _q.predicates...),
+		withShare:  _q.withShare.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithShare tells the query-builder to eager-load the nodes that are connected to
+// the "share" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ResumeShareViewQuery) WithShare(opts ...func(*ResumeShareQuery)) *ResumeShareViewQuery {
+	query := (&ResumeShareClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShare = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ShareID int64 `json:"share_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResumeShareView.Query().
+//		GroupBy(resumeshareview.FieldShareID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *ResumeShareViewQuery) GroupBy(field string, fields ...string) *ResumeShareViewGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ResumeShareViewGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = resumeshareview.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ShareID int64 `json:"share_id,omitempty"`
+//	}
+//
+//	client.ResumeShareView.Query().
+//		Select(resumeshareview.FieldShareID).
+//		Scan(ctx, &v)
+func (_q *ResumeShareViewQuery) Select(fields ...string) *ResumeShareViewSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ResumeShareViewSelect{ResumeShareViewQuery: _q}
+	sbuild.label = resumeshareview.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ResumeShareViewSelect configured with the given aggregations.
+func (_q *ResumeShareViewQuery) Aggregate(fns ...AggregateFunc) *ResumeShareViewSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ResumeShareViewQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !resumeshareview.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *ResumeShareViewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ResumeShareView, error) {
+	var (
+		nodes       = []*ResumeShareView{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withShare != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ResumeShareView).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ResumeShareView{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withShare; query != nil {
+		if err := _q.loadShare(ctx, query, nodes, nil,
+			func(n *ResumeShareView, e *ResumeShare) { n.Edges.Share = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *ResumeShareViewQuery) loadShare(ctx context.Context, query *ResumeShareQuery, nodes []*ResumeShareView, init func(*ResumeShareView), assign func(*ResumeShareView, *ResumeShare)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*ResumeShareView)
+	for i := range nodes {
+		fk := nodes[i].ShareID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(resumeshare.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "share_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *ResumeShareViewQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ResumeShareViewQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(resumeshareview.Table, resumeshareview.Columns, sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumeshareview.FieldID)
+		for i := range fields {
+			if fields[i] != resumeshareview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withShare != nil {
+			_spec.Node.AddColumnOnce(resumeshareview.FieldShareID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ResumeShareViewQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(resumeshareview.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = resumeshareview.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ResumeShareViewGroupBy is the group-by builder for ResumeShareView entities.
+type ResumeShareViewGroupBy struct {
+	selector
+	build *ResumeShareViewQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ResumeShareViewGroupBy) Aggregate(fns ...AggregateFunc) *ResumeShareViewGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ResumeShareViewGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResumeShareViewQuery, *ResumeShareViewGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ResumeShareViewGroupBy) sqlScan(ctx context.Context, root *ResumeShareViewQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ResumeShareViewSelect is the builder for selecting fields of ResumeShareView entities.
+type ResumeShareViewSelect struct {
+	*ResumeShareViewQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ResumeShareViewSelect) Aggregate(fns ...AggregateFunc) *ResumeShareViewSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ResumeShareViewSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResumeShareViewQuery, *ResumeShareViewSelect](ctx, _s.ResumeShareViewQuery, _s, _s.inters, v)
+}
+
+func (_s *ResumeShareViewSelect) sqlScan(ctx context.Context, root *ResumeShareViewQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
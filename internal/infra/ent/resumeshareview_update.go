@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cv2/internal/infra/ent/predicate"
+	"cv2/internal/infra/ent/resumeshareview"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ResumeShareViewUpdate is the builder for updating ResumeShareView entities.
+type ResumeShareViewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ResumeShareViewMutation
+}
+
+// Where appends a list predicates to the ResumeShareViewUpdate builder.
+func (_u *ResumeShareViewUpdate) Where(ps ...predicate.ResumeShareView) *ResumeShareViewUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the ResumeShareViewMutation object of the builder.
+func (_u *ResumeShareViewUpdate) Mutation() *ResumeShareViewMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ResumeShareViewUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ResumeShareViewUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ResumeShareViewUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ResumeShareViewUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ResumeShareViewUpdate) check() error {
+	if _u.mutation.ShareCleared() && len(_u.mutation.ShareIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResumeShareView.share"`)
+	}
+	return nil
+}
+
+func (_u *ResumeShareViewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumeshareview.Table, resumeshareview.Columns, sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ReferrerCleared() {
+		_spec.ClearField(resumeshareview.FieldReferrer, field.TypeString)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(resumeshareview.FieldUserAgent, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumeshareview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ResumeShareViewUpdateOne is the builder for updating a single ResumeShareView entity.
+type ResumeShareViewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResumeShareViewMutation
+}
+
+// Mutation returns the ResumeShareViewMutation object of the builder.
+func (_u *ResumeShareViewUpdateOne) Mutation() *ResumeShareViewMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ResumeShareViewUpdate builder.
+func (_u *ResumeShareViewUpdateOne) Where(ps ...predicate.ResumeShareView) *ResumeShareViewUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ResumeShareViewUpdateOne) Select(field string, fields ...string) *ResumeShareViewUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ResumeShareView entity.
+func (_u *ResumeShareViewUpdateOne) Save(ctx context.Context) (*ResumeShareView, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ResumeShareViewUpdateOne) SaveX(ctx context.Context) *ResumeShareView {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ResumeShareViewUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ResumeShareViewUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ResumeShareViewUpdateOne) check() error {
+	if _u.mutation.ShareCleared() && len(_u.mutation.ShareIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResumeShareView.share"`)
+	}
+	return nil
+}
+
+func (_u *ResumeShareViewUpdateOne) sqlSave(ctx context.Context) (_node *ResumeShareView, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumeshareview.Table, resumeshareview.Columns, sqlgraph.NewFieldSpec(resumeshareview.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ResumeShareView.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumeshareview.FieldID)
+		for _, f := range fields {
+			if !resumeshareview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != resumeshareview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ReferrerCleared() {
+		_spec.ClearField(resumeshareview.FieldReferrer, field.TypeString)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(resumeshareview.FieldUserAgent, field.TypeString)
+	}
+	_node = &ResumeShareView{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumeshareview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
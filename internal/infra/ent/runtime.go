@@ -2,240 +2,4 @@
 
 package ent
 
-import (
-	"cv2/internal/infra/ent/airecord"
-	"cv2/internal/infra/ent/city"
-	"cv2/internal/infra/ent/dictionary"
-	"cv2/internal/infra/ent/dimension"
-	"cv2/internal/infra/ent/module"
-	"cv2/internal/infra/ent/position"
-	"cv2/internal/infra/ent/resume"
-	"cv2/internal/infra/ent/resumescore"
-	"cv2/internal/infra/ent/resumeslot"
-	"cv2/internal/infra/ent/schema"
-	"time"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	airecordFields := schema.AIRecord{}.Fields()
-	_ = airecordFields
-	// airecordDescInfo is the schema descriptor for info field.
-	airecordDescInfo := airecordFields[5].Descriptor()
-	// airecord.DefaultInfo holds the default value on creation for the info field.
-	airecord.DefaultInfo = airecordDescInfo.Default.(string)
-	// airecordDescCreatedAt is the schema descriptor for created_at field.
-	airecordDescCreatedAt := airecordFields[6].Descriptor()
-	// airecord.DefaultCreatedAt holds the default value on creation for the created_at field.
-	airecord.DefaultCreatedAt = airecordDescCreatedAt.Default.(func() time.Time)
-	// airecordDescUpdatedAt is the schema descriptor for updated_at field.
-	airecordDescUpdatedAt := airecordFields[7].Descriptor()
-	// airecord.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	airecord.DefaultUpdatedAt = airecordDescUpdatedAt.Default.(func() time.Time)
-	// airecord.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	airecord.UpdateDefaultUpdatedAt = airecordDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// airecordDescID is the schema descriptor for id field.
-	airecordDescID := airecordFields[0].Descriptor()
-	// airecord.DefaultID holds the default value on creation for the id field.
-	airecord.DefaultID = airecordDescID.Default.(func() int64)
-	cityFields := schema.City{}.Fields()
-	_ = cityFields
-	// cityDescParentID is the schema descriptor for parent_id field.
-	cityDescParentID := cityFields[1].Descriptor()
-	// city.DefaultParentID holds the default value on creation for the parent_id field.
-	city.DefaultParentID = cityDescParentID.Default.(int64)
-	// cityDescTitle is the schema descriptor for title field.
-	cityDescTitle := cityFields[3].Descriptor()
-	// city.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	city.TitleValidator = cityDescTitle.Validators[0].(func(string) error)
-	// cityDescInitial is the schema descriptor for initial field.
-	cityDescInitial := cityFields[4].Descriptor()
-	// city.DefaultInitial holds the default value on creation for the initial field.
-	city.DefaultInitial = cityDescInitial.Default.(string)
-	// cityDescIsHot is the schema descriptor for is_hot field.
-	cityDescIsHot := cityFields[5].Descriptor()
-	// city.DefaultIsHot holds the default value on creation for the is_hot field.
-	city.DefaultIsHot = cityDescIsHot.Default.(bool)
-	// cityDescCreatedAt is the schema descriptor for created_at field.
-	cityDescCreatedAt := cityFields[6].Descriptor()
-	// city.DefaultCreatedAt holds the default value on creation for the created_at field.
-	city.DefaultCreatedAt = cityDescCreatedAt.Default.(func() time.Time)
-	// cityDescUpdatedAt is the schema descriptor for updated_at field.
-	cityDescUpdatedAt := cityFields[7].Descriptor()
-	// city.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	city.DefaultUpdatedAt = cityDescUpdatedAt.Default.(func() time.Time)
-	// city.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	city.UpdateDefaultUpdatedAt = cityDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// cityDescID is the schema descriptor for id field.
-	cityDescID := cityFields[0].Descriptor()
-	// city.DefaultID holds the default value on creation for the id field.
-	city.DefaultID = cityDescID.Default.(func() int64)
-	dictionaryFields := schema.Dictionary{}.Fields()
-	_ = dictionaryFields
-	// dictionaryDescTitle is the schema descriptor for title field.
-	dictionaryDescTitle := dictionaryFields[1].Descriptor()
-	// dictionary.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	dictionary.TitleValidator = dictionaryDescTitle.Validators[0].(func(string) error)
-	// dictionaryDescType is the schema descriptor for type field.
-	dictionaryDescType := dictionaryFields[2].Descriptor()
-	// dictionary.TypeValidator is a validator for the "type" field. It is called by the builders before save.
-	dictionary.TypeValidator = dictionaryDescType.Validators[0].(func(string) error)
-	// dictionaryDescOrder is the schema descriptor for order field.
-	dictionaryDescOrder := dictionaryFields[3].Descriptor()
-	// dictionary.DefaultOrder holds the default value on creation for the order field.
-	dictionary.DefaultOrder = dictionaryDescOrder.Default.(int)
-	// dictionaryDescCreatedAt is the schema descriptor for created_at field.
-	dictionaryDescCreatedAt := dictionaryFields[4].Descriptor()
-	// dictionary.DefaultCreatedAt holds the default value on creation for the created_at field.
-	dictionary.DefaultCreatedAt = dictionaryDescCreatedAt.Default.(func() time.Time)
-	// dictionaryDescUpdatedAt is the schema descriptor for updated_at field.
-	dictionaryDescUpdatedAt := dictionaryFields[5].Descriptor()
-	// dictionary.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	dictionary.DefaultUpdatedAt = dictionaryDescUpdatedAt.Default.(func() time.Time)
-	// dictionary.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	dictionary.UpdateDefaultUpdatedAt = dictionaryDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// dictionaryDescID is the schema descriptor for id field.
-	dictionaryDescID := dictionaryFields[0].Descriptor()
-	// dictionary.DefaultID holds the default value on creation for the id field.
-	dictionary.DefaultID = dictionaryDescID.Default.(func() int64)
-	dimensionFields := schema.Dimension{}.Fields()
-	_ = dimensionFields
-	// dimensionDescTitle is the schema descriptor for title field.
-	dimensionDescTitle := dimensionFields[3].Descriptor()
-	// dimension.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	dimension.TitleValidator = dimensionDescTitle.Validators[0].(func(string) error)
-	// dimensionDescCreatedAt is the schema descriptor for created_at field.
-	dimensionDescCreatedAt := dimensionFields[5].Descriptor()
-	// dimension.DefaultCreatedAt holds the default value on creation for the created_at field.
-	dimension.DefaultCreatedAt = dimensionDescCreatedAt.Default.(func() time.Time)
-	// dimensionDescUpdatedAt is the schema descriptor for updated_at field.
-	dimensionDescUpdatedAt := dimensionFields[6].Descriptor()
-	// dimension.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	dimension.DefaultUpdatedAt = dimensionDescUpdatedAt.Default.(func() time.Time)
-	// dimension.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	dimension.UpdateDefaultUpdatedAt = dimensionDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// dimensionDescID is the schema descriptor for id field.
-	dimensionDescID := dimensionFields[0].Descriptor()
-	// dimension.DefaultID holds the default value on creation for the id field.
-	dimension.DefaultID = dimensionDescID.Default.(func() int64)
-	moduleFields := schema.Module{}.Fields()
-	_ = moduleFields
-	// moduleDescTitle is the schema descriptor for title field.
-	moduleDescTitle := moduleFields[1].Descriptor()
-	// module.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	module.TitleValidator = moduleDescTitle.Validators[0].(func(string) error)
-	// moduleDescDescription is the schema descriptor for description field.
-	moduleDescDescription := moduleFields[2].Descriptor()
-	// module.DefaultDescription holds the default value on creation for the description field.
-	module.DefaultDescription = moduleDescDescription.Default.(string)
-	// moduleDescCreatedAt is the schema descriptor for created_at field.
-	moduleDescCreatedAt := moduleFields[3].Descriptor()
-	// module.DefaultCreatedAt holds the default value on creation for the created_at field.
-	module.DefaultCreatedAt = moduleDescCreatedAt.Default.(func() time.Time)
-	// moduleDescUpdatedAt is the schema descriptor for updated_at field.
-	moduleDescUpdatedAt := moduleFields[4].Descriptor()
-	// module.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	module.DefaultUpdatedAt = moduleDescUpdatedAt.Default.(func() time.Time)
-	// module.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	module.UpdateDefaultUpdatedAt = moduleDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// moduleDescID is the schema descriptor for id field.
-	moduleDescID := moduleFields[0].Descriptor()
-	// module.DefaultID holds the default value on creation for the id field.
-	module.DefaultID = moduleDescID.Default.(func() int64)
-	positionFields := schema.Position{}.Fields()
-	_ = positionFields
-	// positionDescParentID is the schema descriptor for parent_id field.
-	positionDescParentID := positionFields[2].Descriptor()
-	// position.DefaultParentID holds the default value on creation for the parent_id field.
-	position.DefaultParentID = positionDescParentID.Default.(int64)
-	// positionDescTitle is the schema descriptor for title field.
-	positionDescTitle := positionFields[3].Descriptor()
-	// position.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	position.TitleValidator = positionDescTitle.Validators[0].(func(string) error)
-	// positionDescID is the schema descriptor for id field.
-	positionDescID := positionFields[0].Descriptor()
-	// position.DefaultID holds the default value on creation for the id field.
-	position.DefaultID = positionDescID.Default.(func() int64)
-	resumeFields := schema.Resume{}.Fields()
-	_ = resumeFields
-	// resumeDescFilePath is the schema descriptor for file_path field.
-	resumeDescFilePath := resumeFields[3].Descriptor()
-	// resume.FilePathValidator is a validator for the "file_path" field. It is called by the builders before save.
-	resume.FilePathValidator = resumeDescFilePath.Validators[0].(func(string) error)
-	// resumeDescFileName is the schema descriptor for file_name field.
-	resumeDescFileName := resumeFields[4].Descriptor()
-	// resume.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
-	resume.FileNameValidator = resumeDescFileName.Validators[0].(func(string) error)
-	// resumeDescStatus is the schema descriptor for status field.
-	resumeDescStatus := resumeFields[5].Descriptor()
-	// resume.DefaultStatus holds the default value on creation for the status field.
-	resume.DefaultStatus = resumeDescStatus.Default.(int32)
-	// resumeDescCoverImage is the schema descriptor for cover_image field.
-	resumeDescCoverImage := resumeFields[6].Descriptor()
-	// resume.DefaultCoverImage holds the default value on creation for the cover_image field.
-	resume.DefaultCoverImage = resumeDescCoverImage.Default.(string)
-	// resumeDescCreatedAt is the schema descriptor for created_at field.
-	resumeDescCreatedAt := resumeFields[7].Descriptor()
-	// resume.DefaultCreatedAt holds the default value on creation for the created_at field.
-	resume.DefaultCreatedAt = resumeDescCreatedAt.Default.(func() time.Time)
-	// resumeDescUpdatedAt is the schema descriptor for updated_at field.
-	resumeDescUpdatedAt := resumeFields[8].Descriptor()
-	// resume.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	resume.DefaultUpdatedAt = resumeDescUpdatedAt.Default.(func() time.Time)
-	// resume.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	resume.UpdateDefaultUpdatedAt = resumeDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// resumeDescID is the schema descriptor for id field.
-	resumeDescID := resumeFields[0].Descriptor()
-	// resume.DefaultID holds the default value on creation for the id field.
-	resume.DefaultID = resumeDescID.Default.(func() int64)
-	resumescoreFields := schema.ResumeScore{}.Fields()
-	_ = resumescoreFields
-	// resumescoreDescScore is the schema descriptor for score field.
-	resumescoreDescScore := resumescoreFields[4].Descriptor()
-	// resumescore.DefaultScore holds the default value on creation for the score field.
-	resumescore.DefaultScore = resumescoreDescScore.Default.(float64)
-	// resumescoreDescWeight is the schema descriptor for weight field.
-	resumescoreDescWeight := resumescoreFields[5].Descriptor()
-	// resumescore.DefaultWeight holds the default value on creation for the weight field.
-	resumescore.DefaultWeight = resumescoreDescWeight.Default.(float64)
-	// resumescoreDescCreatedAt is the schema descriptor for created_at field.
-	resumescoreDescCreatedAt := resumescoreFields[6].Descriptor()
-	// resumescore.DefaultCreatedAt holds the default value on creation for the created_at field.
-	resumescore.DefaultCreatedAt = resumescoreDescCreatedAt.Default.(func() time.Time)
-	// resumescoreDescUpdatedAt is the schema descriptor for updated_at field.
-	resumescoreDescUpdatedAt := resumescoreFields[7].Descriptor()
-	// resumescore.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	resumescore.DefaultUpdatedAt = resumescoreDescUpdatedAt.Default.(func() time.Time)
-	// resumescore.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	resumescore.UpdateDefaultUpdatedAt = resumescoreDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// resumescoreDescID is the schema descriptor for id field.
-	resumescoreDescID := resumescoreFields[0].Descriptor()
-	// resumescore.DefaultID holds the default value on creation for the id field.
-	resumescore.DefaultID = resumescoreDescID.Default.(func() int64)
-	resumeslotFields := schema.ResumeSlot{}.Fields()
-	_ = resumeslotFields
-	// resumeslotDescUserID is the schema descriptor for user_id field.
-	resumeslotDescUserID := resumeslotFields[1].Descriptor()
-	// resumeslot.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
-	resumeslot.UserIDValidator = resumeslotDescUserID.Validators[0].(func(string) error)
-	// resumeslotDescMaxSlots is the schema descriptor for max_slots field.
-	resumeslotDescMaxSlots := resumeslotFields[2].Descriptor()
-	// resumeslot.DefaultMaxSlots holds the default value on creation for the max_slots field.
-	resumeslot.DefaultMaxSlots = resumeslotDescMaxSlots.Default.(int32)
-	// resumeslotDescCreatedAt is the schema descriptor for created_at field.
-	resumeslotDescCreatedAt := resumeslotFields[3].Descriptor()
-	// resumeslot.DefaultCreatedAt holds the default value on creation for the created_at field.
-	resumeslot.DefaultCreatedAt = resumeslotDescCreatedAt.Default.(func() time.Time)
-	// resumeslotDescUpdatedAt is the schema descriptor for updated_at field.
-	resumeslotDescUpdatedAt := resumeslotFields[4].Descriptor()
-	// resumeslot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	resumeslot.DefaultUpdatedAt = resumeslotDescUpdatedAt.Default.(func() time.Time)
-	// resumeslot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	resumeslot.UpdateDefaultUpdatedAt = resumeslotDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// resumeslotDescID is the schema descriptor for id field.
-	resumeslotDescID := resumeslotFields[0].Descriptor()
-	// resumeslot.DefaultID holds the default value on creation for the id field.
-	resumeslot.DefaultID = resumeslotDescID.Default.(func() int64)
-}
+// The schema-stitching logic is generated in cv2/internal/infra/ent/runtime/runtime.go
@@ -2,7 +2,397 @@
 
 package runtime
 
-// The schema-stitching logic is generated in cv2/internal/infra/ent/runtime.go
+import (
+	"cv2/internal/infra/ent/airecord"
+	"cv2/internal/infra/ent/city"
+	"cv2/internal/infra/ent/dictionary"
+	"cv2/internal/infra/ent/dimension"
+	"cv2/internal/infra/ent/module"
+	"cv2/internal/infra/ent/position"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
+	"cv2/internal/infra/ent/resumeslot"
+	"cv2/internal/infra/ent/schema"
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
+	"time"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	airecordFields := schema.AIRecord{}.Fields()
+	_ = airecordFields
+	// airecordDescInfo is the schema descriptor for info field.
+	airecordDescInfo := airecordFields[5].Descriptor()
+	// airecord.DefaultInfo holds the default value on creation for the info field.
+	airecord.DefaultInfo = airecordDescInfo.Default.(string)
+	// airecordDescCreatedAt is the schema descriptor for created_at field.
+	airecordDescCreatedAt := airecordFields[6].Descriptor()
+	// airecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	airecord.DefaultCreatedAt = airecordDescCreatedAt.Default.(func() time.Time)
+	// airecordDescUpdatedAt is the schema descriptor for updated_at field.
+	airecordDescUpdatedAt := airecordFields[7].Descriptor()
+	// airecord.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	airecord.DefaultUpdatedAt = airecordDescUpdatedAt.Default.(func() time.Time)
+	// airecord.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	airecord.UpdateDefaultUpdatedAt = airecordDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// airecordDescID is the schema descriptor for id field.
+	airecordDescID := airecordFields[0].Descriptor()
+	// airecord.DefaultID holds the default value on creation for the id field.
+	airecord.DefaultID = airecordDescID.Default.(func() int64)
+	cityFields := schema.City{}.Fields()
+	_ = cityFields
+	// cityDescParentID is the schema descriptor for parent_id field.
+	cityDescParentID := cityFields[1].Descriptor()
+	// city.DefaultParentID holds the default value on creation for the parent_id field.
+	city.DefaultParentID = cityDescParentID.Default.(int64)
+	// cityDescTitle is the schema descriptor for title field.
+	cityDescTitle := cityFields[3].Descriptor()
+	// city.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	city.TitleValidator = cityDescTitle.Validators[0].(func(string) error)
+	// cityDescInitial is the schema descriptor for initial field.
+	cityDescInitial := cityFields[4].Descriptor()
+	// city.DefaultInitial holds the default value on creation for the initial field.
+	city.DefaultInitial = cityDescInitial.Default.(string)
+	// cityDescIsHot is the schema descriptor for is_hot field.
+	cityDescIsHot := cityFields[5].Descriptor()
+	// city.DefaultIsHot holds the default value on creation for the is_hot field.
+	city.DefaultIsHot = cityDescIsHot.Default.(bool)
+	// cityDescCreatedAt is the schema descriptor for created_at field.
+	cityDescCreatedAt := cityFields[6].Descriptor()
+	// city.DefaultCreatedAt holds the default value on creation for the created_at field.
+	city.DefaultCreatedAt = cityDescCreatedAt.Default.(func() time.Time)
+	// cityDescUpdatedAt is the schema descriptor for updated_at field.
+	cityDescUpdatedAt := cityFields[7].Descriptor()
+	// city.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	city.DefaultUpdatedAt = cityDescUpdatedAt.Default.(func() time.Time)
+	// city.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	city.UpdateDefaultUpdatedAt = cityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// cityDescID is the schema descriptor for id field.
+	cityDescID := cityFields[0].Descriptor()
+	// city.DefaultID holds the default value on creation for the id field.
+	city.DefaultID = cityDescID.Default.(func() int64)
+	dictionaryFields := schema.Dictionary{}.Fields()
+	_ = dictionaryFields
+	// dictionaryDescTitle is the schema descriptor for title field.
+	dictionaryDescTitle := dictionaryFields[1].Descriptor()
+	// dictionary.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	dictionary.TitleValidator = dictionaryDescTitle.Validators[0].(func(string) error)
+	// dictionaryDescType is the schema descriptor for type field.
+	dictionaryDescType := dictionaryFields[2].Descriptor()
+	// dictionary.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	dictionary.TypeValidator = dictionaryDescType.Validators[0].(func(string) error)
+	// dictionaryDescOrder is the schema descriptor for order field.
+	dictionaryDescOrder := dictionaryFields[3].Descriptor()
+	// dictionary.DefaultOrder holds the default value on creation for the order field.
+	dictionary.DefaultOrder = dictionaryDescOrder.Default.(int)
+	// dictionaryDescCreatedAt is the schema descriptor for created_at field.
+	dictionaryDescCreatedAt := dictionaryFields[4].Descriptor()
+	// dictionary.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dictionary.DefaultCreatedAt = dictionaryDescCreatedAt.Default.(func() time.Time)
+	// dictionaryDescUpdatedAt is the schema descriptor for updated_at field.
+	dictionaryDescUpdatedAt := dictionaryFields[5].Descriptor()
+	// dictionary.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dictionary.DefaultUpdatedAt = dictionaryDescUpdatedAt.Default.(func() time.Time)
+	// dictionary.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dictionary.UpdateDefaultUpdatedAt = dictionaryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dictionaryDescID is the schema descriptor for id field.
+	dictionaryDescID := dictionaryFields[0].Descriptor()
+	// dictionary.DefaultID holds the default value on creation for the id field.
+	dictionary.DefaultID = dictionaryDescID.Default.(func() int64)
+	dimensionMixin := schema.Dimension{}.Mixin()
+	dimensionMixinHooks0 := dimensionMixin[0].Hooks()
+	dimension.Hooks[0] = dimensionMixinHooks0[0]
+	dimensionMixinInters0 := dimensionMixin[0].Interceptors()
+	dimension.Interceptors[0] = dimensionMixinInters0[0]
+	dimensionFields := schema.Dimension{}.Fields()
+	_ = dimensionFields
+	// dimensionDescTitle is the schema descriptor for title field.
+	dimensionDescTitle := dimensionFields[3].Descriptor()
+	// dimension.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	dimension.TitleValidator = dimensionDescTitle.Validators[0].(func(string) error)
+	// dimensionDescWeight is the schema descriptor for weight field.
+	dimensionDescWeight := dimensionFields[5].Descriptor()
+	// dimension.DefaultWeight holds the default value on creation for the weight field.
+	dimension.DefaultWeight = dimensionDescWeight.Default.(float64)
+	// dimension.WeightValidator is a validator for the "weight" field. It is called by the builders before save.
+	dimension.WeightValidator = dimensionDescWeight.Validators[0].(func(float64) error)
+	// dimensionDescCreatedAt is the schema descriptor for created_at field.
+	dimensionDescCreatedAt := dimensionFields[6].Descriptor()
+	// dimension.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dimension.DefaultCreatedAt = dimensionDescCreatedAt.Default.(func() time.Time)
+	// dimensionDescUpdatedAt is the schema descriptor for updated_at field.
+	dimensionDescUpdatedAt := dimensionFields[7].Descriptor()
+	// dimension.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dimension.DefaultUpdatedAt = dimensionDescUpdatedAt.Default.(func() time.Time)
+	// dimension.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dimension.UpdateDefaultUpdatedAt = dimensionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dimensionDescID is the schema descriptor for id field.
+	dimensionDescID := dimensionFields[0].Descriptor()
+	// dimension.DefaultID holds the default value on creation for the id field.
+	dimension.DefaultID = dimensionDescID.Default.(func() int64)
+	moduleMixin := schema.Module{}.Mixin()
+	moduleMixinHooks0 := moduleMixin[0].Hooks()
+	module.Hooks[0] = moduleMixinHooks0[0]
+	moduleMixinInters0 := moduleMixin[0].Interceptors()
+	module.Interceptors[0] = moduleMixinInters0[0]
+	moduleFields := schema.Module{}.Fields()
+	_ = moduleFields
+	// moduleDescTitle is the schema descriptor for title field.
+	moduleDescTitle := moduleFields[2].Descriptor()
+	// module.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	module.TitleValidator = moduleDescTitle.Validators[0].(func(string) error)
+	// moduleDescDescription is the schema descriptor for description field.
+	moduleDescDescription := moduleFields[4].Descriptor()
+	// module.DefaultDescription holds the default value on creation for the description field.
+	module.DefaultDescription = moduleDescDescription.Default.(string)
+	// moduleDescWeight is the schema descriptor for weight field.
+	moduleDescWeight := moduleFields[5].Descriptor()
+	// module.DefaultWeight holds the default value on creation for the weight field.
+	module.DefaultWeight = moduleDescWeight.Default.(float64)
+	// module.WeightValidator is a validator for the "weight" field. It is called by the builders before save.
+	module.WeightValidator = moduleDescWeight.Validators[0].(func(float64) error)
+	// moduleDescCreatedAt is the schema descriptor for created_at field.
+	moduleDescCreatedAt := moduleFields[7].Descriptor()
+	// module.DefaultCreatedAt holds the default value on creation for the created_at field.
+	module.DefaultCreatedAt = moduleDescCreatedAt.Default.(func() time.Time)
+	// moduleDescUpdatedAt is the schema descriptor for updated_at field.
+	moduleDescUpdatedAt := moduleFields[8].Descriptor()
+	// module.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	module.DefaultUpdatedAt = moduleDescUpdatedAt.Default.(func() time.Time)
+	// module.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	module.UpdateDefaultUpdatedAt = moduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// moduleDescID is the schema descriptor for id field.
+	moduleDescID := moduleFields[0].Descriptor()
+	// module.DefaultID holds the default value on creation for the id field.
+	module.DefaultID = moduleDescID.Default.(func() int64)
+	positionFields := schema.Position{}.Fields()
+	_ = positionFields
+	// positionDescParentID is the schema descriptor for parent_id field.
+	positionDescParentID := positionFields[2].Descriptor()
+	// position.DefaultParentID holds the default value on creation for the parent_id field.
+	position.DefaultParentID = positionDescParentID.Default.(int64)
+	// positionDescTitle is the schema descriptor for title field.
+	positionDescTitle := positionFields[3].Descriptor()
+	// position.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	position.TitleValidator = positionDescTitle.Validators[0].(func(string) error)
+	// positionDescID is the schema descriptor for id field.
+	positionDescID := positionFields[0].Descriptor()
+	// position.DefaultID holds the default value on creation for the id field.
+	position.DefaultID = positionDescID.Default.(func() int64)
+	resumeMixin := schema.Resume{}.Mixin()
+	resumeMixinHooks0 := resumeMixin[0].Hooks()
+	resume.Hooks[0] = resumeMixinHooks0[0]
+	resumeMixinInters0 := resumeMixin[0].Interceptors()
+	resume.Interceptors[0] = resumeMixinInters0[0]
+	resumeFields := schema.Resume{}.Fields()
+	_ = resumeFields
+	// resumeDescFilePath is the schema descriptor for file_path field.
+	resumeDescFilePath := resumeFields[3].Descriptor()
+	// resume.FilePathValidator is a validator for the "file_path" field. It is called by the builders before save.
+	resume.FilePathValidator = resumeDescFilePath.Validators[0].(func(string) error)
+	// resumeDescSourceFilePath is the schema descriptor for source_file_path field.
+	resumeDescSourceFilePath := resumeFields[4].Descriptor()
+	// resume.DefaultSourceFilePath holds the default value on creation for the source_file_path field.
+	resume.DefaultSourceFilePath = resumeDescSourceFilePath.Default.(string)
+	// resumeDescFileName is the schema descriptor for file_name field.
+	resumeDescFileName := resumeFields[5].Descriptor()
+	// resume.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	resume.FileNameValidator = resumeDescFileName.Validators[0].(func(string) error)
+	// resumeDescStatus is the schema descriptor for status field.
+	resumeDescStatus := resumeFields[6].Descriptor()
+	// resume.DefaultStatus holds the default value on creation for the status field.
+	resume.DefaultStatus = resumeDescStatus.Default.(int32)
+	// resumeDescCoverImage is the schema descriptor for cover_image field.
+	resumeDescCoverImage := resumeFields[7].Descriptor()
+	// resume.DefaultCoverImage holds the default value on creation for the cover_image field.
+	resume.DefaultCoverImage = resumeDescCoverImage.Default.(string)
+	// resumeDescRenderedRevision is the schema descriptor for rendered_revision field.
+	resumeDescRenderedRevision := resumeFields[9].Descriptor()
+	// resume.DefaultRenderedRevision holds the default value on creation for the rendered_revision field.
+	resume.DefaultRenderedRevision = resumeDescRenderedRevision.Default.(int64)
+	// resumeDescCreatedAt is the schema descriptor for created_at field.
+	resumeDescCreatedAt := resumeFields[11].Descriptor()
+	// resume.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resume.DefaultCreatedAt = resumeDescCreatedAt.Default.(func() time.Time)
+	// resumeDescUpdatedAt is the schema descriptor for updated_at field.
+	resumeDescUpdatedAt := resumeFields[12].Descriptor()
+	// resume.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	resume.DefaultUpdatedAt = resumeDescUpdatedAt.Default.(func() time.Time)
+	// resume.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	resume.UpdateDefaultUpdatedAt = resumeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// resumeDescID is the schema descriptor for id field.
+	resumeDescID := resumeFields[0].Descriptor()
+	// resume.DefaultID holds the default value on creation for the id field.
+	resume.DefaultID = resumeDescID.Default.(func() int64)
+	resumescoreMixin := schema.ResumeScore{}.Mixin()
+	resumescoreMixinHooks0 := resumescoreMixin[0].Hooks()
+	resumescore.Hooks[0] = resumescoreMixinHooks0[0]
+	resumescoreMixinInters0 := resumescoreMixin[0].Interceptors()
+	resumescore.Interceptors[0] = resumescoreMixinInters0[0]
+	resumescoreFields := schema.ResumeScore{}.Fields()
+	_ = resumescoreFields
+	// resumescoreDescScopeID is the schema descriptor for scope_id field.
+	resumescoreDescScopeID := resumescoreFields[4].Descriptor()
+	// resumescore.DefaultScopeID holds the default value on creation for the scope_id field.
+	resumescore.DefaultScopeID = resumescoreDescScopeID.Default.(int64)
+	// resumescoreDescScore is the schema descriptor for score field.
+	resumescoreDescScore := resumescoreFields[5].Descriptor()
+	// resumescore.DefaultScore holds the default value on creation for the score field.
+	resumescore.DefaultScore = resumescoreDescScore.Default.(float64)
+	// resumescoreDescWeight is the schema descriptor for weight field.
+	resumescoreDescWeight := resumescoreFields[6].Descriptor()
+	// resumescore.DefaultWeight holds the default value on creation for the weight field.
+	resumescore.DefaultWeight = resumescoreDescWeight.Default.(float64)
+	// resumescoreDescRevision is the schema descriptor for revision field.
+	resumescoreDescRevision := resumescoreFields[8].Descriptor()
+	// resumescore.DefaultRevision holds the default value on creation for the revision field.
+	resumescore.DefaultRevision = resumescoreDescRevision.Default.(int64)
+	// resumescoreDescScored is the schema descriptor for scored field.
+	resumescoreDescScored := resumescoreFields[9].Descriptor()
+	// resumescore.DefaultScored holds the default value on creation for the scored field.
+	resumescore.DefaultScored = resumescoreDescScored.Default.(bool)
+	// resumescoreDescCreatedAt is the schema descriptor for created_at field.
+	resumescoreDescCreatedAt := resumescoreFields[10].Descriptor()
+	// resumescore.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resumescore.DefaultCreatedAt = resumescoreDescCreatedAt.Default.(func() time.Time)
+	// resumescoreDescUpdatedAt is the schema descriptor for updated_at field.
+	resumescoreDescUpdatedAt := resumescoreFields[11].Descriptor()
+	// resumescore.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	resumescore.DefaultUpdatedAt = resumescoreDescUpdatedAt.Default.(func() time.Time)
+	// resumescore.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	resumescore.UpdateDefaultUpdatedAt = resumescoreDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// resumescoreDescID is the schema descriptor for id field.
+	resumescoreDescID := resumescoreFields[0].Descriptor()
+	// resumescore.DefaultID holds the default value on creation for the id field.
+	resumescore.DefaultID = resumescoreDescID.Default.(func() int64)
+	resumeshareFields := schema.ResumeShare{}.Fields()
+	_ = resumeshareFields
+	// resumeshareDescToken is the schema descriptor for token field.
+	resumeshareDescToken := resumeshareFields[4].Descriptor()
+	// resumeshare.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	resumeshare.TokenValidator = resumeshareDescToken.Validators[0].(func(string) error)
+	// resumeshareDescRedact is the schema descriptor for redact field.
+	resumeshareDescRedact := resumeshareFields[6].Descriptor()
+	// resumeshare.DefaultRedact holds the default value on creation for the redact field.
+	resumeshare.DefaultRedact = resumeshareDescRedact.Default.(bool)
+	// resumeshareDescPasswordHash is the schema descriptor for password_hash field.
+	resumeshareDescPasswordHash := resumeshareFields[7].Descriptor()
+	// resumeshare.DefaultPasswordHash holds the default value on creation for the password_hash field.
+	resumeshare.DefaultPasswordHash = resumeshareDescPasswordHash.Default.(string)
+	// resumeshareDescViewCount is the schema descriptor for view_count field.
+	resumeshareDescViewCount := resumeshareFields[10].Descriptor()
+	// resumeshare.DefaultViewCount holds the default value on creation for the view_count field.
+	resumeshare.DefaultViewCount = resumeshareDescViewCount.Default.(int64)
+	// resumeshareDescCreatedAt is the schema descriptor for created_at field.
+	resumeshareDescCreatedAt := resumeshareFields[12].Descriptor()
+	// resumeshare.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resumeshare.DefaultCreatedAt = resumeshareDescCreatedAt.Default.(func() time.Time)
+	// resumeshareDescUpdatedAt is the schema descriptor for updated_at field.
+	resumeshareDescUpdatedAt := resumeshareFields[13].Descriptor()
+	// resumeshare.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	resumeshare.DefaultUpdatedAt = resumeshareDescUpdatedAt.Default.(func() time.Time)
+	// resumeshare.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	resumeshare.UpdateDefaultUpdatedAt = resumeshareDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// resumeshareDescID is the schema descriptor for id field.
+	resumeshareDescID := resumeshareFields[0].Descriptor()
+	// resumeshare.DefaultID holds the default value on creation for the id field.
+	resumeshare.DefaultID = resumeshareDescID.Default.(func() int64)
+	resumeshareviewFields := schema.ResumeShareView{}.Fields()
+	_ = resumeshareviewFields
+	// resumeshareviewDescReferrer is the schema descriptor for referrer field.
+	resumeshareviewDescReferrer := resumeshareviewFields[4].Descriptor()
+	// resumeshareview.DefaultReferrer holds the default value on creation for the referrer field.
+	resumeshareview.DefaultReferrer = resumeshareviewDescReferrer.Default.(string)
+	// resumeshareview.ReferrerValidator is a validator for the "referrer" field. It is called by the builders before save.
+	resumeshareview.ReferrerValidator = resumeshareviewDescReferrer.Validators[0].(func(string) error)
+	// resumeshareviewDescUserAgent is the schema descriptor for user_agent field.
+	resumeshareviewDescUserAgent := resumeshareviewFields[5].Descriptor()
+	// resumeshareview.DefaultUserAgent holds the default value on creation for the user_agent field.
+	resumeshareview.DefaultUserAgent = resumeshareviewDescUserAgent.Default.(string)
+	// resumeshareview.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	resumeshareview.UserAgentValidator = resumeshareviewDescUserAgent.Validators[0].(func(string) error)
+	// resumeshareviewDescCreatedAt is the schema descriptor for created_at field.
+	resumeshareviewDescCreatedAt := resumeshareviewFields[6].Descriptor()
+	// resumeshareview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resumeshareview.DefaultCreatedAt = resumeshareviewDescCreatedAt.Default.(func() time.Time)
+	// resumeshareviewDescID is the schema descriptor for id field.
+	resumeshareviewDescID := resumeshareviewFields[0].Descriptor()
+	// resumeshareview.DefaultID holds the default value on creation for the id field.
+	resumeshareview.DefaultID = resumeshareviewDescID.Default.(func() int64)
+	resumeslotFields := schema.ResumeSlot{}.Fields()
+	_ = resumeslotFields
+	// resumeslotDescUserID is the schema descriptor for user_id field.
+	resumeslotDescUserID := resumeslotFields[1].Descriptor()
+	// resumeslot.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	resumeslot.UserIDValidator = resumeslotDescUserID.Validators[0].(func(string) error)
+	// resumeslotDescMaxSlots is the schema descriptor for max_slots field.
+	resumeslotDescMaxSlots := resumeslotFields[2].Descriptor()
+	// resumeslot.DefaultMaxSlots holds the default value on creation for the max_slots field.
+	resumeslot.DefaultMaxSlots = resumeslotDescMaxSlots.Default.(int32)
+	// resumeslotDescCreatedAt is the schema descriptor for created_at field.
+	resumeslotDescCreatedAt := resumeslotFields[3].Descriptor()
+	// resumeslot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resumeslot.DefaultCreatedAt = resumeslotDescCreatedAt.Default.(func() time.Time)
+	// resumeslotDescUpdatedAt is the schema descriptor for updated_at field.
+	resumeslotDescUpdatedAt := resumeslotFields[4].Descriptor()
+	// resumeslot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	resumeslot.DefaultUpdatedAt = resumeslotDescUpdatedAt.Default.(func() time.Time)
+	// resumeslot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	resumeslot.UpdateDefaultUpdatedAt = resumeslotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// resumeslotDescID is the schema descriptor for id field.
+	resumeslotDescID := resumeslotFields[0].Descriptor()
+	// resumeslot.DefaultID holds the default value on creation for the id field.
+	resumeslot.DefaultID = resumeslotDescID.Default.(func() int64)
+	scorehistoryFields := schema.ScoreHistory{}.Fields()
+	_ = scorehistoryFields
+	// scorehistoryDescTitle is the schema descriptor for title field.
+	scorehistoryDescTitle := scorehistoryFields[4].Descriptor()
+	// scorehistory.DefaultTitle holds the default value on creation for the title field.
+	scorehistory.DefaultTitle = scorehistoryDescTitle.Default.(string)
+	// scorehistoryDescScore is the schema descriptor for score field.
+	scorehistoryDescScore := scorehistoryFields[5].Descriptor()
+	// scorehistory.DefaultScore holds the default value on creation for the score field.
+	scorehistory.DefaultScore = scorehistoryDescScore.Default.(float64)
+	// scorehistoryDescWeight is the schema descriptor for weight field.
+	scorehistoryDescWeight := scorehistoryFields[6].Descriptor()
+	// scorehistory.DefaultWeight holds the default value on creation for the weight field.
+	scorehistory.DefaultWeight = scorehistoryDescWeight.Default.(float64)
+	// scorehistoryDescRescored is the schema descriptor for rescored field.
+	scorehistoryDescRescored := scorehistoryFields[7].Descriptor()
+	// scorehistory.DefaultRescored holds the default value on creation for the rescored field.
+	scorehistory.DefaultRescored = scorehistoryDescRescored.Default.(bool)
+	// scorehistoryDescCreatedAt is the schema descriptor for created_at field.
+	scorehistoryDescCreatedAt := scorehistoryFields[8].Descriptor()
+	// scorehistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scorehistory.DefaultCreatedAt = scorehistoryDescCreatedAt.Default.(func() time.Time)
+	// scorehistoryDescID is the schema descriptor for id field.
+	scorehistoryDescID := scorehistoryFields[0].Descriptor()
+	// scorehistory.DefaultID holds the default value on creation for the id field.
+	scorehistory.DefaultID = scorehistoryDescID.Default.(func() int64)
+	scorerunFields := schema.ScoreRun{}.Fields()
+	_ = scorerunFields
+	// scorerunDescRuleVersion is the schema descriptor for rule_version field.
+	scorerunDescRuleVersion := scorerunFields[3].Descriptor()
+	// scorerun.DefaultRuleVersion holds the default value on creation for the rule_version field.
+	scorerun.DefaultRuleVersion = scorerunDescRuleVersion.Default.(string)
+	// scorerunDescTotalScore is the schema descriptor for total_score field.
+	scorerunDescTotalScore := scorerunFields[4].Descriptor()
+	// scorerun.DefaultTotalScore holds the default value on creation for the total_score field.
+	scorerun.DefaultTotalScore = scorerunDescTotalScore.Default.(float64)
+	// scorerunDescCreatedAt is the schema descriptor for created_at field.
+	scorerunDescCreatedAt := scorerunFields[7].Descriptor()
+	// scorerun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scorerun.DefaultCreatedAt = scorerunDescCreatedAt.Default.(func() time.Time)
+	// scorerunDescID is the schema descriptor for id field.
+	scorerunDescID := scorerunFields[0].Descriptor()
+	// scorerun.DefaultID holds the default value on creation for the id field.
+	scorerun.DefaultID = scorerunDescID.Default.(func() int64)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
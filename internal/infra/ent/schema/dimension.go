@@ -27,6 +27,12 @@ func (Dimension) Annotations() []schema.Annotation {
 	}
 }
 
+func (Dimension) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 func (Dimension) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("id").
@@ -46,7 +52,12 @@ func (Dimension) Fields() []ent.Field {
 
 		field.JSON("judgment", []map[string]interface{}{}).
 			Optional().
-			Comment("维度得分详情（包括detail, score, weight的数组）"),
+			Comment("维度得分详情（包括detail, score的数组，最高的 score 对应满分）"),
+
+		field.Float("weight").
+			Default(1).
+			Min(0).
+			Comment("维度权重（模块得分为各维度得分按权重的加权平均）"),
 
 		field.Time("created_at").
 			Default(time.Now).
@@ -57,11 +68,6 @@ func (Dimension) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now).
 			Comment("更新时间"),
-
-		field.Time("deleted_at").
-			Optional().
-			Nillable().
-			Comment("删除时间"),
 	}
 }
 
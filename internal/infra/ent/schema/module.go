@@ -26,6 +26,12 @@ func (Module) Annotations() []schema.Annotation {
 	}
 }
 
+func (Module) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 func (Module) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("id").
@@ -33,14 +39,34 @@ func (Module) Fields() []ent.Field {
 			DefaultFunc(snowflake.NextID).
 			Comment("模块ID"),
 
+		field.String("code").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("模块编码（稳定标识，代码中按编码识别模块，不依赖标题）"),
+
 		field.String("title").
 			NotEmpty().
 			Comment("显示标题"),
 
+		field.Enum("shape").
+			Values("object", "list", "text").
+			Default("list").
+			Comment("数据形态: object=单个对象, list=条目列表, text=纯文本"),
+
 		field.String("description").
 			Default("").
 			Comment("模块描述"),
 
+		field.Float("weight").
+			Default(1).
+			Min(0).
+			Comment("模块权重（简历总分为各模块得分按权重的加权平均）"),
+
+		field.JSON("data_schema", map[string]interface{}{}).
+			Optional().
+			Comment("模块数据的 JSON Schema（校验保存的 data 数组）"),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -50,11 +76,6 @@ func (Module) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now).
 			Comment("更新时间"),
-
-		field.Time("deleted_at").
-			Optional().
-			Nillable().
-			Comment("删除时间"),
 	}
 }
 
@@ -27,6 +27,12 @@ func (Resume) Annotations() []schema.Annotation {
 	}
 }
 
+func (Resume) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 func (Resume) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("id").
@@ -37,13 +43,18 @@ func (Resume) Fields() []ent.Field {
 		field.Int64("user_id").
 			Comment("用户ID"),
 
-		field.Int64("tenant_id").
+		field.String("tenant_id").
 			Comment("租户ID"),
 
 		field.String("file_path").
 			NotEmpty().
 			Comment("文件路径"),
 
+		field.String("source_file_path").
+			Optional().
+			Default("").
+			Comment("原始文件路径（用户上传或首次生成的文件），重新渲染后 file_path 指向渲染文件，彻底删除时按此清理原始文件"),
+
 		field.String("file_name").
 			NotEmpty().
 			Comment("文件名"),
@@ -57,6 +68,20 @@ func (Resume) Fields() []ent.Field {
 			Default("").
 			Comment("封面图URL"),
 
+		field.Enum("render_status").
+			Values("pending", "rendering", "done", "failed").
+			Default("done").
+			Comment("PDF 渲染状态: pending=等待渲染, rendering=渲染中, done=已是最新, failed=渲染失败"),
+
+		field.Int64("rendered_revision").
+			Default(0).
+			Comment("file_path 对应的简历内容版本号"),
+
+		field.Enum("template").
+			Values("classic", "modern", "compact").
+			Default("classic").
+			Comment("PDF 模板: classic=经典, modern=现代, compact=紧凑单页"),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -66,11 +91,6 @@ func (Resume) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now).
 			Comment("更新时间"),
-
-		field.Time("deleted_at").
-			Optional().
-			Nillable().
-			Comment("删除时间"),
 	}
 }
 
@@ -78,6 +98,12 @@ func (Resume) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("scores", ResumeScore.Type).
 			Comment("简历得分"),
+
+		edge.To("shares", ResumeShare.Type).
+			Comment("分享链接"),
+
+		edge.To("score_runs", ScoreRun.Type).
+			Comment("评分记录"),
 	}
 }
 
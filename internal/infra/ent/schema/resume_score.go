@@ -27,6 +27,12 @@ func (ResumeScore) Annotations() []schema.Annotation {
 	}
 }
 
+func (ResumeScore) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 func (ResumeScore) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("id").
@@ -43,6 +49,10 @@ func (ResumeScore) Fields() []ent.Field {
 		field.Int32("target_type").
 			Comment("类型: 0=module, 1=dimension"),
 
+		field.Int64("scope_id").
+			Default(0).
+			Comment("维度得分所属的自定义模块ID，内置模块为0"),
+
 		field.Float("score").
 			Default(0).
 			Comment("得分"),
@@ -51,6 +61,19 @@ func (ResumeScore) Fields() []ent.Field {
 			Default(0).
 			Comment("权重"),
 
+		field.Enum("state").
+			Values("pending", "done", "failed", "stale").
+			Default("done").
+			Comment("评分状态（模块得分）: pending=等待评分, done=已评分, failed=评分失败, stale=数据已修改但未重新评分"),
+
+		field.Int64("revision").
+			Default(0).
+			Comment("请求评分时的简历内容版本号，用于丢弃过期的评分结果"),
+
+		field.Bool("scored").
+			Default(true).
+			Comment("是否已有得分，首次评分完成前的占位记录为 false"),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -60,11 +83,6 @@ func (ResumeScore) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now).
 			Comment("更新时间"),
-
-		field.Time("deleted_at").
-			Optional().
-			Nillable().
-			Comment("删除时间"),
 	}
 }
 
@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"cv2/internal/pkg/snowflake"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type ResumeShare struct {
+	ent.Schema
+}
+
+func (ResumeShare) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "cv_resume_share",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_unicode_ci",
+		},
+		entsql.WithComments(true),
+	}
+}
+
+func (ResumeShare) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Immutable().
+			DefaultFunc(snowflake.NextID).
+			Comment("分享ID"),
+
+		field.Int64("resume_id").
+			Immutable().
+			Comment("关联简历ID"),
+
+		field.Int64("user_id").
+			Immutable().
+			Comment("创建人用户ID"),
+
+		field.String("tenant_id").
+			Immutable().
+			Comment("租户ID"),
+
+		field.String("token").
+			Immutable().
+			NotEmpty().
+			Unique().
+			Comment("分享令牌"),
+
+		field.Enum("permission").
+			Values("read", "download").
+			Default("read").
+			Comment("权限: read=只能在线查看, download=可下载 PDF"),
+
+		field.Bool("redact").
+			Immutable().
+			Default(false).
+			Comment("是否脱敏分享"),
+
+		field.String("password_hash").
+			Optional().
+			Default("").
+			Sensitive().
+			Comment("访问密码的 bcrypt 哈希，为空表示不需要密码"),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("过期时间，为空表示永不过期"),
+
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("撤销时间"),
+
+		field.Int64("view_count").
+			Default(0).
+			Comment("访问次数"),
+
+		field.Time("last_viewed_at").
+			Optional().
+			Nillable().
+			Comment("最近访问时间"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
+
+func (ResumeShare) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("resume", Resume.Type).
+			Ref("shares").
+			Unique().
+			Required().
+			Immutable().
+			Field("resume_id").
+			Comment("关联简历"),
+
+		edge.To("views", ResumeShareView.Type).
+			Comment("访问记录"),
+	}
+}
+
+func (ResumeShare) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resume_id"),
+		index.Fields("user_id", "tenant_id"),
+	}
+}
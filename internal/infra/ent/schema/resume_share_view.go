@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"cv2/internal/pkg/snowflake"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type ResumeShareView struct {
+	ent.Schema
+}
+
+func (ResumeShareView) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "cv_resume_share_view",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_unicode_ci",
+		},
+		entsql.WithComments(true),
+	}
+}
+
+func (ResumeShareView) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Immutable().
+			DefaultFunc(snowflake.NextID).
+			Comment("访问记录ID"),
+
+		field.Int64("share_id").
+			Immutable().
+			Comment("关联分享ID"),
+
+		field.Int64("resume_id").
+			Immutable().
+			Comment("简历ID（冗余，便于按简历清理）"),
+
+		field.Enum("action").
+			Values("view", "download").
+			Immutable().
+			Comment("操作: view=在线查看, download=下载 PDF"),
+
+		field.String("referrer").
+			MaxLen(512).
+			Optional().
+			Default("").
+			Immutable().
+			Comment("来源页面（不含查询参数）"),
+
+		field.String("user_agent").
+			MaxLen(64).
+			Optional().
+			Default("").
+			Immutable().
+			Comment("粗粒度客户端类型，如 Chrome/Windows"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("访问时间"),
+	}
+}
+
+func (ResumeShareView) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("share", ResumeShare.Type).
+			Ref("views").
+			Unique().
+			Required().
+			Immutable().
+			Field("share_id").
+			Comment("关联分享"),
+	}
+}
+
+func (ResumeShareView) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("share_id", "created_at"),
+		index.Fields("resume_id"),
+	}
+}
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"cv2/internal/pkg/snowflake"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScoreHistory 评分记录中单个模块的得分快照
+// 每次评分记录简历当时全部已评分模块的得分（包括本次未重新评分的模块），便于按模块画出得分曲线
+type ScoreHistory struct {
+	ent.Schema
+}
+
+func (ScoreHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "cv_score_history",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_unicode_ci",
+		},
+		entsql.WithComments(true),
+	}
+}
+
+func (ScoreHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Immutable().
+			DefaultFunc(snowflake.NextID).
+			Comment("得分快照ID"),
+
+		field.Int64("run_id").
+			Immutable().
+			Comment("关联评分记录ID"),
+
+		field.Int64("resume_id").
+			Immutable().
+			Comment("简历ID（冗余，便于按简历查询和清理）"),
+
+		field.Int64("module_id").
+			Immutable().
+			Comment("模块ID（自定义模块为自定义模块ID）"),
+
+		field.String("title").
+			Immutable().
+			Default("").
+			Comment("评分时的模块标题"),
+
+		field.Float("score").
+			Immutable().
+			Default(0).
+			Comment("模块得分"),
+
+		field.Float("weight").
+			Immutable().
+			Default(0).
+			Comment("模块权重"),
+
+		field.Bool("rescored").
+			Immutable().
+			Default(false).
+			Comment("本次是否重新评分（否则沿用之前的得分）"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("评分时间"),
+	}
+}
+
+func (ScoreHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", ScoreRun.Type).
+			Ref("modules").
+			Unique().
+			Required().
+			Immutable().
+			Field("run_id").
+			Comment("关联评分记录"),
+	}
+}
+
+func (ScoreHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("run_id"),
+		index.Fields("resume_id", "module_id"),
+	}
+}
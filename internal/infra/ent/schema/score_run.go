@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"cv2/internal/pkg/snowflake"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScoreRun 一次评分记录，只追加不修改
+type ScoreRun struct {
+	ent.Schema
+}
+
+func (ScoreRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "cv_score_run",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_unicode_ci",
+		},
+		entsql.WithComments(true),
+	}
+}
+
+func (ScoreRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Immutable().
+			DefaultFunc(snowflake.NextID).
+			Comment("评分记录ID"),
+
+		field.Int64("resume_id").
+			Immutable().
+			Comment("关联简历ID"),
+
+		field.Enum("trigger").
+			Values("upload", "save", "ai_apply", "rescore").
+			Immutable().
+			Comment("触发方式: upload=上传/生成/导入, save=编辑保存, ai_apply=采纳 AI 帮写, rescore=重新评分（如回滚版本）"),
+
+		field.String("rule_version").
+			Immutable().
+			Default("").
+			Comment("评分时的规则版本（模块权重和维度规则的指纹）"),
+
+		field.Float("total_score").
+			Immutable().
+			Default(0).
+			Comment("评分后的简历总分"),
+
+		field.Enum("status").
+			Values("success", "partial", "failed").
+			Default("success").
+			Immutable().
+			Comment("评分结果: success=全部成功, partial=部分模块失败, failed=全部失败"),
+
+		field.JSON("failures", []map[string]string{}).
+			Optional().
+			Immutable().
+			Comment("评分失败的模块（包括module_id, title, reason, error的数组）"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("评分时间"),
+	}
+}
+
+func (ScoreRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("resume", Resume.Type).
+			Ref("score_runs").
+			Unique().
+			Required().
+			Immutable().
+			Field("resume_id").
+			Comment("关联简历"),
+
+		edge.To("modules", ScoreHistory.Type).
+			Comment("评分后各模块的得分"),
+	}
+}
+
+func (ScoreRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resume_id", "created_at"),
+	}
+}
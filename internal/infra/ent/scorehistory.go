@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ScoreHistory is the model entity for the ScoreHistory schema.
+type ScoreHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 得分快照ID
+	ID int64 `json:"id,omitempty"`
+	// 关联评分记录ID
+	RunID int64 `json:"run_id,omitempty"`
+	// 简历ID（冗余，便于按简历查询和清理）
+	ResumeID int64 `json:"resume_id,omitempty"`
+	// 模块ID（自定义模块为自定义模块ID）
+	ModuleID int64 `json:"module_id,omitempty"`
+	// 评分时的模块标题
+	Title string `json:"title,omitempty"`
+	// 模块得分
+	Score float64 `json:"score,omitempty"`
+	// 模块权重
+	Weight float64 `json:"weight,omitempty"`
+	// 本次是否重新评分（否则沿用之前的得分）
+	Rescored bool `json:"rescored,omitempty"`
+	// 评分时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScoreHistoryQuery when eager-loading is set.
+	Edges        ScoreHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScoreHistoryEdges holds the relations/edges for other nodes in the graph.
+type ScoreHistoryEdges struct {
+	// 关联评分记录
+	Run *ScoreRun `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScoreHistoryEdges) RunOrErr() (*ScoreRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: scorerun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScoreHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scorehistory.FieldRescored:
+			values[i] = new(sql.NullBool)
+		case scorehistory.FieldScore, scorehistory.FieldWeight:
+			values[i] = new(sql.NullFloat64)
+		case scorehistory.FieldID, scorehistory.FieldRunID, scorehistory.FieldResumeID, scorehistory.FieldModuleID:
+			values[i] = new(sql.NullInt64)
+		case scorehistory.FieldTitle:
+			values[i] = new(sql.NullString)
+		case scorehistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScoreHistory fields.
+func (_m *ScoreHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scorehistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case scorehistory.FieldRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				_m.RunID = value.Int64
+			}
+		case scorehistory.FieldResumeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_id", values[i])
+			} else if value.Valid {
+				_m.ResumeID = value.Int64
+			}
+		case scorehistory.FieldModuleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field module_id", values[i])
+			} else if value.Valid {
+				_m.ModuleID = value.Int64
+			}
+		case scorehistory.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case scorehistory.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = value.Float64
+			}
+		case scorehistory.FieldWeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				_m.Weight = value.Float64
+			}
+		case scorehistory.FieldRescored:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field rescored", values[i])
+			} else if value.Valid {
+				_m.Rescored = value.Bool
+			}
+		case scorehistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScoreHistory.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScoreHistory) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the ScoreHistory entity.
+func (_m *ScoreHistory) QueryRun() *ScoreRunQuery {
+	return NewScoreHistoryClient(_m.config).QueryRun(_m)
+}
+
+// Update returns a builder for updating this ScoreHistory.
+// Note that you need to call ScoreHistory.Unwrap() before calling this method if this ScoreHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScoreHistory) Update() *ScoreHistoryUpdateOne {
+	return NewScoreHistoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScoreHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScoreHistory) Unwrap() *ScoreHistory {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScoreHistory is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScoreHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScoreHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RunID))
+	builder.WriteString(", ")
+	builder.WriteString("resume_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResumeID))
+	builder.WriteString(", ")
+	builder.WriteString("module_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModuleID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weight))
+	builder.WriteString(", ")
+	builder.WriteString("rescored=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rescored))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScoreHistories is a parsable slice of ScoreHistory.
+type ScoreHistories []*ScoreHistory
@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scorehistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the scorehistory type in the database.
+	Label = "score_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// FieldResumeID holds the string denoting the resume_id field in the database.
+	FieldResumeID = "resume_id"
+	// FieldModuleID holds the string denoting the module_id field in the database.
+	FieldModuleID = "module_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// FieldRescored holds the string denoting the rescored field in the database.
+	FieldRescored = "rescored"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// Table holds the table name of the scorehistory in the database.
+	Table = "cv_score_history"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "cv_score_history"
+	// RunInverseTable is the table name for the ScoreRun entity.
+	// It exists in this package in order to avoid circular dependency with the "scorerun" package.
+	RunInverseTable = "cv_score_run"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "run_id"
+)
+
+// Columns holds all SQL columns for scorehistory fields.
+var Columns = []string{
+	FieldID,
+	FieldRunID,
+	FieldResumeID,
+	FieldModuleID,
+	FieldTitle,
+	FieldScore,
+	FieldWeight,
+	FieldRescored,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTitle holds the default value on creation for the "title" field.
+	DefaultTitle string
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore float64
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight float64
+	// DefaultRescored holds the default value on creation for the "rescored" field.
+	DefaultRescored bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() int64
+)
+
+// OrderOption defines the ordering options for the ScoreHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByResumeID orders the results by the resume_id field.
+func ByResumeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumeID, opts...).ToFunc()
+}
+
+// ByModuleID orders the results by the module_id field.
+func ByModuleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModuleID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
+// ByRescored orders the results by the rescored field.
+func ByRescored(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRescored, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+	)
+}
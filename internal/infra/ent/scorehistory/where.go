@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scorehistory
+
+import (
+	"cv2/internal/infra/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLTE(FieldID, id))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldRunID, v))
+}
+
+// ResumeID applies equality check predicate on the "resume_id" field. It's identical to ResumeIDEQ.
+func ResumeID(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldResumeID, v))
+}
+
+// ModuleID applies equality check predicate on the "module_id" field. It's identical to ModuleIDEQ.
+func ModuleID(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldModuleID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldTitle, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldScore, v))
+}
+
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldWeight, v))
+}
+
+// Rescored applies equality check predicate on the "rescored" field. It's identical to RescoredEQ.
+func Rescored(v bool) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldRescored, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// ResumeIDEQ applies the EQ predicate on the "resume_id" field.
+func ResumeIDEQ(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldResumeID, v))
+}
+
+// ResumeIDNEQ applies the NEQ predicate on the "resume_id" field.
+func ResumeIDNEQ(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNEQ(FieldResumeID, v))
+}
+
+// ResumeIDIn applies the In predicate on the "resume_id" field.
+func ResumeIDIn(vs ...int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldIn(FieldResumeID, vs...))
+}
+
+// ResumeIDNotIn applies the NotIn predicate on the "resume_id" field.
+func ResumeIDNotIn(vs ...int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNotIn(FieldResumeID, vs...))
+}
+
+// ResumeIDGT applies the GT predicate on the "resume_id" field.
+func ResumeIDGT(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGT(FieldResumeID, v))
+}
+
+// ResumeIDGTE applies the GTE predicate on the "resume_id" field.
+func ResumeIDGTE(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGTE(FieldResumeID, v))
+}
+
+// ResumeIDLT applies the LT predicate on the "resume_id" field.
+func ResumeIDLT(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLT(FieldResumeID, v))
+}
+
+// ResumeIDLTE applies the LTE predicate on the "resume_id" field.
+func ResumeIDLTE(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLTE(FieldResumeID, v))
+}
+
+// ModuleIDEQ applies the EQ predicate on the "module_id" field.
+func ModuleIDEQ(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldModuleID, v))
+}
+
+// ModuleIDNEQ applies the NEQ predicate on the "module_id" field.
+func ModuleIDNEQ(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNEQ(FieldModuleID, v))
+}
+
+// ModuleIDIn applies the In predicate on the "module_id" field.
+func ModuleIDIn(vs ...int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldIn(FieldModuleID, vs...))
+}
+
+// ModuleIDNotIn applies the NotIn predicate on the "module_id" field.
+func ModuleIDNotIn(vs ...int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNotIn(FieldModuleID, vs...))
+}
+
+// ModuleIDGT applies the GT predicate on the "module_id" field.
+func ModuleIDGT(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGT(FieldModuleID, v))
+}
+
+// ModuleIDGTE applies the GTE predicate on the "module_id" field.
+func ModuleIDGTE(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGTE(FieldModuleID, v))
+}
+
+// ModuleIDLT applies the LT predicate on the "module_id" field.
+func ModuleIDLT(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLT(FieldModuleID, v))
+}
+
+// ModuleIDLTE applies the LTE predicate on the "module_id" field.
+func ModuleIDLTE(v int64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLTE(FieldModuleID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLTE(FieldScore, v))
+}
+
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v float64) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLTE(FieldWeight, v))
+}
+
+// RescoredEQ applies the EQ predicate on the "rescored" field.
+func RescoredEQ(v bool) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldRescored, v))
+}
+
+// RescoredNEQ applies the NEQ predicate on the "rescored" field.
+func RescoredNEQ(v bool) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNEQ(FieldRescored, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.ScoreHistory {
+	return predicate.ScoreHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.ScoreRun) predicate.ScoreHistory {
+	return predicate.ScoreHistory(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScoreHistory) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScoreHistory) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScoreHistory) predicate.ScoreHistory {
+	return predicate.ScoreHistory(sql.NotPredicates(p))
+}
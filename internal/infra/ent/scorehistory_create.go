@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScoreHistoryCreate is the builder for creating a ScoreHistory entity.
+type ScoreHistoryCreate struct {
+	config
+	mutation *ScoreHistoryMutation
+	hooks    []Hook
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *ScoreHistoryCreate) SetRunID(v int64) *ScoreHistoryCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetResumeID sets the "resume_id" field.
+func (_c *ScoreHistoryCreate) SetResumeID(v int64) *ScoreHistoryCreate {
+	_c.mutation.SetResumeID(v)
+	return _c
+}
+
+// SetModuleID sets the "module_id" field.
+func (_c *ScoreHistoryCreate) SetModuleID(v int64) *ScoreHistoryCreate {
+	_c.mutation.SetModuleID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *ScoreHistoryCreate) SetTitle(v string) *ScoreHistoryCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *ScoreHistoryCreate) SetNillableTitle(v *string) *ScoreHistoryCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *ScoreHistoryCreate) SetScore(v float64) *ScoreHistoryCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *ScoreHistoryCreate) SetNillableScore(v *float64) *ScoreHistoryCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetWeight sets the "weight" field.
+func (_c *ScoreHistoryCreate) SetWeight(v float64) *ScoreHistoryCreate {
+	_c.mutation.SetWeight(v)
+	return _c
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_c *ScoreHistoryCreate) SetNillableWeight(v *float64) *ScoreHistoryCreate {
+	if v != nil {
+		_c.SetWeight(*v)
+	}
+	return _c
+}
+
+// SetRescored sets the "rescored" field.
+func (_c *ScoreHistoryCreate) SetRescored(v bool) *ScoreHistoryCreate {
+	_c.mutation.SetRescored(v)
+	return _c
+}
+
+// SetNillableRescored sets the "rescored" field if the given value is not nil.
+func (_c *ScoreHistoryCreate) SetNillableRescored(v *bool) *ScoreHistoryCreate {
+	if v != nil {
+		_c.SetRescored(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScoreHistoryCreate) SetCreatedAt(v time.Time) *ScoreHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScoreHistoryCreate) SetNillableCreatedAt(v *time.Time) *ScoreHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScoreHistoryCreate) SetID(v int64) *ScoreHistoryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ScoreHistoryCreate) SetNillableID(v *int64) *ScoreHistoryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRun sets the "run" edge to the ScoreRun entity.
+func (_c *ScoreHistoryCreate) SetRun(v *ScoreRun) *ScoreHistoryCreate {
+	return _c.SetRunID(v.ID)
+}
+
+// Mutation returns the ScoreHistoryMutation object of the builder.
+func (_c *ScoreHistoryCreate) Mutation() *ScoreHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the ScoreHistory in the database.
+func (_c *ScoreHistoryCreate) Save(ctx context.Context) (*ScoreHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScoreHistoryCreate) SaveX(ctx context.Context) *ScoreHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScoreHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScoreHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScoreHistoryCreate) defaults() {
+	if _, ok := _c.mutation.Title(); !ok {
+		v := scorehistory.DefaultTitle
+		_c.mutation.SetTitle(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := scorehistory.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		v := scorehistory.DefaultWeight
+		_c.mutation.SetWeight(v)
+	}
+	if _, ok := _c.mutation.Rescored(); !ok {
+		v := scorehistory.DefaultRescored
+		_c.mutation.SetRescored(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scorehistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := scorehistory.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScoreHistoryCreate) check() error {
+	if _, ok := _c.mutation.RunID(); !ok {
+		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "ScoreHistory.run_id"`)}
+	}
+	if _, ok := _c.mutation.ResumeID(); !ok {
+		return &ValidationError{Name: "resume_id", err: errors.New(`ent: missing required field "ScoreHistory.resume_id"`)}
+	}
+	if _, ok := _c.mutation.ModuleID(); !ok {
+		return &ValidationError{Name: "module_id", err: errors.New(`ent: missing required field "ScoreHistory.module_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "ScoreHistory.title"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "ScoreHistory.score"`)}
+	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "ScoreHistory.weight"`)}
+	}
+	if _, ok := _c.mutation.Rescored(); !ok {
+		return &ValidationError{Name: "rescored", err: errors.New(`ent: missing required field "ScoreHistory.rescored"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScoreHistory.created_at"`)}
+	}
+	if len(_c.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "ScoreHistory.run"`)}
+	}
+	return nil
+}
+
+func (_c *ScoreHistoryCreate) sqlSave(ctx context.Context) (*ScoreHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScoreHistoryCreate) createSpec() (*ScoreHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScoreHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scorehistory.Table, sqlgraph.NewFieldSpec(scorehistory.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ResumeID(); ok {
+		_spec.SetField(scorehistory.FieldResumeID, field.TypeInt64, value)
+		_node.ResumeID = value
+	}
+	if value, ok := _c.mutation.ModuleID(); ok {
+		_spec.SetField(scorehistory.FieldModuleID, field.TypeInt64, value)
+		_node.ModuleID = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(scorehistory.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(scorehistory.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.Weight(); ok {
+		_spec.SetField(scorehistory.FieldWeight, field.TypeFloat64, value)
+		_node.Weight = value
+	}
+	if value, ok := _c.mutation.Rescored(); ok {
+		_spec.SetField(scorehistory.FieldRescored, field.TypeBool, value)
+		_node.Rescored = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scorehistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scorehistory.RunTable,
+			Columns: []string{scorehistory.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scorerun.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScoreHistoryCreateBulk is the builder for creating many ScoreHistory entities in bulk.
+type ScoreHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*ScoreHistoryCreate
+}
+
+// Save creates the ScoreHistory entities in the database.
+func (_c *ScoreHistoryCreateBulk) Save(ctx context.Context) ([]*ScoreHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScoreHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScoreHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScoreHistoryCreateBulk) SaveX(ctx context.Context) []*ScoreHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScoreHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScoreHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
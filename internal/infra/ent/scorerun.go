@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/scorerun"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ScoreRun is the model entity for the ScoreRun schema.
+type ScoreRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 评分记录ID
+	ID int64 `json:"id,omitempty"`
+	// 关联简历ID
+	ResumeID int64 `json:"resume_id,omitempty"`
+	// 触发方式: upload=上传/生成/导入, save=编辑保存, ai_apply=采纳 AI 帮写, rescore=重新评分（如回滚版本）
+	Trigger scorerun.Trigger `json:"trigger,omitempty"`
+	// 评分时的规则版本（模块权重和维度规则的指纹）
+	RuleVersion string `json:"rule_version,omitempty"`
+	// 评分后的简历总分
+	TotalScore float64 `json:"total_score,omitempty"`
+	// 评分结果: success=全部成功, partial=部分模块失败, failed=全部失败
+	Status scorerun.Status `json:"status,omitempty"`
+	// 评分失败的模块（包括module_id, title, reason, error的数组）
+	Failures []map[string]string `json:"failures,omitempty"`
+	// 评分时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScoreRunQuery when eager-loading is set.
+	Edges        ScoreRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScoreRunEdges holds the relations/edges for other nodes in the graph.
+type ScoreRunEdges struct {
+	// 关联简历
+	Resume *Resume `json:"resume,omitempty"`
+	// 评分后各模块的得分
+	Modules []*ScoreHistory `json:"modules,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ResumeOrErr returns the Resume value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScoreRunEdges) ResumeOrErr() (*Resume, error) {
+	if e.Resume != nil {
+		return e.Resume, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: resume.Label}
+	}
+	return nil, &NotLoadedError{edge: "resume"}
+}
+
+// ModulesOrErr returns the Modules value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScoreRunEdges) ModulesOrErr() ([]*ScoreHistory, error) {
+	if e.loadedTypes[1] {
+		return e.Modules, nil
+	}
+	return nil, &NotLoadedError{edge: "modules"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScoreRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scorerun.FieldFailures:
+			values[i] = new([]byte)
+		case scorerun.FieldTotalScore:
+			values[i] = new(sql.NullFloat64)
+		case scorerun.FieldID, scorerun.FieldResumeID:
+			values[i] = new(sql.NullInt64)
+		case scorerun.FieldTrigger, scorerun.FieldRuleVersion, scorerun.FieldStatus:
+			values[i] = new(sql.NullString)
+		case scorerun.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScoreRun fields.
+func (_m *ScoreRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scorerun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case scorerun.FieldResumeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_id", values[i])
+			} else if value.Valid {
+				_m.ResumeID = value.Int64
+			}
+		case scorerun.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				_m.Trigger = scorerun.Trigger(value.String)
+			}
+		case scorerun.FieldRuleVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_version", values[i])
+			} else if value.Valid {
+				_m.RuleVersion = value.String
+			}
+		case scorerun.FieldTotalScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_score", values[i])
+			} else if value.Valid {
+				_m.TotalScore = value.Float64
+			}
+		case scorerun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = scorerun.Status(value.String)
+			}
+		case scorerun.FieldFailures:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field failures", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Failures); err != nil {
+					return fmt.Errorf("unmarshal field failures: %w", err)
+				}
+			}
+		case scorerun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScoreRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScoreRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryResume queries the "resume" edge of the ScoreRun entity.
+func (_m *ScoreRun) QueryResume() *ResumeQuery {
+	return NewScoreRunClient(_m.config).QueryResume(_m)
+}
+
+// QueryModules queries the "modules" edge of the ScoreRun entity.
+func (_m *ScoreRun) QueryModules() *ScoreHistoryQuery {
+	return NewScoreRunClient(_m.config).QueryModules(_m)
+}
+
+// Update returns a builder for updating this ScoreRun.
+// Note that you need to call ScoreRun.Unwrap() before calling this method if this ScoreRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScoreRun) Update() *ScoreRunUpdateOne {
+	return NewScoreRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScoreRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScoreRun) Unwrap() *ScoreRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScoreRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScoreRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScoreRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("resume_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResumeID))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("rule_version=")
+	builder.WriteString(_m.RuleVersion)
+	builder.WriteString(", ")
+	builder.WriteString("total_score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalScore))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Failures))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScoreRuns is a parsable slice of ScoreRun.
+type ScoreRuns []*ScoreRun
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scorerun
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the scorerun type in the database.
+	Label = "score_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldResumeID holds the string denoting the resume_id field in the database.
+	FieldResumeID = "resume_id"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldRuleVersion holds the string denoting the rule_version field in the database.
+	FieldRuleVersion = "rule_version"
+	// FieldTotalScore holds the string denoting the total_score field in the database.
+	FieldTotalScore = "total_score"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldFailures holds the string denoting the failures field in the database.
+	FieldFailures = "failures"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeResume holds the string denoting the resume edge name in mutations.
+	EdgeResume = "resume"
+	// EdgeModules holds the string denoting the modules edge name in mutations.
+	EdgeModules = "modules"
+	// Table holds the table name of the scorerun in the database.
+	Table = "cv_score_run"
+	// ResumeTable is the table that holds the resume relation/edge.
+	ResumeTable = "cv_score_run"
+	// ResumeInverseTable is the table name for the Resume entity.
+	// It exists in this package in order to avoid circular dependency with the "resume" package.
+	ResumeInverseTable = "cv_resume"
+	// ResumeColumn is the table column denoting the resume relation/edge.
+	ResumeColumn = "resume_id"
+	// ModulesTable is the table that holds the modules relation/edge.
+	ModulesTable = "cv_score_history"
+	// ModulesInverseTable is the table name for the ScoreHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "scorehistory" package.
+	ModulesInverseTable = "cv_score_history"
+	// ModulesColumn is the table column denoting the modules relation/edge.
+	ModulesColumn = "run_id"
+)
+
+// Columns holds all SQL columns for scorerun fields.
+var Columns = []string{
+	FieldID,
+	FieldResumeID,
+	FieldTrigger,
+	FieldRuleVersion,
+	FieldTotalScore,
+	FieldStatus,
+	FieldFailures,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRuleVersion holds the default value on creation for the "rule_version" field.
+	DefaultRuleVersion string
+	// DefaultTotalScore holds the default value on creation for the "total_score" field.
+	DefaultTotalScore float64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() int64
+)
+
+// Trigger defines the type for the "trigger" enum field.
+type Trigger string
+
+// Trigger values.
+const (
+	TriggerUpload  Trigger = "upload"
+	TriggerSave    Trigger = "save"
+	TriggerAiApply Trigger = "ai_apply"
+	TriggerRescore Trigger = "rescore"
+)
+
+func (t Trigger) String() string {
+	return string(t)
+}
+
+// TriggerValidator is a validator for the "trigger" field enum values. It is called by the builders before save.
+func TriggerValidator(t Trigger) error {
+	switch t {
+	case TriggerUpload, TriggerSave, TriggerAiApply, TriggerRescore:
+		return nil
+	default:
+		return fmt.Errorf("scorerun: invalid enum value for trigger field: %q", t)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusSuccess is the default value of the Status enum.
+const DefaultStatus = StatusSuccess
+
+// Status values.
+const (
+	StatusSuccess Status = "success"
+	StatusPartial Status = "partial"
+	StatusFailed  Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusSuccess, StatusPartial, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("scorerun: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ScoreRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByResumeID orders the results by the resume_id field.
+func ByResumeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumeID, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByRuleVersion orders the results by the rule_version field.
+func ByRuleVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleVersion, opts...).ToFunc()
+}
+
+// ByTotalScore orders the results by the total_score field.
+func ByTotalScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalScore, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByResumeField orders the results by resume field.
+func ByResumeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResumeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByModulesCount orders the results by modules count.
+func ByModulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newModulesStep(), opts...)
+	}
+}
+
+// ByModules orders the results by modules terms.
+func ByModules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newResumeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResumeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ResumeTable, ResumeColumn),
+	)
+}
+func newModulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ModulesTable, ModulesColumn),
+	)
+}
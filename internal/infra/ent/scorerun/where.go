@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scorerun
+
+import (
+	"cv2/internal/infra/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldLTE(FieldID, id))
+}
+
+// ResumeID applies equality check predicate on the "resume_id" field. It's identical to ResumeIDEQ.
+func ResumeID(v int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldResumeID, v))
+}
+
+// RuleVersion applies equality check predicate on the "rule_version" field. It's identical to RuleVersionEQ.
+func RuleVersion(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldRuleVersion, v))
+}
+
+// TotalScore applies equality check predicate on the "total_score" field. It's identical to TotalScoreEQ.
+func TotalScore(v float64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldTotalScore, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ResumeIDEQ applies the EQ predicate on the "resume_id" field.
+func ResumeIDEQ(v int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldResumeID, v))
+}
+
+// ResumeIDNEQ applies the NEQ predicate on the "resume_id" field.
+func ResumeIDNEQ(v int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNEQ(FieldResumeID, v))
+}
+
+// ResumeIDIn applies the In predicate on the "resume_id" field.
+func ResumeIDIn(vs ...int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldIn(FieldResumeID, vs...))
+}
+
+// ResumeIDNotIn applies the NotIn predicate on the "resume_id" field.
+func ResumeIDNotIn(vs ...int64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNotIn(FieldResumeID, vs...))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// RuleVersionEQ applies the EQ predicate on the "rule_version" field.
+func RuleVersionEQ(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldRuleVersion, v))
+}
+
+// RuleVersionNEQ applies the NEQ predicate on the "rule_version" field.
+func RuleVersionNEQ(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNEQ(FieldRuleVersion, v))
+}
+
+// RuleVersionIn applies the In predicate on the "rule_version" field.
+func RuleVersionIn(vs ...string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldIn(FieldRuleVersion, vs...))
+}
+
+// RuleVersionNotIn applies the NotIn predicate on the "rule_version" field.
+func RuleVersionNotIn(vs ...string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNotIn(FieldRuleVersion, vs...))
+}
+
+// RuleVersionGT applies the GT predicate on the "rule_version" field.
+func RuleVersionGT(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldGT(FieldRuleVersion, v))
+}
+
+// RuleVersionGTE applies the GTE predicate on the "rule_version" field.
+func RuleVersionGTE(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldGTE(FieldRuleVersion, v))
+}
+
+// RuleVersionLT applies the LT predicate on the "rule_version" field.
+func RuleVersionLT(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldLT(FieldRuleVersion, v))
+}
+
+// RuleVersionLTE applies the LTE predicate on the "rule_version" field.
+func RuleVersionLTE(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldLTE(FieldRuleVersion, v))
+}
+
+// RuleVersionContains applies the Contains predicate on the "rule_version" field.
+func RuleVersionContains(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldContains(FieldRuleVersion, v))
+}
+
+// RuleVersionHasPrefix applies the HasPrefix predicate on the "rule_version" field.
+func RuleVersionHasPrefix(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldHasPrefix(FieldRuleVersion, v))
+}
+
+// RuleVersionHasSuffix applies the HasSuffix predicate on the "rule_version" field.
+func RuleVersionHasSuffix(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldHasSuffix(FieldRuleVersion, v))
+}
+
+// RuleVersionEqualFold applies the EqualFold predicate on the "rule_version" field.
+func RuleVersionEqualFold(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEqualFold(FieldRuleVersion, v))
+}
+
+// RuleVersionContainsFold applies the ContainsFold predicate on the "rule_version" field.
+func RuleVersionContainsFold(v string) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldContainsFold(FieldRuleVersion, v))
+}
+
+// TotalScoreEQ applies the EQ predicate on the "total_score" field.
+func TotalScoreEQ(v float64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldTotalScore, v))
+}
+
+// TotalScoreNEQ applies the NEQ predicate on the "total_score" field.
+func TotalScoreNEQ(v float64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNEQ(FieldTotalScore, v))
+}
+
+// TotalScoreIn applies the In predicate on the "total_score" field.
+func TotalScoreIn(vs ...float64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldIn(FieldTotalScore, vs...))
+}
+
+// TotalScoreNotIn applies the NotIn predicate on the "total_score" field.
+func TotalScoreNotIn(vs ...float64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNotIn(FieldTotalScore, vs...))
+}
+
+// TotalScoreGT applies the GT predicate on the "total_score" field.
+func TotalScoreGT(v float64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldGT(FieldTotalScore, v))
+}
+
+// TotalScoreGTE applies the GTE predicate on the "total_score" field.
+func TotalScoreGTE(v float64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldGTE(FieldTotalScore, v))
+}
+
+// TotalScoreLT applies the LT predicate on the "total_score" field.
+func TotalScoreLT(v float64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldLT(FieldTotalScore, v))
+}
+
+// TotalScoreLTE applies the LTE predicate on the "total_score" field.
+func TotalScoreLTE(v float64) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldLTE(FieldTotalScore, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// FailuresIsNil applies the IsNil predicate on the "failures" field.
+func FailuresIsNil() predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldIsNull(FieldFailures))
+}
+
+// FailuresNotNil applies the NotNil predicate on the "failures" field.
+func FailuresNotNil() predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNotNull(FieldFailures))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasResume applies the HasEdge predicate on the "resume" edge.
+func HasResume() predicate.ScoreRun {
+	return predicate.ScoreRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResumeTable, ResumeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResumeWith applies the HasEdge predicate on the "resume" edge with a given conditions (other predicates).
+func HasResumeWith(preds ...predicate.Resume) predicate.ScoreRun {
+	return predicate.ScoreRun(func(s *sql.Selector) {
+		step := newResumeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasModules applies the HasEdge predicate on the "modules" edge.
+func HasModules() predicate.ScoreRun {
+	return predicate.ScoreRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ModulesTable, ModulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModulesWith applies the HasEdge predicate on the "modules" edge with a given conditions (other predicates).
+func HasModulesWith(preds ...predicate.ScoreHistory) predicate.ScoreRun {
+	return predicate.ScoreRun(func(s *sql.Selector) {
+		step := newModulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScoreRun) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScoreRun) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScoreRun) predicate.ScoreRun {
+	return predicate.ScoreRun(sql.NotPredicates(p))
+}
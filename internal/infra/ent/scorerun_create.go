@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScoreRunCreate is the builder for creating a ScoreRun entity.
+type ScoreRunCreate struct {
+	config
+	mutation *ScoreRunMutation
+	hooks    []Hook
+}
+
+// SetResumeID sets the "resume_id" field.
+func (_c *ScoreRunCreate) SetResumeID(v int64) *ScoreRunCreate {
+	_c.mutation.SetResumeID(v)
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *ScoreRunCreate) SetTrigger(v scorerun.Trigger) *ScoreRunCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetRuleVersion sets the "rule_version" field.
+func (_c *ScoreRunCreate) SetRuleVersion(v string) *ScoreRunCreate {
+	_c.mutation.SetRuleVersion(v)
+	return _c
+}
+
+// SetNillableRuleVersion sets the "rule_version" field if the given value is not nil.
+func (_c *ScoreRunCreate) SetNillableRuleVersion(v *string) *ScoreRunCreate {
+	if v != nil {
+		_c.SetRuleVersion(*v)
+	}
+	return _c
+}
+
+// SetTotalScore sets the "total_score" field.
+func (_c *ScoreRunCreate) SetTotalScore(v float64) *ScoreRunCreate {
+	_c.mutation.SetTotalScore(v)
+	return _c
+}
+
+// SetNillableTotalScore sets the "total_score" field if the given value is not nil.
+func (_c *ScoreRunCreate) SetNillableTotalScore(v *float64) *ScoreRunCreate {
+	if v != nil {
+		_c.SetTotalScore(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ScoreRunCreate) SetStatus(v scorerun.Status) *ScoreRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ScoreRunCreate) SetNillableStatus(v *scorerun.Status) *ScoreRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetFailures sets the "failures" field.
+func (_c *ScoreRunCreate) SetFailures(v []map[string]string) *ScoreRunCreate {
+	_c.mutation.SetFailures(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScoreRunCreate) SetCreatedAt(v time.Time) *ScoreRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScoreRunCreate) SetNillableCreatedAt(v *time.Time) *ScoreRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScoreRunCreate) SetID(v int64) *ScoreRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ScoreRunCreate) SetNillableID(v *int64) *ScoreRunCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetResume sets the "resume" edge to the Resume entity.
+func (_c *ScoreRunCreate) SetResume(v *Resume) *ScoreRunCreate {
+	return _c.SetResumeID(v.ID)
+}
+
+// AddModuleIDs adds the "modules" edge to the ScoreHistory entity by IDs.
+func (_c *ScoreRunCreate) AddModuleIDs(ids ...int64) *ScoreRunCreate {
+	_c.mutation.AddModuleIDs(ids...)
+	return _c
+}
+
+// AddModules adds the "modules" edges to the ScoreHistory entity.
+func (_c *ScoreRunCreate) AddModules(v ...*ScoreHistory) *ScoreRunCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddModuleIDs(ids...)
+}
+
+// Mutation returns the ScoreRunMutation object of the builder.
+func (_c *ScoreRunCreate) Mutation() *ScoreRunMutation {
+	return _c.mutation
+}
+
+// Save creates the ScoreRun in the database.
+func (_c *ScoreRunCreate) Save(ctx context.Context) (*ScoreRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScoreRunCreate) SaveX(ctx context.Context) *ScoreRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScoreRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScoreRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScoreRunCreate) defaults() {
+	if _, ok := _c.mutation.RuleVersion(); !ok {
+		v := scorerun.DefaultRuleVersion
+		_c.mutation.SetRuleVersion(v)
+	}
+	if _, ok := _c.mutation.TotalScore(); !ok {
+		v := scorerun.DefaultTotalScore
+		_c.mutation.SetTotalScore(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := scorerun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scorerun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := scorerun.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScoreRunCreate) check() error {
+	if _, ok := _c.mutation.ResumeID(); !ok {
+		return &ValidationError{Name: "resume_id", err: errors.New(`ent: missing required field "ScoreRun.resume_id"`)}
+	}
+	if _, ok := _c.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "ScoreRun.trigger"`)}
+	}
+	if v, ok := _c.mutation.Trigger(); ok {
+		if err := scorerun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "ScoreRun.trigger": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RuleVersion(); !ok {
+		return &ValidationError{Name: "rule_version", err: errors.New(`ent: missing required field "ScoreRun.rule_version"`)}
+	}
+	if _, ok := _c.mutation.TotalScore(); !ok {
+		return &ValidationError{Name: "total_score", err: errors.New(`ent: missing required field "ScoreRun.total_score"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScoreRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := scorerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScoreRun.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScoreRun.created_at"`)}
+	}
+	if len(_c.mutation.ResumeIDs()) == 0 {
+		return &ValidationError{Name: "resume", err: errors.New(`ent: missing required edge "ScoreRun.resume"`)}
+	}
+	return nil
+}
+
+func (_c *ScoreRunCreate) sqlSave(ctx context.Context) (*ScoreRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScoreRunCreate) createSpec() (*ScoreRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScoreRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scorerun.Table, sqlgraph.NewFieldSpec(scorerun.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(scorerun.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := _c.mutation.RuleVersion(); ok {
+		_spec.SetField(scorerun.FieldRuleVersion, field.TypeString, value)
+		_node.RuleVersion = value
+	}
+	if value, ok := _c.mutation.TotalScore(); ok {
+		_spec.SetField(scorerun.FieldTotalScore, field.TypeFloat64, value)
+		_node.TotalScore = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(scorerun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Failures(); ok {
+		_spec.SetField(scorerun.FieldFailures, field.TypeJSON, value)
+		_node.Failures = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scorerun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ResumeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scorerun.ResumeTable,
+			Columns: []string{scorerun.ResumeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resume.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResumeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ModulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scorerun.ModulesTable,
+			Columns: []string{scorerun.ModulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scorehistory.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScoreRunCreateBulk is the builder for creating many ScoreRun entities in bulk.
+type ScoreRunCreateBulk struct {
+	config
+	err      error
+	builders []*ScoreRunCreate
+}
+
+// Save creates the ScoreRun entities in the database.
+func (_c *ScoreRunCreateBulk) Save(ctx context.Context) ([]*ScoreRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScoreRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScoreRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScoreRunCreateBulk) SaveX(ctx context.Context) []*ScoreRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScoreRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScoreRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -28,8 +28,16 @@ type Tx struct {
 	Resume *ResumeClient
 	// ResumeScore is the client for interacting with the ResumeScore builders.
 	ResumeScore *ResumeScoreClient
+	// ResumeShare is the client for interacting with the ResumeShare builders.
+	ResumeShare *ResumeShareClient
+	// ResumeShareView is the client for interacting with the ResumeShareView builders.
+	ResumeShareView *ResumeShareViewClient
 	// ResumeSlot is the client for interacting with the ResumeSlot builders.
 	ResumeSlot *ResumeSlotClient
+	// ScoreHistory is the client for interacting with the ScoreHistory builders.
+	ScoreHistory *ScoreHistoryClient
+	// ScoreRun is the client for interacting with the ScoreRun builders.
+	ScoreRun *ScoreRunClient
 
 	// lazily loaded.
 	client     *Client
@@ -169,7 +177,11 @@ func (tx *Tx) init() {
 	tx.Position = NewPositionClient(tx.config)
 	tx.Resume = NewResumeClient(tx.config)
 	tx.ResumeScore = NewResumeScoreClient(tx.config)
+	tx.ResumeShare = NewResumeShareClient(tx.config)
+	tx.ResumeShareView = NewResumeShareViewClient(tx.config)
 	tx.ResumeSlot = NewResumeSlotClient(tx.config)
+	tx.ScoreHistory = NewScoreHistoryClient(tx.config)
+	tx.ScoreRun = NewScoreRunClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,79 @@
+package interop
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// 简历中的日期为年月精度的自由写法（2020、2020-09、2020.09、2020年9月、至今），
+// 外部格式多为 ISO 8601（2020-09-01、2020-09、2020）
+
+// Present 表示“至今”
+const Present = "至今"
+
+var datePattern = regexp.MustCompile(`^(\d{4})(?:\s*[-./年]\s*(\d{1,2})(?:\s*[-./月]\s*(\d{1,2})日?)?月?)?$`)
+
+// Date 解析后的日期，Month、Day 为 0 表示未填写
+type Date struct {
+	Year, Month, Day int
+	Present          bool
+}
+
+// ParseDate 解析简历日期或 ISO 8601 日期，无法识别时 ok 为 false
+func ParseDate(s string) (d Date, ok bool) {
+	s = strings.TrimSpace(s)
+	if s == Present || strings.EqualFold(s, "present") || s == "今" {
+		return Date{Present: true}, true
+	}
+	// ISO 8601 日期时间只取日期部分
+	if i := strings.IndexByte(s, 'T'); i == 10 {
+		s = s[:i]
+	}
+	m := datePattern.FindStringSubmatch(s)
+	if m == nil {
+		return Date{}, false
+	}
+	d.Year, _ = strconv.Atoi(m[1])
+	d.Month, _ = strconv.Atoi(m[2])
+	d.Day, _ = strconv.Atoi(m[3])
+	if d.Month > 12 || d.Day > 31 {
+		return Date{}, false
+	}
+	return d, true
+}
+
+// ISO 按 ISO 8601 输出（2020、2020-09、2020-09-01），至今为空字符串
+func (d Date) ISO() string {
+	switch {
+	case d.Present || d.Year == 0:
+		return ""
+	case d.Month == 0:
+		return strconv.Itoa(d.Year)
+	case d.Day == 0:
+		return fmt.Sprintf("%04d-%02d", d.Year, d.Month)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// Resume 按简历的年月写法输出（2020、2020-09），至今为“至今”
+func (d Date) Resume() string {
+	switch {
+	case d.Present:
+		return Present
+	case d.Year == 0:
+		return ""
+	case d.Month == 0:
+		return strconv.Itoa(d.Year)
+	}
+	return fmt.Sprintf("%04d-%02d", d.Year, d.Month)
+}
+
+// ISODate 简历日期转 ISO 8601，至今和无法识别的日期返回空字符串
+func ISODate(s string) string {
+	if d, ok := ParseDate(s); ok {
+		return d.ISO()
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package interop
+
+import "strings"
+
+// 学历与外部格式（JSON Resume 的 studyType、Europass 的 EQF 等级）的对应
+// 简历学历取值见 moduleregistry 的 degrees
+
+// DegreeOther 无法识别的学历
+const DegreeOther = "其他"
+
+// degreeAliases 外部写法（小写）到简历学历的对应
+var degreeAliases = map[string]string{
+	"初中":                                "初中",
+	"高中":                                "高中",
+	"中专":                                "中专",
+	"大专":                                "大专",
+	"专科":                                "专科",
+	"本科":                                "本科",
+	"学士":                                "学士",
+	"硕士":                                "硕士",
+	"研究生":                               "研究生",
+	"博士":                                "博士",
+	"其他":                                "其他",
+	"mba":                               "MBA",
+	"middle school":                     "初中",
+	"high school":                       "高中",
+	"secondary":                         "高中",
+	"vocational":                        "中专",
+	"associate":                         "大专",
+	"associate degree":                  "大专",
+	"associate's degree":                "大专",
+	"bachelor":                          "本科",
+	"bachelors":                         "本科",
+	"bachelor's":                        "本科",
+	"bachelor's degree":                 "本科",
+	"bachelor degree":                   "本科",
+	"undergraduate":                     "本科",
+	"ba":                                "本科",
+	"bs":                                "本科",
+	"bsc":                               "本科",
+	"b.s.":                              "本科",
+	"b.a.":                              "本科",
+	"b.sc.":                             "本科",
+	"beng":                              "本科",
+	"master":                            "硕士",
+	"masters":                           "硕士",
+	"master's":                          "硕士",
+	"master's degree":                   "硕士",
+	"master degree":                     "硕士",
+	"graduate":                          "研究生",
+	"ma":                                "硕士",
+	"ms":                                "硕士",
+	"msc":                               "硕士",
+	"m.s.":                              "硕士",
+	"m.a.":                              "硕士",
+	"m.sc.":                             "硕士",
+	"meng":                              "硕士",
+	"phd":                               "博士",
+	"ph.d.":                             "博士",
+	"ph.d":                              "博士",
+	"doctor":                            "博士",
+	"doctorate":                         "博士",
+	"doctoral":                          "博士",
+	"doctor of philosophy":              "博士",
+	"master of business administration": "MBA",
+}
+
+// NormalizeDegree 把外部学历写法转换为简历学历，无法识别时 ok 为 false
+func NormalizeDegree(s string) (degree string, ok bool) {
+	degree, ok = degreeAliases[strings.ToLower(strings.TrimSpace(s))]
+	return degree, ok
+}
+
+// eqfDegrees 欧洲资格框架（EQF）等级对应的学历
+var eqfDegrees = map[int]string{
+	1: "初中",
+	2: "初中",
+	3: "高中",
+	4: "中专",
+	5: "大专",
+	6: "本科",
+	7: "硕士",
+	8: "博士",
+}
+
+// DegreeFromEQF EQF 等级（1-8）对应的学历
+func DegreeFromEQF(level int) (string, bool) {
+	degree, ok := eqfDegrees[level]
+	return degree, ok
+}
+
+// degreeEQF 学历对应的 EQF 等级
+var degreeEQF = map[string]int{
+	"初中":  2,
+	"高中":  3,
+	"中专":  4,
+	"大专":  5,
+	"专科":  5,
+	"本科":  6,
+	"学士":  6,
+	"硕士":  7,
+	"研究生": 7,
+	"MBA": 7,
+	"博士":  8,
+}
+
+// EQFLevel 学历对应的 EQF 等级，无法对应时返回 0
+func EQFLevel(degree string) int {
+	return degreeEQF[degree]
+}
@@ -0,0 +1,83 @@
+package europass
+
+import (
+	"regexp"
+	"strings"
+
+	"cv2/internal/infra/interop"
+)
+
+// 导入导出共用的编码对应
+
+// achievementSections 成就类型编码对应的自定义模块
+var achievementSections = map[string]string{
+	"honors_awards":  "获奖经历",
+	"publications":   "出版物",
+	"certifications": "证书",
+	"references":     "推荐人",
+	"projects":       "项目成果",
+	"memberships":    "社团组织",
+	"conferences":    "会议",
+	"seminars":       "研讨会",
+	"courses":        "课程",
+	"citations":      "引用",
+}
+
+// achievementSelfEval 自我评价导出为该标题（无编码）的成就，导入时识别
+const achievementSelfEval = "自我评价"
+
+// levelMotherTongue 母语在“语言能力”模块中的水平
+const levelMotherTongue = "母语"
+
+// CEFR 各项语言能力在“语言能力”模块条目中的字段名
+const (
+	fieldListening         = "听力"
+	fieldReading           = "阅读"
+	fieldSpokenInteraction = "口语交流"
+	fieldSpokenProduction  = "口语表达"
+	fieldWriting           = "写作"
+)
+
+var cefrLevel = regexp.MustCompile(`(?i)\b([ABC][12])\b`)
+
+type proficiencyField struct {
+	label string
+	value *string
+}
+
+// fields 各项能力及其字段名
+func (p *Proficiency) fields() []proficiencyField {
+	return []proficiencyField{
+		{fieldListening, &p.Listening},
+		{fieldReading, &p.Reading},
+		{fieldSpokenInteraction, &p.SpokenInteraction},
+		{fieldSpokenProduction, &p.SpokenProduction},
+		{fieldWriting, &p.Writing},
+	}
+}
+
+// summary 综合水平：各项相同时为该等级，否则为最低到最高的范围，如 B2-C1
+func (p *Proficiency) summary() string {
+	low, high := "", ""
+	for _, f := range p.fields() {
+		v := strings.ToUpper(strings.TrimSpace(*f.value))
+		if !cefrLevel.MatchString(v) {
+			continue
+		}
+		if low == "" || v < low {
+			low = v
+		}
+		if v > high {
+			high = v
+		}
+	}
+	if low == high {
+		return low
+	}
+	return low + "-" + high
+}
+
+// String 日期的 ISO 8601 写法（2020、2020-09、2020-09-01）
+func (d *Date) String() string {
+	return interop.Date{Year: d.Year, Month: d.Month, Day: d.Day}.ISO()
+}
@@ -0,0 +1,275 @@
+package europass
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/interop"
+)
+
+// Export 把简历转换为 Europass 文档
+// 实习经历与工作经历一起导出为工作经历；项目经历、在校经历、自我评价和自定义模块导出为其他成就，
+// 语言能力模块导出为母语和外语（含 CEFR 等级）；民族、政治面貌、意向城市、期望薪资在 Europass 中没有对应字段，不导出
+func Export(data *algorithm.ResumeData) *Document {
+	doc := &Document{Xmlns: Namespace, Locale: "zh"}
+	li := &doc.LearnerInfo
+
+	id := &Identification{ContactInfo: &ContactInfo{}}
+	if data.Name != "" {
+		first, surname := splitName(data.Name)
+		id.PersonName = &PersonName{FirstName: first, Surname: surname}
+	}
+	if data.Email != "" {
+		id.ContactInfo.Email = &Contact{Contact: data.Email}
+	}
+	if data.Phone != "" {
+		id.ContactInfo.Telephone = []UseContact{{Contact: data.Phone, Use: &CodeLabel{Code: "mobile"}}}
+	}
+	if data.Location != "" {
+		id.ContactInfo.Address = &Address{Contact: AddressContact{Municipality: data.Location}}
+	}
+	if d := date(data.Birthday); d != nil {
+		id.Demographics = &Demographics{Birthdate: d}
+	}
+	li.Identification = id
+
+	if data.JobTitle != "" {
+		li.Headline = &Headline{
+			Type:        &CodeLabel{Code: headlinePreferredJob, Label: "Preferred job"},
+			Description: &CodeLabel{Label: data.JobTitle},
+		}
+	}
+
+	for _, w := range data.WorkExp {
+		li.WorkExperience = append(li.WorkExperience, WorkExperience{
+			Period:     periodOf(w.StartTime, w.EndTime),
+			Position:   label(w.Title),
+			Activities: interop.TextHTML(interop.Paragraphs(w.Responsibilities, w.Achievements)),
+			Employer:   &Organisation{Name: w.Company},
+		})
+	}
+	for _, w := range data.InternExp {
+		li.WorkExperience = append(li.WorkExperience, WorkExperience{
+			Period:     periodOf(w.StartTime, w.EndTime),
+			Position:   label(w.Position),
+			Activities: interop.TextHTML(w.Description),
+			Employer:   &Organisation{Name: w.Company},
+		})
+	}
+
+	for _, e := range data.Education {
+		edu := Education{
+			Period:       periodOf(e.StartTime, e.EndTime),
+			Title:        e.Degree,
+			Activities:   interop.TextHTML(e.Description),
+			Organisation: &Organisation{Name: e.SchoolName},
+			Field:        label(e.Major),
+		}
+		if level := interop.EQFLevel(e.Degree); level != 0 {
+			edu.Level = &CodeLabel{Code: fmt.Sprint(level), Label: fmt.Sprintf("EQF level %d", level)}
+		}
+		li.Education = append(li.Education, edu)
+	}
+
+	skills := &Skills{}
+	if data.Skills != "" {
+		skills.JobRelated = &SkillDescription{Description: interop.TextHTML(data.Skills)}
+	}
+
+	for _, p := range data.ProjectExp {
+		li.Achievement = append(li.Achievement, Achievement{
+			Title:       CodeLabel{Code: "projects", Label: "Projects"},
+			Description: interop.TextHTML(entryText(join("，", p.ProjectName, p.Role), p.StartTime, p.EndTime, p.Description)),
+		})
+	}
+	for _, c := range data.CampusExp {
+		li.Achievement = append(li.Achievement, Achievement{
+			Title:       CodeLabel{Label: "在校经历"},
+			Description: interop.TextHTML(entryText(join("，", c.Title, c.Role), c.StartTime, c.EndTime, c.Description)),
+		})
+	}
+	for _, s := range data.Custom {
+		switch s.Title {
+		case interop.SectionLanguages:
+			skills.Linguistic = exportLanguages(s.Items)
+		case interop.SectionProfiles:
+			exportProfiles(id.ContactInfo, s.Items)
+		default:
+			title := CodeLabel{Code: sectionCodes[s.Title], Label: s.Title}
+			for _, item := range s.Items {
+				li.Achievement = append(li.Achievement, Achievement{Title: title, Description: interop.TextHTML(customText(item))})
+			}
+		}
+	}
+	if data.SelfEval != "" {
+		li.Achievement = append(li.Achievement, Achievement{
+			Title:       CodeLabel{Label: achievementSelfEval},
+			Description: interop.TextHTML(data.SelfEval),
+		})
+	}
+	if *skills != (Skills{}) {
+		li.Skills = skills
+	}
+	if ci := id.ContactInfo; ci.Address == nil && ci.Email == nil && len(ci.Telephone) == 0 && len(ci.Website) == 0 && len(ci.InstantMessaging) == 0 {
+		id.ContactInfo = nil
+	}
+	return doc
+}
+
+// emptyList 匹配空的列表容器元素
+// encoding/xml 处理 a>b 形式的字段时，即使切片为空也会输出父元素
+var emptyList = regexp.MustCompile(`\n\s*<\w+List></\w+List>`)
+
+// EncodeXML 输出 XML 写法
+func EncodeXML(doc *Document) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("encode europass xml: %w", err)
+	}
+	return emptyList.ReplaceAll(buf.Bytes(), nil), nil
+}
+
+// EncodeJSON 输出 JSON 写法
+func EncodeJSON(doc *Document) ([]byte, error) {
+	b, err := json.MarshalIndent(jsonDocument{SkillsPassport: doc}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode europass json: %w", err)
+	}
+	return b, nil
+}
+
+// sectionCodes 自定义模块对应的成就类型编码
+var sectionCodes = func() map[string]string {
+	m := make(map[string]string, len(achievementSections))
+	for code, section := range achievementSections {
+		m[section] = code
+	}
+	return m
+}()
+
+// exportLanguages 水平为“母语”的导出为母语，其他导出为外语；
+// 各项 CEFR 等级优先取对应字段，没有时取水平中的第一个等级
+func exportLanguages(items []map[string]string) *Linguistic {
+	l := &Linguistic{}
+	for _, item := range items {
+		lang := Language{Description: CodeLabel{Label: item[algorithm.CustomFieldTitle]}}
+		level := item[interop.FieldLevel]
+		if level == levelMotherTongue || strings.EqualFold(level, "native") || strings.EqualFold(level, "native speaker") {
+			l.MotherTongue = append(l.MotherTongue, lang)
+			continue
+		}
+		p := &Proficiency{}
+		fallback := strings.ToUpper(cefrLevel.FindString(level))
+		for _, f := range p.fields() {
+			v := strings.ToUpper(cefrLevel.FindString(item[f.label]))
+			if v == "" {
+				v = fallback
+			}
+			*f.value = v
+		}
+		if *p != (Proficiency{}) {
+			lang.ProficiencyLevel = p
+		}
+		l.ForeignLanguage = append(l.ForeignLanguage, lang)
+	}
+	return l
+}
+
+// exportProfiles 个人网站导出为网站，其他账号导出为即时通讯
+func exportProfiles(ci *ContactInfo, items []map[string]string) {
+	for _, item := range items {
+		if url := item[interop.FieldURL]; url != "" {
+			ci.Website = append(ci.Website, UseContact{Contact: url})
+			continue
+		}
+		if account := item[algorithm.CustomFieldSubtitle]; account != "" {
+			ci.InstantMessaging = append(ci.InstantMessaging, UseContact{Contact: account, Use: label(item[algorithm.CustomFieldTitle])})
+		}
+	}
+}
+
+// customText 自定义模块条目的文本：标题、时间、描述，其他字段按 字段名：值 输出
+func customText(item map[string]string) string {
+	text := entryText(join("，", item[algorithm.CustomFieldTitle], item[algorithm.CustomFieldSubtitle]),
+		item[algorithm.CustomFieldStartTime], item[algorithm.CustomFieldEndTime], item[algorithm.CustomFieldDescription])
+	var extra []string
+	for k, v := range item {
+		switch k {
+		case algorithm.CustomFieldTitle, algorithm.CustomFieldSubtitle, algorithm.CustomFieldStartTime,
+			algorithm.CustomFieldEndTime, algorithm.CustomFieldDescription:
+			continue
+		}
+		extra = append(extra, interop.Labeled(k, v))
+	}
+	sort.Strings(extra)
+	return interop.Paragraphs(append([]string{text}, extra...)...)
+}
+
+// entryText 条目文本，第一行为标题和时间
+func entryText(title, start, end, description string) string {
+	head := title
+	if when := join(" - ", start, end); when != "" {
+		head = join(" ", head, "("+when+")")
+	}
+	return interop.Paragraphs(head, description)
+}
+
+func join(sep string, parts ...string) string {
+	var result []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			result = append(result, p)
+		}
+	}
+	return strings.Join(result, sep)
+}
+
+func label(s string) *CodeLabel {
+	if s == "" {
+		return nil
+	}
+	return &CodeLabel{Label: s}
+}
+
+// date 简历日期转 Europass 日期，至今和无法识别的日期返回 nil
+func date(s string) *Date {
+	d, ok := interop.ParseDate(s)
+	if !ok || d.Present {
+		return nil
+	}
+	return &Date{Year: d.Year, Month: d.Month, Day: d.Day}
+}
+
+func periodOf(start, end string) *Period {
+	p := &Period{From: date(start), To: date(end)}
+	if d, ok := interop.ParseDate(end); ok && d.Present {
+		p.Current = true
+	}
+	if p.From == nil && p.To == nil && !p.Current {
+		return nil
+	}
+	return p
+}
+
+// splitName 拆分姓名：中文姓名第一个字为姓，其他按最后一个单词为姓
+func splitName(name string) (first, surname string) {
+	name = strings.TrimSpace(name)
+	if isHan(name) && !strings.Contains(name, " ") {
+		r, size := utf8.DecodeRuneInString(name)
+		return name[size:], string(r)
+	}
+	if i := strings.LastIndex(name, " "); i > 0 {
+		return strings.TrimSpace(name[:i]), name[i+1:]
+	}
+	return name, ""
+}
@@ -0,0 +1,350 @@
+package europass
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/interop"
+)
+
+// ErrInvalidDocument 文件不是 Europass 文档
+var ErrInvalidDocument = errors.New("invalid europass document")
+
+// ImportXML 解析 Europass XML 文档
+func ImportXML(b []byte) (*interop.Importer, error) {
+	var doc Document
+	if err := xml.NewDecoder(bytes.NewReader(b)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+	return importDocument(&doc), nil
+}
+
+// ImportJSON 解析 Europass JSON 文档
+func ImportJSON(b []byte) (*interop.Importer, error) {
+	var root jsonDocument
+	if err := json.Unmarshal(b, &root); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+	if root.SkillsPassport == nil {
+		return nil, fmt.Errorf("%w: missing SkillsPassport", ErrInvalidDocument)
+	}
+	return importDocument(root.SkillsPassport), nil
+}
+
+// importDocument 转换 Europass 文档
+// 个人信息、工作经历、教育经历（EQF 等级转学历）、技能映射到内置模块，外语（含 CEFR 等级）导入为“语言能力”模块，
+// 其他成就按类型导入为自定义模块，无法映射的字段放入“其他信息”
+func importDocument(doc *Document) *interop.Importer {
+	im := interop.NewImporter()
+	li := &doc.LearnerInfo
+	importIdentification(im, li.Identification)
+	importHeadline(im, li.Headline)
+	for i, w := range li.WorkExperience {
+		importWork(im, interop.Path("LearnerInfo.WorkExperience", i, ""), w)
+	}
+	for i, e := range li.Education {
+		importEducation(im, interop.Path("LearnerInfo.Education", i, ""), e)
+	}
+	importSkills(im, li.Skills)
+	for i, a := range li.Achievement {
+		importAchievement(im, interop.Path("LearnerInfo.Achievement", i, ""), a)
+	}
+	return im
+}
+
+func importIdentification(im *interop.Importer, id *Identification) {
+	if id == nil {
+		return
+	}
+	const path = "LearnerInfo.Identification"
+	d := im.Data
+	if id.PersonName != nil {
+		im.Set(&d.Name, path+".PersonName", "姓名", joinName(id.PersonName.FirstName, id.PersonName.Surname))
+	}
+	if id.Photo != nil {
+		im.Report.Ignored(path + ".Photo")
+	}
+
+	if ci := id.ContactInfo; ci != nil {
+		if ci.Email != nil {
+			im.Set(&d.Email, path+".ContactInfo.Email", "邮箱", ci.Email.Contact)
+		}
+		for i, t := range ci.Telephone {
+			source := interop.Path(path+".ContactInfo.Telephone", i, "")
+			if d.Phone == "" {
+				im.Set(&d.Phone, source, "电话", t.Contact)
+			} else {
+				im.Other(source, labeledContact(t))
+			}
+		}
+		if ci.Address != nil {
+			a := ci.Address.Contact
+			im.Set(&d.Location, path+".ContactInfo.Address.Municipality", "所在地", a.Municipality)
+			other(im, path+".ContactInfo.Address.AddressLine", a.AddressLine)
+			other(im, path+".ContactInfo.Address.PostalCode", a.PostalCode)
+			other(im, path+".ContactInfo.Address.Country", a.Country.Text())
+		}
+		for i, w := range ci.Website {
+			im.AddCustom(interop.Path(path+".ContactInfo.Website", i, ""), interop.SectionProfiles, map[string]string{
+				algorithm.CustomFieldTitle: interop.ProfileWebsite,
+				interop.FieldURL:           w.Contact,
+			})
+		}
+		for i, m := range ci.InstantMessaging {
+			im.AddCustom(interop.Path(path+".ContactInfo.InstantMessaging", i, ""), interop.SectionProfiles, map[string]string{
+				algorithm.CustomFieldTitle:    m.Use.Text(),
+				algorithm.CustomFieldSubtitle: m.Contact,
+			})
+		}
+	}
+
+	if demo := id.Demographics; demo != nil {
+		if demo.Birthdate != nil {
+			im.Date(&d.Birthday, path+".Demographics.Birthdate", "出生日期", demo.Birthdate.String())
+		}
+		other(im, path+".Demographics.Gender", demo.Gender.Text())
+		for i, n := range demo.Nationality {
+			other(im, interop.Path(path+".Demographics.Nationality", i, ""), n.Text())
+		}
+	}
+}
+
+// importHeadline 个人陈述导入为自我评价，其他类型（求职意向、申请职位等）导入为意向岗位
+func importHeadline(im *interop.Importer, h *Headline) {
+	if h == nil || h.Description == nil {
+		return
+	}
+	const path = "LearnerInfo.Headline.Description"
+	if h.Type != nil && h.Type.Code == headlinePersonalStatement {
+		im.Set(&im.Data.SelfEval, path, "自我评价", h.Description.Text())
+		return
+	}
+	im.Set(&im.Data.JobTitle, path, "意向岗位", h.Description.Text())
+}
+
+// importWork 工作经历，没有雇主名称的条目放入“其他信息”
+func importWork(im *interop.Importer, path string, w WorkExperience) {
+	d := im.Data
+	if w.Employer == nil || strings.TrimSpace(w.Employer.Name) == "" {
+		other(im, path+".Position", w.Position.Text())
+		other(im, path+".Activities", interop.HTMLText(w.Activities))
+		return
+	}
+
+	prefix := interop.Path("工作经历", len(d.WorkExp), "")
+	var exp algorithm.WorkExp
+	im.Set(&exp.Company, path+".Employer.Name", prefix+".公司名称", w.Employer.Name)
+	im.Set(&exp.Title, path+".Position", prefix+".职位", w.Position.Text())
+	period(im, path, prefix+".开始时间", prefix+".结束时间", w.Period, &exp.StartTime, &exp.EndTime)
+	im.Set(&exp.Responsibilities, path+".Activities", prefix+".工作职责", interop.HTMLText(w.Activities))
+	other(im, path+".Employer.Sector", w.Employer.Sector.Text())
+	other(im, path+".Employer.ContactInfo", contactText(w.Employer.ContactInfo))
+	d.WorkExp = append(d.WorkExp, exp)
+}
+
+// importEducation 教育经历：学历优先按 EQF 等级，其次按学位名称识别；专业取 Field，没有时取学位名称
+func importEducation(im *interop.Importer, path string, e Education) {
+	d := im.Data
+	if e.Organisation == nil || strings.TrimSpace(e.Organisation.Name) == "" {
+		other(im, path+".Title", e.Title)
+		other(im, path+".Activities", interop.HTMLText(e.Activities))
+		return
+	}
+
+	prefix := interop.Path("教育经历", len(d.Education), "")
+	var exp algorithm.EducationExp
+	im.Set(&exp.SchoolName, path+".Organisation.Name", prefix+".学校名称", e.Organisation.Name)
+	period(im, path, prefix+".入学时间", prefix+".毕业时间", e.Period, &exp.StartTime, &exp.EndTime)
+
+	title := strings.TrimSpace(e.Title)
+	degree, ok := "", false
+	if e.Level != nil {
+		if level, err := strconv.Atoi(eqfDigits.FindString(e.Level.Code + " " + e.Level.Label)); err == nil {
+			degree, ok = interop.DegreeFromEQF(level)
+		}
+		if ok {
+			im.Set(&exp.Degree, path+".Level", prefix+".学历", degree)
+		} else {
+			other(im, path+".Level", e.Level.Text())
+		}
+	}
+	if titleDegree, titleOK := interop.NormalizeDegree(title); titleOK {
+		// 学位名称就是学历（如本系统导出的文件），不再写入描述
+		if !ok {
+			im.Set(&exp.Degree, path+".Title", prefix+".学历", titleDegree)
+		}
+		title = ""
+	}
+
+	field := e.Field.Text()
+	if field != "" {
+		im.Set(&exp.Major, path+".Field", prefix+".专业", field)
+	} else {
+		im.Set(&exp.Major, path+".Title", prefix+".专业", title)
+		title = ""
+	}
+	activities := interop.HTMLText(e.Activities)
+	exp.Description = interop.Paragraphs(activities, interop.Labeled("学位", title))
+	im.Report.Mapped(path+".Activities", prefix+".经历描述", activities)
+	im.Report.Mapped(path+".Title", prefix+".经历描述", title)
+	other(im, path+".Organisation.ContactInfo", contactText(e.Organisation.ContactInfo))
+	d.Education = append(d.Education, exp)
+}
+
+var eqfDigits = regexp.MustCompile(`[1-8]`)
+
+// importSkills 各类技能按类别拼接为技能证书，语言导入为“语言能力”模块
+func importSkills(im *interop.Importer, s *Skills) {
+	if s == nil {
+		return
+	}
+	const path = "LearnerInfo.Skills"
+	var parts []string
+	add := func(source, label, text string) {
+		text = interop.HTMLText(text)
+		if text == "" {
+			return
+		}
+		parts = append(parts, interop.Labeled(label, text))
+		im.Report.Mapped(path+"."+source, "技能证书", text)
+	}
+	// 与职业相关的技能不加前缀（本系统导出时技能证书写入该项）
+	if s.JobRelated != nil && strings.TrimSpace(s.JobRelated.Description) != "" {
+		text := interop.HTMLText(s.JobRelated.Description)
+		parts = append(parts, text)
+		im.Report.Mapped(path+".JobRelated", "技能证书", text)
+	}
+	for _, c := range []struct {
+		source, label string
+		skill         *SkillDescription
+	}{
+		{"Communication", "沟通能力", s.Communication},
+		{"Organisational", "组织管理能力", s.Organisational},
+		{"Computer", "计算机技能", s.Computer},
+		{"Other", "其他技能", s.Other},
+	} {
+		if c.skill != nil {
+			add(c.source, c.label, c.skill.Description)
+		}
+	}
+	if s.Driving != nil {
+		add("Driving", "驾驶证", strings.Join(s.Driving.Description.Licence, "、"))
+	}
+	im.Data.Skills = strings.Join(parts, "\n")
+
+	if s.Linguistic == nil {
+		return
+	}
+	for i, l := range s.Linguistic.MotherTongue {
+		im.AddCustom(interop.Path(path+".Linguistic.MotherTongue", i, ""), interop.SectionLanguages, map[string]string{
+			algorithm.CustomFieldTitle: l.Description.Text(),
+			interop.FieldLevel:         levelMotherTongue,
+		})
+	}
+	for i, l := range s.Linguistic.ForeignLanguage {
+		item := map[string]string{algorithm.CustomFieldTitle: l.Description.Text()}
+		if p := l.ProficiencyLevel; p != nil {
+			for _, f := range p.fields() {
+				item[f.label] = strings.ToUpper(strings.TrimSpace(*f.value))
+			}
+			item[interop.FieldLevel] = p.summary()
+		}
+		im.AddCustom(interop.Path(path+".Linguistic.ForeignLanguage", i, ""), interop.SectionLanguages, item)
+	}
+}
+
+// importAchievement 其他成就按类型导入为自定义模块，本系统导出的自我评价导回自我评价
+func importAchievement(im *interop.Importer, path string, a Achievement) {
+	text := interop.HTMLText(a.Description)
+	if a.Title.Code == "" && a.Title.Label == achievementSelfEval {
+		im.Set(&im.Data.SelfEval, path, "自我评价", text)
+		return
+	}
+	section, ok := achievementSections[a.Title.Code]
+	if !ok {
+		section = a.Title.Text()
+	}
+	if section == "" {
+		section = interop.OtherSection
+	}
+	im.AddCustom(path, section, map[string]string{algorithm.CustomFieldDescription: text})
+}
+
+// period 导入起止时间，Current 或有开始时间而没有结束时间表示至今
+func period(im *interop.Importer, path, startTarget, endTarget string, p *Period, start, end *string) {
+	if p == nil {
+		return
+	}
+	if p.From != nil {
+		im.Date(start, path+".Period.From", startTarget, p.From.String())
+	}
+	if p.To != nil && !p.Current {
+		im.Date(end, path+".Period.To", endTarget, p.To.String())
+	}
+	if *start != "" && *end == "" {
+		*end = interop.Present
+	}
+}
+
+// other 非空字段放入“其他信息”
+func other(im *interop.Importer, source, value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		im.Other(source, value)
+	}
+}
+
+// joinName 拼接姓名：中文姓在前且不加空格，其他按 名 姓
+func joinName(first, surname string) string {
+	first, surname = strings.TrimSpace(first), strings.TrimSpace(surname)
+	if isHan(first) || isHan(surname) {
+		return surname + first
+	}
+	return strings.TrimSpace(first + " " + surname)
+}
+
+func isHan(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// labeledContact 带用途的联系方式，如 工作：+86 10 1234 5678
+func labeledContact(c UseContact) string {
+	if use := c.Use.Text(); use != "" {
+		return use + "：" + c.Contact
+	}
+	return c.Contact
+}
+
+// contactText 雇主、学校等机构联系方式的文本
+func contactText(ci *ContactInfo) string {
+	if ci == nil {
+		return ""
+	}
+	var parts []string
+	if ci.Address != nil {
+		a := ci.Address.Contact
+		parts = append(parts, a.AddressLine, a.PostalCode, a.Municipality, a.Country.Text())
+	}
+	for _, w := range ci.Website {
+		parts = append(parts, w.Contact)
+	}
+	var result []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			result = append(result, p)
+		}
+	}
+	return strings.Join(result, " ")
+}
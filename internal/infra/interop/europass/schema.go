@@ -0,0 +1,247 @@
+package europass
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Europass CV（SkillsPassport v3）文档结构
+// XML 与 JSON 两种写法的结构相同，区别在于 XML 的列表有 XxxList 外层元素、日期为属性（month="--09"），
+// JSON 的根对象为 {"SkillsPassport": {...}}、日期为数字；同一套结构体同时带 xml 和 json tag
+
+// Namespace Europass XML 命名空间
+const Namespace = "http://europass.cedefop.europa.eu/Europass"
+
+// Document Europass 文档
+type Document struct {
+	XMLName     xml.Name    `xml:"SkillsPassport" json:"-"`
+	Xmlns       string      `xml:"xmlns,attr,omitempty" json:"-"`
+	Locale      string      `xml:"locale,attr,omitempty" json:"Locale,omitempty"`
+	LearnerInfo LearnerInfo `xml:"LearnerInfo" json:"LearnerInfo"`
+}
+
+// jsonDocument JSON 写法的根对象
+type jsonDocument struct {
+	SkillsPassport *Document `json:"SkillsPassport"`
+}
+
+// LearnerInfo 简历内容
+type LearnerInfo struct {
+	Identification *Identification  `xml:"Identification,omitempty" json:"Identification,omitempty"`
+	Headline       *Headline        `xml:"Headline,omitempty" json:"Headline,omitempty"`
+	WorkExperience []WorkExperience `xml:"WorkExperienceList>WorkExperience,omitempty" json:"WorkExperience,omitempty"`
+	Education      []Education      `xml:"EducationList>Education,omitempty" json:"Education,omitempty"`
+	Skills         *Skills          `xml:"Skills,omitempty" json:"Skills,omitempty"`
+	Achievement    []Achievement    `xml:"AchievementList>Achievement,omitempty" json:"Achievement,omitempty"`
+}
+
+// Identification 个人信息
+type Identification struct {
+	PersonName   *PersonName   `xml:"PersonName,omitempty" json:"PersonName,omitempty"`
+	ContactInfo  *ContactInfo  `xml:"ContactInfo,omitempty" json:"ContactInfo,omitempty"`
+	Demographics *Demographics `xml:"Demographics,omitempty" json:"Demographics,omitempty"`
+	Photo        *struct{}     `xml:"Photo,omitempty" json:"Photo,omitempty"` // 照片不导入，只记录是否存在
+}
+
+// PersonName 姓名
+type PersonName struct {
+	FirstName string `xml:"FirstName,omitempty" json:"FirstName,omitempty"`
+	Surname   string `xml:"Surname,omitempty" json:"Surname,omitempty"`
+}
+
+// ContactInfo 联系方式
+type ContactInfo struct {
+	Address          *Address     `xml:"Address,omitempty" json:"Address,omitempty"`
+	Email            *Contact     `xml:"Email,omitempty" json:"Email,omitempty"`
+	Telephone        []UseContact `xml:"TelephoneList>Telephone,omitempty" json:"Telephone,omitempty"`
+	Website          []UseContact `xml:"WebsiteList>Website,omitempty" json:"Website,omitempty"`
+	InstantMessaging []UseContact `xml:"InstantMessagingList>InstantMessaging,omitempty" json:"InstantMessaging,omitempty"`
+}
+
+// Address 地址
+type Address struct {
+	Contact AddressContact `xml:"Contact" json:"Contact"`
+}
+
+// AddressContact 地址内容
+type AddressContact struct {
+	AddressLine  string     `xml:"AddressLine,omitempty" json:"AddressLine,omitempty"`
+	PostalCode   string     `xml:"PostalCode,omitempty" json:"PostalCode,omitempty"`
+	Municipality string     `xml:"Municipality,omitempty" json:"Municipality,omitempty"`
+	Country      *CodeLabel `xml:"Country,omitempty" json:"Country,omitempty"`
+}
+
+// Contact 单个联系方式
+type Contact struct {
+	Contact string `xml:"Contact" json:"Contact"`
+}
+
+// UseContact 带用途的联系方式（电话、网站、即时通讯）
+type UseContact struct {
+	Contact string     `xml:"Contact" json:"Contact"`
+	Use     *CodeLabel `xml:"Use,omitempty" json:"Use,omitempty"`
+}
+
+// CodeLabel 编码和显示名
+type CodeLabel struct {
+	Code  string `xml:"Code,omitempty" json:"Code,omitempty"`
+	Label string `xml:"Label,omitempty" json:"Label,omitempty"`
+}
+
+// Text 显示名，没有时返回编码
+func (c *CodeLabel) Text() string {
+	if c == nil {
+		return ""
+	}
+	if c.Label != "" {
+		return strings.TrimSpace(c.Label)
+	}
+	return strings.TrimSpace(c.Code)
+}
+
+// Demographics 人口信息
+type Demographics struct {
+	Birthdate   *Date       `xml:"Birthdate,omitempty" json:"Birthdate,omitempty"`
+	Gender      *CodeLabel  `xml:"Gender,omitempty" json:"Gender,omitempty"`
+	Nationality []CodeLabel `xml:"NationalityList>Nationality,omitempty" json:"Nationality,omitempty"`
+}
+
+// Headline 求职意向或个人陈述
+type Headline struct {
+	Type        *CodeLabel `xml:"Type,omitempty" json:"Type,omitempty"`
+	Description *CodeLabel `xml:"Description,omitempty" json:"Description,omitempty"`
+}
+
+// Headline.Type 的编码
+const (
+	headlinePreferredJob      = "preferred_job"
+	headlinePersonalStatement = "personal_statement"
+)
+
+// Period 起止时间，Current 表示至今
+type Period struct {
+	From    *Date `xml:"From,omitempty" json:"From,omitempty"`
+	To      *Date `xml:"To,omitempty" json:"To,omitempty"`
+	Current bool  `xml:"Current,omitempty" json:"Current,omitempty"`
+}
+
+// WorkExperience 工作经历
+type WorkExperience struct {
+	Period     *Period       `xml:"Period,omitempty" json:"Period,omitempty"`
+	Position   *CodeLabel    `xml:"Position,omitempty" json:"Position,omitempty"`
+	Activities string        `xml:"Activities,omitempty" json:"Activities,omitempty"` // 富文本
+	Employer   *Organisation `xml:"Employer,omitempty" json:"Employer,omitempty"`
+}
+
+// Organisation 雇主或教育机构
+type Organisation struct {
+	Name        string       `xml:"Name,omitempty" json:"Name,omitempty"`
+	ContactInfo *ContactInfo `xml:"ContactInfo,omitempty" json:"ContactInfo,omitempty"`
+	Sector      *CodeLabel   `xml:"Sector,omitempty" json:"Sector,omitempty"`
+}
+
+// Education 教育经历，Level 为 EQF 等级（1-8）
+type Education struct {
+	Period       *Period       `xml:"Period,omitempty" json:"Period,omitempty"`
+	Title        string        `xml:"Title,omitempty" json:"Title,omitempty"`
+	Activities   string        `xml:"Activities,omitempty" json:"Activities,omitempty"`
+	Organisation *Organisation `xml:"Organisation,omitempty" json:"Organisation,omitempty"`
+	Level        *CodeLabel    `xml:"Level,omitempty" json:"Level,omitempty"`
+	Field        *CodeLabel    `xml:"Field,omitempty" json:"Field,omitempty"`
+}
+
+// Skills 技能
+type Skills struct {
+	Linguistic     *Linguistic       `xml:"Linguistic,omitempty" json:"Linguistic,omitempty"`
+	Communication  *SkillDescription `xml:"Communication,omitempty" json:"Communication,omitempty"`
+	Organisational *SkillDescription `xml:"Organisational,omitempty" json:"Organisational,omitempty"`
+	JobRelated     *SkillDescription `xml:"JobRelated,omitempty" json:"JobRelated,omitempty"`
+	Computer       *SkillDescription `xml:"Computer,omitempty" json:"Computer,omitempty"`
+	Driving        *Driving          `xml:"Driving,omitempty" json:"Driving,omitempty"`
+	Other          *SkillDescription `xml:"Other,omitempty" json:"Other,omitempty"`
+}
+
+// SkillDescription 技能描述（富文本）
+type SkillDescription struct {
+	Description string `xml:"Description,omitempty" json:"Description,omitempty"`
+}
+
+// Driving 驾驶证
+type Driving struct {
+	Description struct {
+		Licence []string `xml:"Licence" json:"Licence,omitempty"`
+	} `xml:"Description" json:"Description"`
+}
+
+// Linguistic 语言能力
+type Linguistic struct {
+	MotherTongue    []Language `xml:"MotherTongueList>MotherTongue,omitempty" json:"MotherTongue,omitempty"`
+	ForeignLanguage []Language `xml:"ForeignLanguageList>ForeignLanguage,omitempty" json:"ForeignLanguage,omitempty"`
+}
+
+// Language 语言，外语带 CEFR 等级
+type Language struct {
+	Description      CodeLabel    `xml:"Description" json:"Description"`
+	ProficiencyLevel *Proficiency `xml:"ProficiencyLevel,omitempty" json:"ProficiencyLevel,omitempty"`
+}
+
+// Proficiency 各项语言能力的 CEFR 等级（A1-C2）
+type Proficiency struct {
+	Listening         string `xml:"Listening,omitempty" json:"Listening,omitempty"`
+	Reading           string `xml:"Reading,omitempty" json:"Reading,omitempty"`
+	SpokenInteraction string `xml:"SpokenInteraction,omitempty" json:"SpokenInteraction,omitempty"`
+	SpokenProduction  string `xml:"SpokenProduction,omitempty" json:"SpokenProduction,omitempty"`
+	Writing           string `xml:"Writing,omitempty" json:"Writing,omitempty"`
+}
+
+// Achievement 其他成就（获奖、出版物、项目等），Title.Code 标识类型
+type Achievement struct {
+	Title       CodeLabel `xml:"Title" json:"Title"`
+	Description string    `xml:"Description,omitempty" json:"Description,omitempty"` // 富文本
+}
+
+// Date 日期，Month、Day 为 0 表示未填写
+// XML 写法为属性 year="2020" month="--09" day="---01"，JSON 写法为 {"Year": 2020, "Month": 9, "Day": 1}
+type Date struct {
+	Year  int `json:"Year,omitempty"`
+	Month int `json:"Month,omitempty"`
+	Day   int `json:"Day,omitempty"`
+}
+
+// UnmarshalXML 解析日期属性
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		v, err := strconv.Atoi(strings.TrimLeft(attr.Value, "-"))
+		if err != nil {
+			return fmt.Errorf("invalid date %s=%q", attr.Name.Local, attr.Value)
+		}
+		switch attr.Name.Local {
+		case "year":
+			d.Year = v
+		case "month":
+			d.Month = v
+		case "day":
+			d.Day = v
+		}
+	}
+	return dec.Skip()
+}
+
+// MarshalXML 输出日期属性
+func (d Date) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if d.Year != 0 {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "year"}, Value: strconv.Itoa(d.Year)})
+	}
+	if d.Month != 0 {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "month"}, Value: fmt.Sprintf("--%02d", d.Month)})
+	}
+	if d.Day != 0 {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "day"}, Value: fmt.Sprintf("---%02d", d.Day)})
+	}
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+	return enc.EncodeToken(start.End())
+}
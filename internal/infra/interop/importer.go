@@ -0,0 +1,87 @@
+package interop
+
+import (
+	"strings"
+
+	"cv2/internal/infra/algorithm"
+)
+
+// Importer 导入过程的公共状态：转换结果、自定义模块和导入报告
+type Importer struct {
+	Data   *algorithm.ResumeData
+	Report Report
+}
+
+// NewImporter 创建导入状态
+func NewImporter() *Importer {
+	return &Importer{Data: &algorithm.ResumeData{}}
+}
+
+// Set 把非空的来源字段写入目标字段并记录映射
+func (im *Importer) Set(dst *string, source, target, value string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return
+	}
+	*dst = value
+	im.Report.Mapped(source, target, value)
+}
+
+// Date 转换日期并记录映射，无法识别的日期放入“其他信息”，返回空字符串
+func (im *Importer) Date(dst *string, source, target, value string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return
+	}
+	d, ok := ParseDate(value)
+	if !ok {
+		im.Other(source, value)
+		return
+	}
+	*dst = d.Resume()
+	im.Report.Mapped(source, target, value)
+}
+
+// AddCustom 向自定义模块追加条目（模块不存在时创建），item 的 key 为字段显示名
+// 空字段忽略，没有非空字段的条目不追加
+func (im *Importer) AddCustom(source, section string, item map[string]string) {
+	for k, v := range item {
+		if v = strings.TrimSpace(v); v == "" {
+			delete(item, k)
+		} else {
+			item[k] = v
+		}
+	}
+	if len(item) == 0 {
+		return
+	}
+	custom := im.Data.Custom
+	i := 0
+	for i < len(custom) && custom[i].Title != section {
+		i++
+	}
+	if i == len(custom) {
+		im.Data.Custom = append(im.Data.Custom, algorithm.CustomSection{Title: section})
+	}
+	s := &im.Data.Custom[i]
+	s.Items = append(s.Items, item)
+	im.Report.Custom(source, section, len(s.Items)-1)
+}
+
+// Other 把无法映射的零散字段放入“其他信息”模块，名称为来源字段路径
+func (im *Importer) Other(source, value string) {
+	im.AddCustom(source, OtherSection, map[string]string{
+		algorithm.CustomFieldTitle:       source,
+		algorithm.CustomFieldDescription: value,
+	})
+}
+
+// Empty 是否没有导入任何内容
+func (im *Importer) Empty() bool {
+	for _, m := range im.Report.Mappings {
+		if m.Kind != KindIgnored {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,181 @@
+package jsonresume
+
+import (
+	"regexp"
+	"strings"
+
+	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/interop"
+)
+
+// Export 把简历转换为 JSON Resume 文档
+// 日期转为 ISO 8601，“至今”不输出结束日期；自定义模块按标题导出到对应部分，其余放入 x-customSections
+func Export(data *algorithm.ResumeData) *Resume {
+	r := &Resume{
+		Schema: SchemaURL,
+		Basics: Basics{
+			Name:       data.Name,
+			Label:      data.JobTitle,
+			Email:      data.Email,
+			Phone:      data.Phone,
+			Summary:    data.SelfEval,
+			Birthday:   interop.ISODate(data.Birthday),
+			Ethnicity:  data.Ethnicity,
+			Politics:   data.Politics,
+			TargetCity: data.TargetCity,
+			MinSalary:  data.MinSalary,
+			MaxSalary:  data.MaxSalary,
+			JobType:    data.JobType,
+		},
+	}
+	if data.Location != "" {
+		r.Basics.Location = &Location{City: data.Location}
+	}
+
+	for _, w := range data.WorkExp {
+		r.Work = append(r.Work, Work{
+			Name:           w.Company,
+			Position:       w.Title,
+			StartDate:      interop.ISODate(w.StartTime),
+			EndDate:        interop.ISODate(w.EndTime),
+			Summary:        w.Responsibilities,
+			Highlights:     interop.Lines(w.Achievements),
+			Department:     w.Department,
+			EmploymentType: w.EmploymentType,
+		})
+	}
+	for _, w := range data.InternExp {
+		r.Work = append(r.Work, Work{
+			Name:       w.Company,
+			Position:   w.Position,
+			StartDate:  interop.ISODate(w.StartTime),
+			EndDate:    interop.ISODate(w.EndTime),
+			Summary:    w.Description,
+			Internship: true,
+		})
+	}
+	for _, c := range data.CampusExp {
+		r.Volunteer = append(r.Volunteer, Volunteer{
+			Organization: c.Title,
+			Position:     c.Role,
+			StartDate:    interop.ISODate(c.StartTime),
+			EndDate:      interop.ISODate(c.EndTime),
+			Summary:      c.Description,
+			Campus:       true,
+		})
+	}
+	for _, e := range data.Education {
+		r.Education = append(r.Education, Education{
+			Institution: e.SchoolName,
+			Area:        e.Major,
+			StudyType:   e.Degree,
+			StartDate:   interop.ISODate(e.StartTime),
+			EndDate:     interop.ISODate(e.EndTime),
+			Description: e.Description,
+		})
+	}
+	for _, p := range data.ProjectExp {
+		project := Project{
+			Name:        p.ProjectName,
+			Description: p.Description,
+			StartDate:   interop.ISODate(p.StartTime),
+			EndDate:     interop.ISODate(p.EndTime),
+		}
+		if p.Role != "" {
+			project.Roles = []string{p.Role}
+		}
+		r.Projects = append(r.Projects, project)
+	}
+	for _, line := range interop.Lines(data.Skills) {
+		r.Skills = append(r.Skills, parseSkill(line))
+	}
+	for _, s := range data.Custom {
+		exportCustom(r, s)
+	}
+	return r
+}
+
+// exportCustom 按标题把自定义模块导出到对应部分
+func exportCustom(r *Resume, s algorithm.CustomSection) {
+	for _, item := range s.Items {
+		title, subtitle := item[algorithm.CustomFieldTitle], item[algorithm.CustomFieldSubtitle]
+		start, desc, url := customDate(item), item[algorithm.CustomFieldDescription], item[interop.FieldURL]
+		switch s.Title {
+		case interop.SectionProfiles:
+			if subtitle == "" && title == interop.ProfileWebsite {
+				r.Basics.URL = url
+				continue
+			}
+			r.Basics.Profiles = append(r.Basics.Profiles, Profile{Network: title, Username: subtitle, URL: url})
+		case interop.SectionAwards:
+			r.Awards = append(r.Awards, Award{Title: title, Awarder: subtitle, Date: start, Summary: desc})
+		case interop.SectionCertificates:
+			r.Certificates = append(r.Certificates, Certificate{Name: title, Issuer: subtitle, Date: start, URL: url})
+		case interop.SectionPublications:
+			r.Publications = append(r.Publications, Publication{Name: title, Publisher: subtitle, ReleaseDate: start, URL: url, Summary: desc})
+		case interop.SectionLanguages:
+			r.Languages = append(r.Languages, Language{Language: title, Fluency: item[interop.FieldLevel]})
+		case interop.SectionInterests:
+			r.Interests = append(r.Interests, Interest{Name: title, Keywords: splitKeywords(desc)})
+		case interop.SectionReferences:
+			r.References = append(r.References, Reference{Name: title, Reference: desc})
+		case interop.SectionVolunteer:
+			r.Volunteer = append(r.Volunteer, Volunteer{
+				Organization: title,
+				Position:     subtitle,
+				URL:          url,
+				StartDate:    start,
+				EndDate:      interop.ISODate(item[algorithm.CustomFieldEndTime]),
+				Summary:      desc,
+			})
+		default:
+			// 没有对应部分的模块整体导出
+			r.CustomSections = append(r.CustomSections, s)
+			return
+		}
+	}
+}
+
+// customDate 自定义模块条目的日期，无法识别的日期（导入时写入“日期”字段）原样导出
+func customDate(item map[string]string) string {
+	if d := interop.ISODate(item[algorithm.CustomFieldStartTime]); d != "" {
+		return d
+	}
+	return item[interop.FieldDate]
+}
+
+var (
+	skillPattern     = regexp.MustCompile(`^(.+?)(?:\s*[（(]([^）)]+)[）)])?(?:\s*[:：]\s*(.*))?$`)
+	keywordSeparator = regexp.MustCompile(`\s*[、,，;；/]\s*`)
+)
+
+// parseSkill 解析技能行，如 Go（熟练）：并发编程、性能优化
+func parseSkill(line string) Skill {
+	m := skillPattern.FindStringSubmatch(line)
+	if m == nil {
+		return Skill{Name: line}
+	}
+	return Skill{Name: strings.TrimSpace(m[1]), Level: m[2], Keywords: splitKeywords(m[3])}
+}
+
+// formatSkill 技能行的写法，与 parseSkill 对应
+func formatSkill(name, level string, keywords []string) string {
+	line := name
+	if level != "" {
+		line += "（" + level + "）"
+	}
+	if len(keywords) > 0 {
+		line += "：" + strings.Join(keywords, "、")
+	}
+	return line
+}
+
+func splitKeywords(s string) []string {
+	var result []string
+	for _, k := range keywordSeparator.Split(strings.TrimSpace(s), -1) {
+		if k != "" {
+			result = append(result, k)
+		}
+	}
+	return result
+}
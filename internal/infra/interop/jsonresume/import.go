@@ -0,0 +1,333 @@
+package jsonresume
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/interop"
+)
+
+// ErrInvalidDocument 文件不是 JSON Resume 文档
+var ErrInvalidDocument = errors.New("invalid json resume document")
+
+// Import 解析 JSON Resume 文档
+// basics、work、education、projects、skills 映射到内置模块，awards 等其他部分导入为同名自定义模块，
+// 无法映射的字段放入“其他信息”模块；返回的 Importer 包含转换结果和导入报告
+func Import(b []byte) (*interop.Importer, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+	if m == nil {
+		return nil, ErrInvalidDocument
+	}
+
+	im := interop.NewImporter()
+	root := interop.NewObject("", m)
+	for _, key := range []string{"$schema", "meta"} {
+		if root.Has(key) {
+			im.Report.Ignored(key)
+			root.Skip(key)
+		}
+	}
+
+	importBasics(im, root.Object("basics"))
+	for _, o := range root.List("work") {
+		importWork(im, o)
+	}
+	for _, o := range root.List("volunteer") {
+		importVolunteer(im, o)
+	}
+	for _, o := range root.List("education") {
+		importEducation(im, o)
+	}
+	for _, o := range root.List("projects") {
+		importProject(im, o)
+	}
+	importSkills(im, root.List("skills"))
+	for _, o := range root.List("awards") {
+		item := map[string]string{
+			algorithm.CustomFieldTitle:       o.String("title"),
+			algorithm.CustomFieldSubtitle:    o.String("awarder"),
+			algorithm.CustomFieldDescription: o.String("summary"),
+		}
+		setDate(item, algorithm.CustomFieldStartTime, o.String("date"))
+		addCustom(im, o, interop.SectionAwards, item)
+	}
+	for _, o := range root.List("certificates") {
+		item := map[string]string{
+			algorithm.CustomFieldTitle:    o.String("name"),
+			algorithm.CustomFieldSubtitle: o.String("issuer"),
+			interop.FieldURL:              o.String("url"),
+		}
+		setDate(item, algorithm.CustomFieldStartTime, o.String("date"))
+		addCustom(im, o, interop.SectionCertificates, item)
+	}
+	for _, o := range root.List("publications") {
+		item := map[string]string{
+			algorithm.CustomFieldTitle:       o.String("name"),
+			algorithm.CustomFieldSubtitle:    o.String("publisher"),
+			algorithm.CustomFieldDescription: o.String("summary"),
+			interop.FieldURL:                 o.String("url"),
+		}
+		setDate(item, algorithm.CustomFieldStartTime, o.String("releaseDate"))
+		addCustom(im, o, interop.SectionPublications, item)
+	}
+	for _, o := range root.List("languages") {
+		addCustom(im, o, interop.SectionLanguages, map[string]string{
+			algorithm.CustomFieldTitle: o.String("language"),
+			interop.FieldLevel:         o.String("fluency"),
+		})
+	}
+	for _, o := range root.List("interests") {
+		addCustom(im, o, interop.SectionInterests, map[string]string{
+			algorithm.CustomFieldTitle:       o.String("name"),
+			algorithm.CustomFieldDescription: strings.Join(o.Strings("keywords"), "、"),
+		})
+	}
+	for _, o := range root.List("references") {
+		addCustom(im, o, interop.SectionReferences, map[string]string{
+			algorithm.CustomFieldTitle:       o.String("name"),
+			algorithm.CustomFieldDescription: o.String("reference"),
+		})
+	}
+	for _, o := range root.List("x-customSections") {
+		title := o.String("模块名称")
+		if title == "" {
+			title = interop.OtherSection
+		}
+		for _, item := range o.List("条目") {
+			addCustom(im, item, title, item.Fields())
+		}
+		o.Rest(im)
+	}
+
+	root.Rest(im)
+	return im, nil
+}
+
+// importBasics 基本信息：profiles 和 url 导入为“个人主页”模块
+func importBasics(im *interop.Importer, o *interop.Object) {
+	if o == nil {
+		return
+	}
+	d := im.Data
+	im.Set(&d.Name, o.Path("name"), "姓名", o.String("name"))
+	im.Set(&d.JobTitle, o.Path("label"), "意向岗位", o.String("label"))
+	im.Set(&d.Email, o.Path("email"), "邮箱", o.String("email"))
+	im.Set(&d.Phone, o.Path("phone"), "电话", o.String("phone"))
+	im.Set(&d.SelfEval, o.Path("summary"), "自我评价", o.String("summary"))
+	im.Date(&d.Birthday, o.Path("x-birthday"), "出生日期", o.String("x-birthday"))
+	im.Set(&d.Ethnicity, o.Path("x-ethnicity"), "民族", o.String("x-ethnicity"))
+	im.Set(&d.Politics, o.Path("x-politics"), "政治面貌", o.String("x-politics"))
+	im.Set(&d.TargetCity, o.Path("x-targetCity"), "意向城市", o.String("x-targetCity"))
+	im.Set(&d.MinSalary, o.Path("x-minSalary"), "期望薪资下限", o.String("x-minSalary"))
+	im.Set(&d.MaxSalary, o.Path("x-maxSalary"), "期望薪资上限", o.String("x-maxSalary"))
+	im.Set(&d.JobType, o.Path("x-jobType"), "求职类型", o.String("x-jobType"))
+
+	// 所在地取城市，没有城市时取省份，详细地址等放入“其他信息”
+	if loc := o.Object("location"); loc != nil {
+		key := "city"
+		if loc.String(key) == "" {
+			key = "region"
+		}
+		im.Set(&d.Location, loc.Path(key), "所在地", loc.String(key))
+		loc.Rest(im)
+	}
+
+	if url := o.String("url"); url != "" {
+		im.AddCustom(o.Path("url"), interop.SectionProfiles, map[string]string{
+			algorithm.CustomFieldTitle: interop.ProfileWebsite,
+			interop.FieldURL:           url,
+		})
+	}
+	for _, p := range o.List("profiles") {
+		addCustom(im, p, interop.SectionProfiles, map[string]string{
+			algorithm.CustomFieldTitle:    p.String("network"),
+			algorithm.CustomFieldSubtitle: p.String("username"),
+			interop.FieldURL:              p.String("url"),
+		})
+	}
+	o.Rest(im)
+}
+
+// importWork 工作经历，x-internship 标记的导入为实习经历；没有公司名称的条目放入“其他信息”
+func importWork(im *interop.Importer, o *interop.Object) {
+	d := im.Data
+	key := "name"
+	if !o.Has(key) {
+		key = "company" // 0.x 版本的字段名
+	}
+	if o.String(key) == "" {
+		o.Skip(key)
+		o.Rest(im)
+		return
+	}
+
+	summary := o.String("summary")
+	highlights := o.Strings("highlights")
+	if o.Bool("x-internship") {
+		prefix := interop.Path("实习经历", len(d.InternExp), "")
+		var w algorithm.InternExp
+		im.Set(&w.Company, o.Path(key), prefix+".公司名称", o.String(key))
+		im.Set(&w.Position, o.Path("position"), prefix+".职位", o.String("position"))
+		dates(im, o, prefix, &w.StartTime, &w.EndTime)
+		w.Description = interop.Paragraphs(summary, interop.Bullets(highlights))
+		im.Report.Mapped(o.Path("summary"), prefix+".工作内容", summary)
+		im.Report.Mapped(o.Path("highlights"), prefix+".工作内容", strings.Join(highlights, ""))
+		d.InternExp = append(d.InternExp, w)
+		o.Rest(im)
+		return
+	}
+
+	prefix := interop.Path("工作经历", len(d.WorkExp), "")
+	var w algorithm.WorkExp
+	im.Set(&w.Company, o.Path(key), prefix+".公司名称", o.String(key))
+	im.Set(&w.Title, o.Path("position"), prefix+".职位", o.String("position"))
+	im.Set(&w.Department, o.Path("x-department"), prefix+".部门", o.String("x-department"))
+	im.Set(&w.EmploymentType, o.Path("x-employmentType"), prefix+".工作性质", o.String("x-employmentType"))
+	dates(im, o, prefix, &w.StartTime, &w.EndTime)
+	im.Set(&w.Responsibilities, o.Path("summary"), prefix+".工作职责", summary)
+	im.Set(&w.Achievements, o.Path("highlights"), prefix+".工作业绩", interop.Bullets(highlights))
+	d.WorkExp = append(d.WorkExp, w)
+	o.Rest(im)
+}
+
+// importVolunteer 志愿经历导入为自定义模块，x-campus 标记的导入为在校经历
+func importVolunteer(im *interop.Importer, o *interop.Object) {
+	d := im.Data
+	summary := interop.Paragraphs(o.String("summary"), interop.Bullets(o.Strings("highlights")))
+	if !o.Bool("x-campus") {
+		item := map[string]string{
+			algorithm.CustomFieldTitle:       o.String("organization"),
+			algorithm.CustomFieldSubtitle:    o.String("position"),
+			algorithm.CustomFieldDescription: summary,
+			interop.FieldURL:                 o.String("url"),
+		}
+		setDate(item, algorithm.CustomFieldStartTime, o.String("startDate"))
+		setDate(item, algorithm.CustomFieldEndTime, o.String("endDate"))
+		addCustom(im, o, interop.SectionVolunteer, item)
+		return
+	}
+
+	prefix := interop.Path("在校经历", len(d.CampusExp), "")
+	var c algorithm.CampusExp
+	im.Set(&c.Title, o.Path("organization"), prefix+".经历名称", o.String("organization"))
+	im.Set(&c.Role, o.Path("position"), prefix+".角色", o.String("position"))
+	dates(im, o, prefix, &c.StartTime, &c.EndTime)
+	im.Set(&c.Description, o.Path("summary"), prefix+".经历描述", summary)
+	d.CampusExp = append(d.CampusExp, c)
+	o.Rest(im)
+}
+
+// importEducation 教育经历：无法识别的学位记为“其他”，原写法、成绩和课程写入经历描述
+func importEducation(im *interop.Importer, o *interop.Object) {
+	d := im.Data
+	if o.String("institution") == "" {
+		o.Skip("institution")
+		o.Rest(im)
+		return
+	}
+
+	prefix := interop.Path("教育经历", len(d.Education), "")
+	var e algorithm.EducationExp
+	im.Set(&e.SchoolName, o.Path("institution"), prefix+".学校名称", o.String("institution"))
+	im.Set(&e.Major, o.Path("area"), prefix+".专业", o.String("area"))
+	datesAs(im, o, prefix+".入学时间", prefix+".毕业时间", &e.StartTime, &e.EndTime)
+
+	var studyType string
+	if s := o.String("studyType"); s != "" {
+		degree, ok := interop.NormalizeDegree(s)
+		if !ok {
+			degree, studyType = interop.DegreeOther, s
+		}
+		im.Set(&e.Degree, o.Path("studyType"), prefix+".学历", degree)
+	}
+	courses := strings.Join(o.Strings("courses"), "、")
+	e.Description = interop.Paragraphs(
+		o.String("x-description"),
+		interop.Labeled("学位", studyType),
+		interop.Labeled("成绩", o.String("score")),
+		interop.Labeled("主修课程", courses),
+	)
+	im.Report.Mapped(o.Path("x-description"), prefix+".经历描述", o.String("x-description"))
+	im.Report.Mapped(o.Path("score"), prefix+".经历描述", o.String("score"))
+	im.Report.Mapped(o.Path("courses"), prefix+".经历描述", courses)
+	d.Education = append(d.Education, e)
+	o.Rest(im)
+}
+
+// importProject 项目经历：多个角色用顿号连接，亮点和关键词写入项目描述
+func importProject(im *interop.Importer, o *interop.Object) {
+	d := im.Data
+	if o.String("name") == "" {
+		o.Skip("name")
+		o.Rest(im)
+		return
+	}
+
+	prefix := interop.Path("项目经历", len(d.ProjectExp), "")
+	var p algorithm.ProjectExp
+	im.Set(&p.ProjectName, o.Path("name"), prefix+".项目名称", o.String("name"))
+	im.Set(&p.Role, o.Path("roles"), prefix+".角色", strings.Join(o.Strings("roles"), "、"))
+	dates(im, o, prefix, &p.StartTime, &p.EndTime)
+	highlights := interop.Bullets(o.Strings("highlights"))
+	keywords := strings.Join(o.Strings("keywords"), "、")
+	p.Description = interop.Paragraphs(o.String("description"), highlights, interop.Labeled("关键词", keywords))
+	im.Report.Mapped(o.Path("description"), prefix+".项目描述", o.String("description"))
+	im.Report.Mapped(o.Path("highlights"), prefix+".项目描述", highlights)
+	im.Report.Mapped(o.Path("keywords"), prefix+".项目描述", keywords)
+	d.ProjectExp = append(d.ProjectExp, p)
+	o.Rest(im)
+}
+
+// importSkills 每项技能一行，写法见 formatSkill
+func importSkills(im *interop.Importer, skills []*interop.Object) {
+	var lines []string
+	for _, o := range skills {
+		name := o.String("name")
+		if name == "" {
+			o.Rest(im)
+			continue
+		}
+		lines = append(lines, formatSkill(name, o.String("level"), o.Strings("keywords")))
+		im.Report.Mapped(o.Path("name"), "技能证书", name)
+		o.Rest(im)
+	}
+	im.Data.Skills = strings.Join(lines, "\n")
+}
+
+// dates 导入开始和结束日期（目标字段为 prefix.开始时间、prefix.结束时间），有开始日期而没有结束日期表示至今
+func dates(im *interop.Importer, o *interop.Object, prefix string, start, end *string) {
+	datesAs(im, o, prefix+".开始时间", prefix+".结束时间", start, end)
+}
+
+// datesAs 同 dates，指定目标字段路径
+func datesAs(im *interop.Importer, o *interop.Object, startTarget, endTarget string, start, end *string) {
+	im.Date(start, o.Path("startDate"), startTarget, o.String("startDate"))
+	im.Date(end, o.Path("endDate"), endTarget, o.String("endDate"))
+	if *start != "" && *end == "" && o.String("endDate") == "" {
+		*end = interop.Present
+	}
+}
+
+// addCustom 把条目导入自定义模块，对象中未读取的字段放入“其他信息”
+func addCustom(im *interop.Importer, o *interop.Object, section string, item map[string]string) {
+	im.AddCustom(o.Path(""), section, item)
+	o.Rest(im)
+}
+
+// setDate 写入自定义模块条目的日期，无法识别的写法原样写入“日期”字段
+func setDate(item map[string]string, key, value string) {
+	if value == "" {
+		return
+	}
+	if d, ok := interop.ParseDate(value); ok {
+		item[key] = d.Resume()
+		return
+	}
+	item[interop.FieldDate] = value
+}
@@ -0,0 +1,170 @@
+package jsonresume
+
+import "cv2/internal/infra/algorithm"
+
+// JSON Resume（https://jsonresume.org/schema）v1.0.0 文档结构
+// 没有对应字段的简历内容用 x- 前缀的扩展字段导出，导入时识别这些字段，保证本系统导出的文件可以无损导回
+
+// SchemaURL 导出文档的 $schema
+const SchemaURL = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// Resume JSON Resume 文档
+type Resume struct {
+	Schema       string        `json:"$schema,omitempty"`
+	Basics       Basics        `json:"basics"`
+	Work         []Work        `json:"work,omitempty"`
+	Volunteer    []Volunteer   `json:"volunteer,omitempty"`
+	Education    []Education   `json:"education,omitempty"`
+	Awards       []Award       `json:"awards,omitempty"`
+	Certificates []Certificate `json:"certificates,omitempty"`
+	Publications []Publication `json:"publications,omitempty"`
+	Skills       []Skill       `json:"skills,omitempty"`
+	Languages    []Language    `json:"languages,omitempty"`
+	Interests    []Interest    `json:"interests,omitempty"`
+	References   []Reference   `json:"references,omitempty"`
+	Projects     []Project     `json:"projects,omitempty"`
+
+	// 没有对应部分的自定义模块
+	CustomSections []algorithm.CustomSection `json:"x-customSections,omitempty"`
+}
+
+// Basics 基本信息
+type Basics struct {
+	Name     string    `json:"name,omitempty"`
+	Label    string    `json:"label,omitempty"`
+	Image    string    `json:"image,omitempty"`
+	Email    string    `json:"email,omitempty"`
+	Phone    string    `json:"phone,omitempty"`
+	URL      string    `json:"url,omitempty"`
+	Summary  string    `json:"summary,omitempty"`
+	Location *Location `json:"location,omitempty"`
+	Profiles []Profile `json:"profiles,omitempty"`
+
+	Birthday   string `json:"x-birthday,omitempty"`
+	Ethnicity  string `json:"x-ethnicity,omitempty"`
+	Politics   string `json:"x-politics,omitempty"`
+	TargetCity string `json:"x-targetCity,omitempty"`
+	MinSalary  string `json:"x-minSalary,omitempty"`
+	MaxSalary  string `json:"x-maxSalary,omitempty"`
+	JobType    string `json:"x-jobType,omitempty"`
+}
+
+// Location 所在地
+type Location struct {
+	Address     string `json:"address,omitempty"`
+	PostalCode  string `json:"postalCode,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+// Profile 社交账号
+type Profile struct {
+	Network  string `json:"network,omitempty"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// Work 工作经历，实习经历以 x-internship 标记
+type Work struct {
+	Name       string   `json:"name,omitempty"`
+	Position   string   `json:"position,omitempty"`
+	URL        string   `json:"url,omitempty"`
+	StartDate  string   `json:"startDate,omitempty"`
+	EndDate    string   `json:"endDate,omitempty"`
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights,omitempty"`
+
+	Department     string `json:"x-department,omitempty"`
+	EmploymentType string `json:"x-employmentType,omitempty"`
+	Internship     bool   `json:"x-internship,omitempty"`
+}
+
+// Volunteer 志愿经历，在校经历以 x-campus 标记
+type Volunteer struct {
+	Organization string   `json:"organization,omitempty"`
+	Position     string   `json:"position,omitempty"`
+	URL          string   `json:"url,omitempty"`
+	StartDate    string   `json:"startDate,omitempty"`
+	EndDate      string   `json:"endDate,omitempty"`
+	Summary      string   `json:"summary,omitempty"`
+	Highlights   []string `json:"highlights,omitempty"`
+
+	Campus bool `json:"x-campus,omitempty"`
+}
+
+// Education 教育经历
+type Education struct {
+	Institution string   `json:"institution,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Area        string   `json:"area,omitempty"`
+	StudyType   string   `json:"studyType,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Score       string   `json:"score,omitempty"`
+	Courses     []string `json:"courses,omitempty"`
+
+	Description string `json:"x-description,omitempty"`
+}
+
+// Award 获奖经历
+type Award struct {
+	Title   string `json:"title,omitempty"`
+	Date    string `json:"date,omitempty"`
+	Awarder string `json:"awarder,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// Certificate 证书
+type Certificate struct {
+	Name   string `json:"name,omitempty"`
+	Date   string `json:"date,omitempty"`
+	Issuer string `json:"issuer,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+// Publication 出版物
+type Publication struct {
+	Name        string `json:"name,omitempty"`
+	Publisher   string `json:"publisher,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Summary     string `json:"summary,omitempty"`
+}
+
+// Skill 技能
+type Skill struct {
+	Name     string   `json:"name,omitempty"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// Language 语言
+type Language struct {
+	Language string `json:"language,omitempty"`
+	Fluency  string `json:"fluency,omitempty"`
+}
+
+// Interest 兴趣爱好
+type Interest struct {
+	Name     string   `json:"name,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// Reference 推荐人
+type Reference struct {
+	Name      string `json:"name,omitempty"`
+	Reference string `json:"reference,omitempty"`
+}
+
+// Project 项目经历
+type Project struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+}
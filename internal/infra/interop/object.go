@@ -0,0 +1,177 @@
+package interop
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Object JSON 对象的读取器，记录读取过的字段，未读取的字段由 Rest 放入“其他信息”
+type Object struct {
+	path  string
+	m     map[string]interface{}
+	used  map[string]bool
+	stray []stray // 数组中无法按预期类型读取的元素
+}
+
+type stray struct{ path, value string }
+
+// NewObject 包装解码后的 JSON 对象，path 为对象在来源文档中的路径（根对象为空）
+func NewObject(path string, m map[string]interface{}) *Object {
+	return &Object{path: path, m: m, used: make(map[string]bool)}
+}
+
+// Path 字段在来源文档中的路径，key 为空时返回对象自身的路径
+func (o *Object) Path(key string) string {
+	if key == "" {
+		return o.path
+	}
+	if o.path == "" {
+		return key
+	}
+	return o.path + "." + key
+}
+
+// Has 字段是否存在
+func (o *Object) Has(key string) bool {
+	_, ok := o.m[key]
+	return ok
+}
+
+// Skip 标记字段已处理
+func (o *Object) Skip(keys ...string) {
+	for _, k := range keys {
+		o.used[k] = true
+	}
+}
+
+// String 读取字符串字段，数字和布尔值转为字符串，其他类型返回空字符串且不标记为已读取
+func (o *Object) String(key string) string {
+	switch v := o.m[key].(type) {
+	case nil:
+		o.used[key] = true
+		return ""
+	case string:
+		o.used[key] = true
+		return strings.TrimSpace(v)
+	case float64, bool, json.Number:
+		o.used[key] = true
+		return fmt.Sprint(v)
+	}
+	return ""
+}
+
+// Bool 读取布尔字段
+func (o *Object) Bool(key string) bool {
+	v, ok := o.m[key].(bool)
+	if ok || o.m[key] == nil {
+		o.used[key] = true
+	}
+	return v
+}
+
+// Fields 读取全部字符串、数字和布尔字段（key 为原字段名），其他类型的字段由 Rest 处理
+func (o *Object) Fields() map[string]string {
+	fields := make(map[string]string, len(o.m))
+	for k, v := range o.m {
+		switch v.(type) {
+		case nil, string, float64, bool, json.Number:
+			fields[k] = o.String(k)
+		}
+	}
+	return fields
+}
+
+// Strings 读取字符串数组字段，非字符串元素忽略
+func (o *Object) Strings(key string) []string {
+	list, ok := o.m[key].([]interface{})
+	if !ok {
+		if o.m[key] == nil {
+			o.used[key] = true
+		}
+		return nil
+	}
+	o.used[key] = true
+	var result []string
+	for _, v := range list {
+		if s, ok := v.(string); ok && strings.TrimSpace(s) != "" {
+			result = append(result, strings.TrimSpace(s))
+		}
+	}
+	return result
+}
+
+// Object 读取子对象，不存在或不是对象时返回 nil
+func (o *Object) Object(key string) *Object {
+	m, ok := o.m[key].(map[string]interface{})
+	if !ok {
+		if o.m[key] == nil {
+			o.used[key] = true
+		}
+		return nil
+	}
+	o.used[key] = true
+	return NewObject(o.Path(key), m)
+}
+
+// List 读取对象数组，非对象元素由 Rest 放入“其他信息”
+func (o *Object) List(key string) []*Object {
+	list, ok := o.m[key].([]interface{})
+	if !ok {
+		if o.m[key] == nil {
+			o.used[key] = true
+		}
+		return nil
+	}
+	o.used[key] = true
+	var result []*Object
+	for i, v := range list {
+		path := fmt.Sprintf("%s[%d]", o.Path(key), i)
+		if m, ok := v.(map[string]interface{}); ok {
+			result = append(result, NewObject(path, m))
+		} else if s := display(v); s != "" {
+			o.stray = append(o.stray, stray{path, s})
+		}
+	}
+	return result
+}
+
+// Rest 把未读取的非空字段放入“其他信息”，值为字符串或 JSON
+func (o *Object) Rest(im *Importer) {
+	keys := make([]string, 0, len(o.m))
+	for k := range o.m {
+		if !o.used[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v := display(o.m[k]); v != "" {
+			im.Other(o.Path(k), v)
+		}
+	}
+	for _, s := range o.stray {
+		im.Other(s.path, s.value)
+	}
+}
+
+// display 字段值的显示文本，空值返回空字符串
+func display(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case []interface{}:
+		if len(v) == 0 {
+			return ""
+		}
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return ""
+		}
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
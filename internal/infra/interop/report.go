@@ -0,0 +1,63 @@
+package interop
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 第三方简历格式（JSON Resume、Europass 等）与 algorithm.ResumeData 的互转
+// 各格式的转换器在子包中实现，本包提供导入报告、日期转换等公共部分；
+// 导入时无法对应到内置模块的字段不丢弃，放入自定义模块，并在报告中说明
+
+// 字段映射结果
+const (
+	KindMapped  = "mapped"  // 映射到内置模块字段
+	KindCustom  = "custom"  // 放入自定义模块
+	KindIgnored = "ignored" // 格式元信息（如 $schema），不保存
+)
+
+// OtherSection 零散的无法映射字段统一放入的自定义模块
+const OtherSection = "其他信息"
+
+// Mapping 单个来源字段的去向
+type Mapping struct {
+	Source string `json:"source"` // 来源字段路径，如 work[0].name
+	Target string `json:"target"` // 目标字段路径，如 工作经历[0].公司名称
+	Kind   string `json:"kind"`   // mapped / custom / ignored
+}
+
+// Report 导入报告
+type Report struct {
+	Mappings []Mapping
+}
+
+// Mapped 记录映射到内置模块的字段，value 为空时不记录
+func (r *Report) Mapped(source, target, value string) {
+	if strings.TrimSpace(value) == "" {
+		return
+	}
+	r.Mappings = append(r.Mappings, Mapping{Source: source, Target: target, Kind: KindMapped})
+}
+
+// Custom 记录放入自定义模块的字段
+func (r *Report) Custom(source, section string, index int) {
+	r.Mappings = append(r.Mappings, Mapping{
+		Source: source,
+		Target: fmt.Sprintf("自定义模块「%s」[%d]", section, index),
+		Kind:   KindCustom,
+	})
+}
+
+// Ignored 记录不保存的字段
+func (r *Report) Ignored(source string) {
+	r.Mappings = append(r.Mappings, Mapping{Source: source, Kind: KindIgnored})
+}
+
+// Path 拼接带下标的字段路径，如 Path("work", 0, "name") = work[0].name
+func Path(list string, index int, field string) string {
+	p := fmt.Sprintf("%s[%d]", list, index)
+	if field != "" {
+		p += "." + field
+	}
+	return p
+}
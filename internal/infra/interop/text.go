@@ -0,0 +1,101 @@
+package interop
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// 模块标题：外部格式中没有对应内置模块的部分导入为这些自定义模块，导出时按标题识别
+const (
+	SectionProfiles     = "个人主页"
+	SectionAwards       = "获奖经历"
+	SectionCertificates = "证书"
+	SectionLanguages    = "语言能力"
+	SectionPublications = "出版物"
+	SectionVolunteer    = "志愿经历"
+	SectionInterests    = "兴趣爱好"
+	SectionReferences   = "推荐人"
+)
+
+// ProfileWebsite 个人网站在“个人主页”模块中的名称
+const ProfileWebsite = "个人网站"
+
+// 自定义模块条目中没有对应 schema 字段的显示名
+const (
+	FieldURL   = "链接"
+	FieldLevel = "水平"
+	FieldDate  = "日期" // 无法识别为日期的原始写法
+)
+
+var bulletPrefix = regexp.MustCompile(`^(?:[-*+•·●▪]|\d+[.)、])\s*`)
+
+// Lines 把多行文本拆为条目，去掉空行和列表符号
+func Lines(s string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		line = strings.TrimSpace(bulletPrefix.ReplaceAllString(strings.TrimSpace(line), ""))
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// Paragraphs 拼接非空段落，段落之间换行
+func Paragraphs(parts ...string) string {
+	var result []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			result = append(result, p)
+		}
+	}
+	return strings.Join(result, "\n")
+}
+
+// Bullets 把条目拼接为列表文本，每行以 “- ” 开头
+func Bullets(items []string) string {
+	var b strings.Builder
+	for _, item := range items {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("- " + item)
+	}
+	return b.String()
+}
+
+// Labeled 带前缀的段落，如 主修课程：数据结构、操作系统，内容为空时返回空字符串
+func Labeled(label, text string) string {
+	if strings.TrimSpace(text) == "" {
+		return ""
+	}
+	return label + "：" + strings.TrimSpace(text)
+}
+
+var (
+	htmlBreak  = regexp.MustCompile(`(?i)<br\s*/?>|</(?:p|div|li|h[1-6])>`)
+	htmlItem   = regexp.MustCompile(`(?i)<li[^>]*>`)
+	htmlTag    = regexp.MustCompile(`<[^>]*>`)
+	blankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+// HTMLText 富文本（HTML 片段）转纯文本：段落和换行转为换行，列表项以 “- ” 开头
+func HTMLText(s string) string {
+	if !strings.Contains(s, "<") {
+		return strings.TrimSpace(html.UnescapeString(s))
+	}
+	s = htmlBreak.ReplaceAllString(s, "\n")
+	s = htmlItem.ReplaceAllString(s, "- ")
+	s = html.UnescapeString(htmlTag.ReplaceAllString(s, ""))
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+	}
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+// TextHTML 纯文本转富文本：转义并把换行转为 <br/>
+func TextHTML(s string) string {
+	return strings.ReplaceAll(html.EscapeString(strings.TrimSpace(s)), "\n", "<br/>")
+}
@@ -0,0 +1,106 @@
+package markdown
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"cv2/internal/infra/algorithm"
+)
+
+// 本地简历 Markdown 渲染
+// 模板位于 templates/ 目录并嵌入二进制，数据为 algorithm.ResumeData（含自定义模块），空字段和空模块不输出
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var resumeTemplate = template.Must(template.New("resume.md.tmpl").
+	Funcs(template.FuncMap{
+		"join":       join,
+		"label":      label,
+		"period":     period,
+		"salary":     salary,
+		"customItem": customItem,
+	}).
+	ParseFS(templateFS, "templates/resume.md.tmpl"))
+
+// blankLines 连续空行
+var blankLines = regexp.MustCompile(`\n{3,}`)
+
+// Render 把结构化简历渲染为 Markdown
+func Render(data *algorithm.ResumeData) (string, error) {
+	var buf bytes.Buffer
+	if err := resumeTemplate.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("execute resume template: %w", err)
+	}
+	return strings.TrimSpace(blankLines.ReplaceAllString(buf.String(), "\n\n")) + "\n", nil
+}
+
+// join 用 sep 连接非空字符串
+func join(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// label 输出 “名称：值”，值为空时返回空字符串
+func label(name, value string) string {
+	if strings.TrimSpace(value) == "" {
+		return ""
+	}
+	return name + "：" + strings.TrimSpace(value)
+}
+
+// period 输出时间段，如 2020.09 - 2024.06
+func period(start, end string) string {
+	return join(" - ", start, end)
+}
+
+// salary 输出期望薪资范围
+func salary(min, max string) string {
+	return join(" - ", min, max)
+}
+
+// customEntry 自定义模块条目的展示结构
+type customEntry struct {
+	Title       string
+	Subtitle    string
+	Period      string
+	Description string
+	Extra       []customField // 常用字段以外的字段，按名称排序
+}
+
+type customField struct {
+	Label string
+	Value string
+}
+
+// customItem 把自定义模块的自由结构条目整理为固定的展示结构
+func customItem(item map[string]string) customEntry {
+	entry := customEntry{
+		Title:       item[algorithm.CustomFieldTitle],
+		Subtitle:    item[algorithm.CustomFieldSubtitle],
+		Period:      period(item[algorithm.CustomFieldStartTime], item[algorithm.CustomFieldEndTime]),
+		Description: item[algorithm.CustomFieldDescription],
+	}
+	for k, v := range item {
+		switch k {
+		case algorithm.CustomFieldTitle, algorithm.CustomFieldSubtitle, algorithm.CustomFieldStartTime,
+			algorithm.CustomFieldEndTime, algorithm.CustomFieldDescription:
+			continue
+		}
+		if strings.TrimSpace(v) != "" {
+			entry.Extra = append(entry.Extra, customField{Label: k, Value: v})
+		}
+	}
+	sort.Slice(entry.Extra, func(i, j int) bool { return entry.Extra[i].Label < entry.Extra[j].Label })
+	return entry
+}
@@ -0,0 +1,42 @@
+package markdown
+
+import (
+	"context"
+
+	"cv2/internal/infra/algorithm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	ModeLocal  = "local"  // 本地模板渲染
+	ModeRemote = "remote" // 调用算法服务渲染，失败时回退到本地模板
+)
+
+// Renderer 简历 Markdown 渲染器，按配置选择本地模板或算法服务
+type Renderer struct {
+	algorithm *algorithm.Client
+	mode      string
+}
+
+// NewRenderer 创建渲染器，mode 为 ModeLocal 或 ModeRemote
+func NewRenderer(algClient *algorithm.Client, mode string) *Renderer {
+	return &Renderer{
+		algorithm: algClient,
+		mode:      mode,
+	}
+}
+
+// Render 渲染简历 Markdown，title 为算法服务使用的文档标题
+func (r *Renderer) Render(ctx context.Context, data *algorithm.ResumeData, title string) (string, error) {
+	if r.mode == ModeLocal {
+		return Render(data)
+	}
+
+	md, err := r.algorithm.Struct2Markdown(ctx, data, title)
+	if err == nil {
+		return md, nil
+	}
+	logx.WithContext(ctx).Errorf("remote struct to markdown failed, fallback to local template: %v", err)
+	return Render(data)
+}
@@ -6,7 +6,12 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -57,14 +62,34 @@ func (c *Client) GetPresignedUploadURL(ctx context.Context, objectName string, e
 }
 
 // GetPresignedDownloadURL 获取预签名下载 URL
-func (c *Client) GetPresignedDownloadURL(ctx context.Context, objectName string, expires time.Duration) (string, error) {
-	presignedURL, err := c.client.PresignedGetObject(ctx, c.bucketName, objectName, expires, nil)
+// filename 不为空时通过 response-content-disposition 指定下载文件名
+func (c *Client) GetPresignedDownloadURL(ctx context.Context, objectName string, expires time.Duration, filename string) (string, error) {
+	var reqParams url.Values
+	if filename != "" {
+		reqParams = url.Values{}
+		reqParams.Set("response-content-disposition", ContentDisposition(filename))
+	}
+	presignedURL, err := c.client.PresignedGetObject(ctx, c.bucketName, objectName, expires, reqParams)
 	if err != nil {
 		return "", err
 	}
 	return presignedURL.String(), nil
 }
 
+// ContentDisposition 生成附件下载的 Content-Disposition（RFC 6266）
+// filename* 为 UTF-8 编码的原始文件名；filename 为旧客户端使用的 ASCII 回退名，含非 ASCII 字符时为 resume+扩展名
+func ContentDisposition(filename string) string {
+	fallback := filename
+	for _, r := range filename {
+		if r > unicode.MaxASCII || r < 0x20 || r == '"' || r == '\\' {
+			fallback = "resume" + path.Ext(filename)
+			break
+		}
+	}
+	return fmt.Sprintf(`attachment; filename="%s"; filename*=UTF-8''%s`,
+		fallback, strings.ReplaceAll(url.QueryEscape(filename), "+", "%20"))
+}
+
 // GetPresignedPostPolicy 获取预签名 POST policy（用于表单上传）
 func (c *Client) GetPresignedPostPolicy(ctx context.Context, objectName string, expires time.Duration, maxFileSize int64) (*minio.PostPolicy, map[string]string, error) {
 	policy := minio.NewPostPolicy()
@@ -140,6 +165,77 @@ func (c *Client) GetPublicURL(objectName string) string {
 	return fmt.Sprintf("%s/%s/%s", c.client.EndpointURL().String(), c.bucketName, objectName)
 }
 
+// Download 下载对象内容，超过 maxSize 字节时返回错误
+func (c *Client) Download(ctx context.Context, objectName string, maxSize int64) ([]byte, error) {
+	obj, err := c.client.GetObject(ctx, c.bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	data, err := io.ReadAll(io.LimitReader(obj, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("object %s exceeds %d bytes", objectName, maxSize)
+	}
+	return data, nil
+}
+
+// ObjectExists 对象是否存在
+func (c *Client) ObjectExists(ctx context.Context, objectName string) (bool, error) {
+	_, err := c.client.StatObject(ctx, c.bucketName, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// RemoveObject 删除对象
+func (c *Client) RemoveObject(ctx context.Context, objectName string) error {
+	return c.client.RemoveObject(ctx, c.bucketName, objectName, minio.RemoveObjectOptions{})
+}
+
+// ListObjectKeys 列出指定前缀下的全部 object key（含子目录）
+func (c *Client) ListObjectKeys(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	for obj := range c.client.ListObjects(ctx, c.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		keys = append(keys, obj.Key)
+	}
+	return keys, nil
+}
+
+// RemovePrefix 删除指定前缀下的全部对象
+func (c *Client) RemovePrefix(ctx context.Context, prefix string) error {
+	keys, err := c.ListObjectKeys(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := c.RemoveObject(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ObjectKeyFromURL 从 GetPublicURL 生成的地址中解析 object key
+// 不属于当前 bucket 的地址返回 false
+func (c *Client) ObjectKeyFromURL(fileURL string) (string, bool) {
+	prefix := c.GetPublicURL("")
+	if !strings.HasPrefix(fileURL, prefix) || len(fileURL) == len(prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(fileURL, prefix), true
+}
+
 // getContentType 根据文件名获取 Content-Type
 func getContentType(filename string) string {
 	switch {
@@ -160,6 +256,38 @@ func getContentType(filename string) string {
 	}
 }
 
+// renderKeyPrefix 简历重新渲染生成的文件前缀
+const renderKeyPrefix = "renders/"
+
+// RenderObjectKey 生成简历渲染文件的 object key，按内容版本号区分，新版本不会覆盖旧文件
+// name 区分同一版本的不同文件，如 classic.pdf、resume.md
+func RenderObjectKey(resumeID, revision int64, name string) string {
+	return fmt.Sprintf("%s%d/%d/%s", renderKeyPrefix, resumeID, revision, name)
+}
+
+// RenderPrefix 简历全部渲染文件（各版本的 PDF 及导出文件）的公共前缀 renders/{resumeID}/
+func RenderPrefix(resumeID int64) string {
+	return fmt.Sprintf("%s%d/", renderKeyPrefix, resumeID)
+}
+
+// RenderObjectRevision 解析渲染文件 object key 中的内容版本号，不是渲染文件时返回 false
+func RenderObjectRevision(objectName string) (int64, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(objectName, renderKeyPrefix), "/", 3)
+	if !IsRenderObjectKey(objectName) || len(parts) != 3 {
+		return 0, false
+	}
+	revision, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return revision, true
+}
+
+// IsRenderObjectKey 是否为简历渲染生成的文件（区别于用户上传的原始文件）
+func IsRenderObjectKey(objectName string) bool {
+	return strings.HasPrefix(objectName, renderKeyPrefix)
+}
+
 // GenerateObjectKey 生成 MinIO object key
 func GenerateObjectKey(filename string) string {
 	now := time.Now()
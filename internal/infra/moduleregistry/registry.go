@@ -0,0 +1,318 @@
+package moduleregistry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/dimension"
+	"cv2/internal/infra/ent/module"
+	"cv2/internal/pkg/jsonschema"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// reloadInterval 定期重新加载的间隔
+const reloadInterval = 5 * time.Minute
+
+// 内置模块编码
+// 代码中只按编码识别模块，模块ID和标题以数据库为准
+const (
+	CodeBasicInfo = "basic_info"      // 基本信息
+	CodeEducation = "education"       // 教育经历
+	CodeCampus    = "campus"          // 在校经历
+	CodeIntern    = "internship"      // 实习经历
+	CodeProject   = "project"         // 项目经历
+	CodeSkills    = "skills"          // 技能证书
+	CodeSelfEval  = "self_evaluation" // 自我评价
+	CodeWork      = "work"            // 工作经历
+	CodeCustom    = "custom"          // 通用模块，为用户自定义模块提供评分规则、维度和 schema
+)
+
+// 模块数据形态
+const (
+	ShapeObject = string(module.ShapeObject) // 单个对象，取 data[0]
+	ShapeList   = string(module.ShapeList)   // 条目列表
+	ShapeText   = string(module.ShapeText)   // 纯文本，取 data[0]["content"]
+)
+
+// builtin 内置模块的默认形态和历史标题，用于给没有编码的旧数据补编码
+var builtin = []struct {
+	code   string
+	shape  module.Shape
+	titles []string
+}{
+	{CodeBasicInfo, module.ShapeObject, []string{"基本信息"}},
+	{CodeEducation, module.ShapeList, []string{"教育经历", "教育背景"}},
+	{CodeCampus, module.ShapeList, []string{"在校经历"}},
+	{CodeIntern, module.ShapeList, []string{"实习经历"}},
+	{CodeProject, module.ShapeList, []string{"项目经历", "项目经验"}},
+	{CodeSkills, module.ShapeText, []string{"技能证书"}},
+	{CodeSelfEval, module.ShapeText, []string{"自我评价"}},
+	{CodeWork, module.ShapeList, []string{"工作经历", "工作经验"}},
+	{CodeCustom, module.ShapeList, []string{"自定义模块"}},
+}
+
+// Module 模块注册信息
+type Module struct {
+	ID           int64
+	Code         string
+	Title        string
+	Shape        string
+	Schema       *jsonschema.Schema // 数据校验规则，为空时不校验
+	RuleModuleID int64              // 评分规则和维度所属的模块ID，自定义模块为通用模块ID，其余为自身ID
+	Weight       float64            // 模块在简历总分中的权重，自定义模块沿用通用模块的权重
+}
+
+// IsCustom 是否为用户自定义模块
+func (m Module) IsCustom() bool {
+	return m.ID != m.RuleModuleID
+}
+
+// Validate 按模块的 JSON Schema 校验模块数据，pointer 为错误路径前缀
+func (m Module) Validate(data []map[string]interface{}, pointer string) jsonschema.Error {
+	if m.Schema == nil {
+		return nil
+	}
+	return m.Schema.Validate(data, pointer)
+}
+
+// Registry 模块注册表，启动时从数据库加载并缓存在内存中
+type Registry struct {
+	client *ent.Client
+
+	mu          sync.RWMutex
+	byID        map[int64]Module
+	byCode      map[string]Module
+	ruleVersion string
+}
+
+// Load 创建并加载模块注册表
+func Load(ctx context.Context, client *ent.Client) (*Registry, error) {
+	r := &Registry{client: client}
+	if err := r.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload 从数据库重新加载模块，没有编码的内置模块按标题补写编码和形态，没有 schema 的补写默认 schema
+func (r *Registry) Reload(ctx context.Context) error {
+	rows, err := r.client.Module.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("query modules: %w", err)
+	}
+
+	byID := make(map[int64]Module, len(rows))
+	byCode := make(map[string]Module, len(rows))
+	used := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		if row.Code != nil {
+			used[*row.Code] = true
+		}
+	}
+
+	for _, row := range rows {
+		if row.Code == nil {
+			if err := r.backfill(ctx, row, used); err != nil {
+				return err
+			}
+		}
+		if len(row.DataSchema) == 0 && row.Code != nil {
+			if err := r.backfillSchema(ctx, row); err != nil {
+				return err
+			}
+		}
+
+		m := newModule(ctx, row)
+		if m.Code != "" {
+			byCode[m.Code] = m
+		}
+		byID[m.ID] = m
+	}
+
+	// 创建缺少的种子模块（工作经历、通用模块）及其默认评分维度
+	// 多实例同时启动时可能因编码唯一约束失败，只记录日志，下次加载时会读到其他实例创建的模块
+	created, err := r.seedModules(ctx, byCode)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("seed modules failed: %v", err)
+	}
+	for _, row := range created {
+		m := newModule(ctx, row)
+		byID[m.ID], byCode[m.Code] = m, m
+		logx.WithContext(ctx).Infof("module seeded: id=%d, code=%s", m.ID, m.Code)
+	}
+
+	for _, b := range builtin {
+		if _, ok := byCode[b.code]; !ok {
+			logx.WithContext(ctx).Errorf("builtin module not found in db: code=%s", b.code)
+		}
+	}
+
+	version, err := r.loadRuleVersion(ctx, byID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.byID, r.byCode, r.ruleVersion = byID, byCode, version
+	r.mu.Unlock()
+	return nil
+}
+
+// loadRuleVersion 计算评分规则版本：模块权重和全部维度（标题、权重、判定项）的指纹
+func (r *Registry) loadRuleVersion(ctx context.Context, byID map[int64]Module) (string, error) {
+	dims, err := r.client.Dimension.Query().
+		Order(ent.Asc(dimension.FieldID)).
+		All(ctx)
+	if err != nil {
+		return "", fmt.Errorf("query dimensions: %w", err)
+	}
+
+	ids := make([]int64, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	h := sha256.New()
+	for _, id := range ids {
+		fmt.Fprintf(h, "m:%d:%g\n", id, byID[id].Weight)
+	}
+	for _, d := range dims {
+		// json 序列化 map 时按键排序，结果稳定
+		judgment, _ := json.Marshal(d.Judgment)
+		fmt.Fprintf(h, "d:%d:%d:%q:%g:%s\n", d.ID, d.ModuleID, d.Title, d.Weight, judgment)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:6]), nil
+}
+
+// newModule 由模块记录构造注册信息
+func newModule(ctx context.Context, row *ent.Module) Module {
+	m := Module{ID: row.ID, Title: row.Title, Shape: string(row.Shape), RuleModuleID: row.ID, Weight: row.Weight}
+	if row.Code != nil {
+		m.Code = *row.Code
+	}
+	if len(row.DataSchema) > 0 {
+		// schema 有误时只记录日志，不影响其他模块加载
+		schema, err := jsonschema.Compile(row.DataSchema)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("compile module data schema failed: id=%d, err=%v", row.ID, err)
+		}
+		m.Schema = schema
+	}
+	return m
+}
+
+// backfill 按历史标题为内置模块补写编码和形态
+func (r *Registry) backfill(ctx context.Context, row *ent.Module, used map[string]bool) error {
+	for _, b := range builtin {
+		if used[b.code] || !containsTitle(b.titles, row.Title) {
+			continue
+		}
+		updated, err := r.client.Module.UpdateOne(row).
+			SetCode(b.code).
+			SetShape(b.shape).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("backfill module code: id=%d, code=%s: %w", row.ID, b.code, err)
+		}
+		*row = *updated
+		used[b.code] = true
+		logx.WithContext(ctx).Infof("module code backfilled: id=%d, title=%s, code=%s", row.ID, row.Title, b.code)
+		return nil
+	}
+	return nil
+}
+
+// backfillSchema 为内置模块补写默认的数据 schema
+func (r *Registry) backfillSchema(ctx context.Context, row *ent.Module) error {
+	schema := defaultSchema(*row.Code)
+	if schema == nil {
+		return nil
+	}
+	updated, err := r.client.Module.UpdateOne(row).
+		SetDataSchema(schema).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("backfill module data schema: id=%d, code=%s: %w", row.ID, *row.Code, err)
+	}
+	*row = *updated
+	logx.WithContext(ctx).Infof("module data schema backfilled: id=%d, code=%s", row.ID, *row.Code)
+	return nil
+}
+
+// StartAutoReload 定期重新加载，使数据库中修改的标题等信息无需重启即可生效
+func (r *Registry) StartAutoReload() {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(reloadInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := r.Reload(context.Background()); err != nil {
+				logx.Errorf("reload module registry failed: %v", err)
+			}
+		}
+	})
+}
+
+// ByID 按模块ID查找
+func (r *Registry) ByID(id int64) (Module, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	m, ok := r.byID[id]
+	return m, ok
+}
+
+// ByCode 按模块编码查找
+func (r *Registry) ByCode(code string) (Module, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	m, ok := r.byCode[code]
+	return m, ok
+}
+
+// Custom 返回简历中自定义模块的注册信息
+// 评分规则、维度、权重、形态和 schema 沿用通用模块，ID 和标题为自定义模块自身的
+func (r *Registry) Custom(id int64, title string) (Module, bool) {
+	m, ok := r.ByCode(CodeCustom)
+	if !ok {
+		return Module{}, false
+	}
+	m.ID, m.Title = id, title
+	return m, true
+}
+
+// RuleVersion 返回评分规则版本，模块权重或维度规则变化后（重新加载时）改变
+func (r *Registry) RuleVersion() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.ruleVersion
+}
+
+// All 返回全部模块（按ID升序）
+func (r *Registry) All() []Module {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make([]Module, 0, len(r.byID))
+	for _, m := range r.byID {
+		result = append(result, m)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+func containsTitle(titles []string, title string) bool {
+	for _, t := range titles {
+		if t == title {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package moduleregistry
+
+// 内置模块的默认数据 JSON Schema
+// 数据库中 data_schema 为空时按编码补写，之后以数据库为准，可直接修改数据库调整规则
+// 字段名需与 mongo.BuildModules 写入的字段保持一致，item_id 在校验前去除
+
+// 日期字段统一使用 resume-date，兼容 2020-09、2020.09、至今 等写法
+var dateField = map[string]interface{}{"type": "string", "format": "resume-date"}
+
+// employmentTypes 工作性质取值，空字符串表示未填写
+var employmentTypes = []interface{}{"", "全职", "兼职", "合同工", "劳务派遣", "外包", "自由职业", "其他"}
+
+// degrees 学历取值，空字符串表示未填写
+var degrees = []interface{}{"", "初中", "高中", "中专", "大专", "专科", "本科", "学士", "硕士", "研究生", "博士", "MBA", "其他"}
+
+func stringField(maxLength int) map[string]interface{} {
+	return map[string]interface{}{"type": "string", "maxLength": maxLength}
+}
+
+// listSchema 构造条目列表形态的 schema
+func listSchema(maxItems int, required []string, properties map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "array",
+		"maxItems": maxItems,
+		"items": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required":             required,
+			"properties":           properties,
+		},
+	}
+}
+
+// defaultSchema 返回内置模块的默认 schema，非内置编码返回 nil
+func defaultSchema(code string) map[string]interface{} {
+	switch code {
+	case CodeBasicInfo:
+		return listSchema(1, []string{"name"}, map[string]interface{}{
+			"name":        stringField(50),
+			"phone":       map[string]interface{}{"type": "string", "maxLength": 30, "pattern": `^[0-9+\-() ]+$`},
+			"email":       map[string]interface{}{"type": "string", "maxLength": 100, "format": "email"},
+			"job_title":   stringField(50),
+			"birthday":    dateField,
+			"ethnicity":   stringField(20),
+			"politics":    stringField(20),
+			"location":    stringField(50),
+			"target_city": stringField(50),
+			"min_salary":  stringField(20),
+			"max_salary":  stringField(20),
+			"job_type":    stringField(20),
+			"photo":       stringField(500),
+		})
+	case CodeEducation:
+		return listSchema(10, []string{"school_name"}, map[string]interface{}{
+			"school_name": stringField(100),
+			"degree":      map[string]interface{}{"type": "string", "enum": degrees},
+			"major":       stringField(100),
+			"start_time":  dateField,
+			"end_time":    dateField,
+			"description": stringField(2000),
+		})
+	case CodeCampus:
+		return listSchema(20, []string{"title"}, map[string]interface{}{
+			"title":       stringField(100),
+			"role":        stringField(50),
+			"start_time":  dateField,
+			"end_time":    dateField,
+			"description": stringField(2000),
+		})
+	case CodeIntern:
+		return listSchema(20, []string{"company"}, map[string]interface{}{
+			"company":     stringField(100),
+			"position":    stringField(50),
+			"start_time":  dateField,
+			"end_time":    dateField,
+			"description": stringField(2000),
+		})
+	case CodeProject:
+		return listSchema(20, []string{"project_name"}, map[string]interface{}{
+			"project_name": stringField(100),
+			"role":         stringField(50),
+			"start_time":   dateField,
+			"end_time":     dateField,
+			"description":  stringField(2000),
+		})
+	case CodeWork:
+		return listSchema(20, []string{"company"}, map[string]interface{}{
+			"company":          stringField(100),
+			"title":            stringField(50),
+			"department":       stringField(50),
+			"employment_type":  map[string]interface{}{"type": "string", "enum": employmentTypes},
+			"start_time":       dateField,
+			"end_time":         dateField,
+			"responsibilities": stringField(2000),
+			"achievements":     stringField(2000),
+		})
+	case CodeSkills, CodeSelfEval:
+		return listSchema(1, []string{"content"}, map[string]interface{}{
+			"content": stringField(5000),
+		})
+	case CodeCustom:
+		// 自定义模块的条目为自由结构，只约束常用字段，允许其他字段
+		return map[string]interface{}{
+			"type":     "array",
+			"maxItems": 50,
+			"items": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"title":       stringField(100),
+					"subtitle":    stringField(100),
+					"start_time":  dateField,
+					"end_time":    dateField,
+					"description": stringField(2000),
+				},
+			},
+		}
+	}
+	return nil
+}
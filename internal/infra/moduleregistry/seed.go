@@ -0,0 +1,121 @@
+package moduleregistry
+
+import (
+	"context"
+	"fmt"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/module"
+)
+
+// seeds 模块表中缺少时自动创建的模块及其默认评分维度
+// 只在数据库中没有该编码时创建一次，之后标题、维度和评分规则均以数据库为准
+var seeds = []struct {
+	code        string
+	title       string
+	description string
+	shape       module.Shape
+	dimensions  []seedDimension
+}{
+	{
+		code:        CodeWork,
+		title:       "工作经历",
+		description: "全职工作经历",
+		shape:       module.ShapeList,
+		dimensions: []seedDimension{
+			{"信息完整性", "公司、职位、任职时间等信息完整清晰", []judgment{
+				{"公司、职位、部门、任职时间齐全", 100},
+				{"缺少部门或工作性质等次要信息", 80},
+				{"缺少公司、职位或任职时间", 50},
+			}},
+			{"工作职责", "职责描述具体，体现工作范围和个人承担的角色", []judgment{
+				{"职责清晰具体，能体现个人角色和工作范围", 100},
+				{"职责描述基本清楚", 80},
+				{"职责描述笼统或缺失", 50},
+			}},
+			{"工作业绩", "业绩量化，有数据或结果支撑", []judgment{
+				{"业绩有量化数据，结果明确", 100},
+				{"有业绩描述但缺少量化", 75},
+				{"没有业绩描述", 40},
+			}},
+		},
+	},
+	{
+		code:        CodeCustom,
+		title:       "自定义模块",
+		description: "用户自定义模块的通用评分规则",
+		shape:       module.ShapeList,
+		dimensions: []seedDimension{
+			{"内容完整性", "条目的名称、时间、描述等信息完整", []judgment{
+				{"内容完整详细", 100},
+				{"内容基本完整", 80},
+				{"内容不够完整", 60},
+			}},
+			{"内容专业性", "表述专业规范，突出个人能力", []judgment{
+				{"专业术语准确，表述规范", 100},
+				{"表述基本专业", 80},
+				{"表述不够专业", 60},
+			}},
+		},
+	},
+}
+
+// seedDimension 默认评分维度
+type seedDimension struct {
+	title       string
+	description string
+	judgments   []judgment
+}
+
+// judgment 维度的判定项，对应维度表 judgment 字段中的 {detail, score}
+type judgment struct {
+	detail string
+	score  float64
+}
+
+// seedModules 创建数据库中缺少的种子模块，返回新建的模块记录
+func (r *Registry) seedModules(ctx context.Context, existing map[string]Module) ([]*ent.Module, error) {
+	var created []*ent.Module
+	for _, s := range seeds {
+		if _, ok := existing[s.code]; ok {
+			continue
+		}
+
+		tx, err := r.client.Tx(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("seed module: code=%s: %w", s.code, err)
+		}
+		row, err := tx.Module.Create().
+			SetCode(s.code).
+			SetTitle(s.title).
+			SetDescription(s.description).
+			SetShape(s.shape).
+			SetDataSchema(defaultSchema(s.code)).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("seed module: code=%s: %w", s.code, err)
+		}
+		for _, d := range s.dimensions {
+			items := make([]map[string]interface{}, 0, len(d.judgments))
+			for _, j := range d.judgments {
+				items = append(items, map[string]interface{}{"detail": j.detail, "score": j.score})
+			}
+			_, err := tx.Dimension.Create().
+				SetModuleID(row.ID).
+				SetTitle(d.title).
+				SetDescription(d.description).
+				SetJudgment(items).
+				Save(ctx)
+			if err != nil {
+				tx.Rollback()
+				return nil, fmt.Errorf("seed dimension: code=%s, dimension=%s: %w", s.code, d.title, err)
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("seed module: code=%s: %w", s.code, err)
+		}
+		created = append(created, row)
+	}
+	return created, nil
+}
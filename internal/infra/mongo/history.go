@@ -0,0 +1,86 @@
+package mongo
+
+import (
+	"context"
+	"cv2/internal/infra/mongo/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const resumeContentHistoryCollection = "resume_content_history"
+
+// InsertResumeHistory 写入一条简历内容快照，返回快照ID
+func (c *Client) InsertResumeHistory(ctx context.Context, history *model.ResumeContentHistory) (primitive.ObjectID, error) {
+	collection := c.Database().Collection(resumeContentHistoryCollection)
+
+	history.ID = primitive.NewObjectID()
+	if history.CreateTime.IsZero() {
+		history.CreateTime = time.Now()
+	}
+
+	_, err := collection.InsertOne(ctx, history)
+	return history.ID, err
+}
+
+// HasResumeHistory 判断简历是否已有快照
+func (c *Client) HasResumeHistory(ctx context.Context, resumeID int64) (bool, error) {
+	collection := c.Database().Collection(resumeContentHistoryCollection)
+
+	n, err := collection.CountDocuments(ctx, bson.M{"mysql_id": resumeID}, options.Count().SetLimit(1))
+	return n > 0, err
+}
+
+// ListResumeHistory 按时间倒序分页列出简历快照（不含模块数据）
+// before 为上一页最后一条快照ID，零值表示从最新开始
+func (c *Client) ListResumeHistory(ctx context.Context, resumeID int64, before primitive.ObjectID, limit int64) ([]model.ResumeContentHistory, error) {
+	collection := c.Database().Collection(resumeContentHistoryCollection)
+
+	filter := bson.M{"mysql_id": resumeID}
+	if !before.IsZero() {
+		filter["_id"] = bson.M{"$lt": before}
+	}
+
+	cursor, err := collection.Find(ctx, filter,
+		options.Find().
+			SetSort(bson.D{{Key: "_id", Value: -1}}).
+			SetLimit(limit).
+			SetProjection(bson.M{"modules": 0, "scores": 0}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []model.ResumeContentHistory
+	if err := cursor.All(ctx, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetResumeHistory 获取简历的指定快照，不存在时返回 nil
+func (c *Client) GetResumeHistory(ctx context.Context, resumeID int64, id primitive.ObjectID) (*model.ResumeContentHistory, error) {
+	collection := c.Database().Collection(resumeContentHistoryCollection)
+
+	var result model.ResumeContentHistory
+	err := collection.FindOne(ctx, bson.M{"_id": id, "mysql_id": resumeID}).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteResumeHistory 删除简历的全部快照
+func (c *Client) DeleteResumeHistory(ctx context.Context, resumeID int64) error {
+	collection := c.Database().Collection(resumeContentHistoryCollection)
+	_, err := collection.DeleteMany(ctx, bson.M{"mysql_id": resumeID})
+	return err
+}
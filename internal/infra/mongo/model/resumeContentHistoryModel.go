@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 版本快照的产生方式
+const (
+	HistoryActionCreate = "create" // 简历创建
+	HistoryActionInit   = "init"   // 首次编辑前补录的基线版本
+	HistoryActionSave   = "save"   // 保存模块
+	HistoryActionRevert = "revert" // 回滚
+)
+
+// ResumeContentHistory 简历内容的不可变快照，每次修改简历内容后写入一条
+type ResumeContentHistory struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	MySQLID    int64              `bson:"mysql_id" json:"mysql_id"`                           // 关联 MySQL 中的简历ID
+	Action     string             `bson:"action" json:"action"`                               // 产生快照的操作
+	ModuleID   int64              `bson:"module_id" json:"module_id"`                         // 变更的模块ID，0 表示整份简历
+	Revision   int64              `bson:"revision" json:"revision"`                           // 快照对应的内容版本号
+	AuthorID   int64              `bson:"author_id" json:"author_id"`                         // 操作人ID
+	RevertFrom primitive.ObjectID `bson:"revert_from,omitempty" json:"revert_from,omitempty"` // 回滚来源版本
+	CreateTime time.Time          `bson:"create_time" json:"create_time"`                     // 快照时间
+	Modules    []ModuleData       `bson:"modules" json:"modules"`                             // 快照时的全部模块数据
+	Scores     []ScoreSnapshot    `bson:"scores" json:"scores"`                               // 快照时的当前评分（不一定是该版本内容的评分，见 ScoreSnapshot.State）
+	TotalScore float64            `bson:"total_score" json:"total_score"`                     // 快照时的简历总分
+}
+
+// ScoreSnapshot 快照时的单条评分，与 MySQL resume_score 表一一对应
+type ScoreSnapshot struct {
+	TargetID   int64   `bson:"target_id" json:"target_id"`                   // 模块ID或维度ID
+	TargetType int32   `bson:"target_type" json:"target_type"`               // 0=模块, 1=维度
+	ScopeID    int64   `bson:"scope_id,omitempty" json:"scope_id,omitempty"` // 维度得分所属的自定义模块ID
+	Score      float64 `bson:"score" json:"score"`                           // 得分
+	Weight     float64 `bson:"weight,omitempty" json:"weight,omitempty"`     // 权重
+	State      string  `bson:"state,omitempty" json:"state,omitempty"`       // 评分状态，pending/failed/stale 时得分为修改前的得分
+}
@@ -14,13 +14,41 @@ type ResumeContent struct {
 	UpdateTime time.Time          `bson:"update_time" json:"update_time"` // 更新时间
 	RawMD      string             `bson:"raw_md" json:"raw_md"`           // 原始 Markdown 内容
 	Modules    []ModuleData       `bson:"modules" json:"modules"`         // 模块化数据
+	Revision   int64              `bson:"revision" json:"revision"`       // 内容版本号，每次修改模块数据时递增（乐观锁）
 }
 
 // ModuleData 模块数据
 type ModuleData struct {
-	ModuleID int64                    `bson:"module_id" json:"module_id"` // 模块ID，对应 MySQL 中的 module.id
-	Title    string                   `bson:"title" json:"title"`         // 模块标题
-	Data     []map[string]interface{} `bson:"data" json:"data"`           // 模块具体数据（灵活结构）
+	ModuleID int64                    `bson:"module_id" json:"module_id"`               // 模块ID，对应 MySQL 中的 module.id
+	Title    string                   `bson:"title" json:"title"`                       // 模块标题
+	Data     []map[string]interface{} `bson:"data" json:"data"`                         // 模块具体数据（灵活结构），每一项带有稳定的 item_id
+	Custom   bool                     `bson:"custom,omitempty" json:"custom,omitempty"` // 是否为用户自定义模块（ModuleID 为简历内生成的ID，不在模块表中）
+}
+
+// ItemIDKey 模块数据项中存放条目ID的字段
+const ItemIDKey = "item_id"
+
+// NewItemID 生成模块数据项ID
+func NewItemID() string {
+	return primitive.NewObjectID().Hex()
+}
+
+// ItemID 返回数据项的条目ID，没有时返回空字符串
+func ItemID(item map[string]interface{}) string {
+	id, _ := item[ItemIDKey].(string)
+	return id
+}
+
+// EnsureItemIDs 为缺少条目ID的数据项补充ID，返回是否有改动
+func EnsureItemIDs(data []map[string]interface{}) bool {
+	changed := false
+	for _, item := range data {
+		if item != nil && ItemID(item) == "" {
+			item[ItemIDKey] = NewItemID()
+			changed = true
+		}
+	}
+	return changed
 }
 
 // BasicInfo 基本信息模块
@@ -3,17 +3,26 @@ package mongo
 import (
 	"context"
 	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/moduleregistry"
 	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/snowflake"
+	"errors"
 	"time"
 
+	"github.com/zeromicro/go-zero/core/logx"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const resumeContentCollection = "resume_content"
 
+// ErrRevisionConflict 简历内容已被其他请求修改
+var ErrRevisionConflict = errors.New("resume content revision conflict")
+
 // SaveResumeContent 保存简历内容到 MongoDB
-func (c *Client) SaveResumeContent(ctx context.Context, resumeID int64, data *algorithm.ResumeData) error {
+// modules 由 BuildModules 构建
+func (c *Client) SaveResumeContent(ctx context.Context, resumeID int64, modules []model.ModuleData) error {
 	collection := c.Database().Collection(resumeContentCollection)
 
 	// 构造 MongoDB 文档
@@ -22,7 +31,7 @@ func (c *Client) SaveResumeContent(ctx context.Context, resumeID int64, data *al
 		CreateTime: time.Now(),
 		UpdateTime: time.Now(),
 		RawMD:      "", // TODO: 如果有 Markdown 原文可以存储
-		Modules:    buildModules(data),
+		Modules:    modules,
 	}
 
 	// 插入文档
@@ -30,8 +39,9 @@ func (c *Client) SaveResumeContent(ctx context.Context, resumeID int64, data *al
 	return err
 }
 
-// buildModules 构建模块数据
-func buildModules(data *algorithm.ResumeData) []model.ModuleData {
+// BuildModules 把算法服务返回的结构化数据转换为模块数据
+// 模块ID和标题从模块注册表按编码获取
+func BuildModules(data *algorithm.ResumeData, registry *moduleregistry.Registry) []model.ModuleData {
 	var modules []model.ModuleData
 
 	// 基本信息模块
@@ -50,11 +60,11 @@ func buildModules(data *algorithm.ResumeData) []model.ModuleData {
 			"max_salary":  data.MaxSalary,
 			"job_type":    data.JobType,
 		}
-		modules = append(modules, model.ModuleData{
-			ModuleID: 1, // TODO: 从配置或数据库获取实际的模块ID
-			Title:    "基本信息",
-			Data:     []map[string]interface{}{basicInfo},
-		})
+		// 照片只有用户上传后才有，为空时不写入（兼容未包含 photo 字段的旧 schema）
+		if data.Photo != "" {
+			basicInfo["photo"] = data.Photo
+		}
+		modules = appendModule(modules, registry, moduleregistry.CodeBasicInfo, []map[string]interface{}{basicInfo})
 	}
 
 	// 教育经历模块
@@ -70,11 +80,7 @@ func buildModules(data *algorithm.ResumeData) []model.ModuleData {
 				"description": edu.Description,
 			})
 		}
-		modules = append(modules, model.ModuleData{
-			ModuleID: 2,
-			Title:    "教育经历",
-			Data:     eduData,
-		})
+		modules = appendModule(modules, registry, moduleregistry.CodeEducation, eduData)
 	}
 
 	// 在校经历模块
@@ -89,11 +95,7 @@ func buildModules(data *algorithm.ResumeData) []model.ModuleData {
 				"description": campus.Description,
 			})
 		}
-		modules = append(modules, model.ModuleData{
-			ModuleID: 3,
-			Title:    "在校经历",
-			Data:     campusData,
-		})
+		modules = appendModule(modules, registry, moduleregistry.CodeCampus, campusData)
 	}
 
 	// 实习经历模块
@@ -108,11 +110,25 @@ func buildModules(data *algorithm.ResumeData) []model.ModuleData {
 				"description": intern.Description,
 			})
 		}
-		modules = append(modules, model.ModuleData{
-			ModuleID: 4,
-			Title:    "实习经历",
-			Data:     internData,
-		})
+		modules = appendModule(modules, registry, moduleregistry.CodeIntern, internData)
+	}
+
+	// 工作经历模块
+	if len(data.WorkExp) > 0 {
+		workData := make([]map[string]interface{}, 0, len(data.WorkExp))
+		for _, work := range data.WorkExp {
+			workData = append(workData, map[string]interface{}{
+				"company":          work.Company,
+				"title":            work.Title,
+				"department":       work.Department,
+				"employment_type":  work.EmploymentType,
+				"start_time":       work.StartTime,
+				"end_time":         work.EndTime,
+				"responsibilities": work.Responsibilities,
+				"achievements":     work.Achievements,
+			})
+		}
+		modules = appendModule(modules, registry, moduleregistry.CodeWork, workData)
 	}
 
 	// 项目经历模块
@@ -127,38 +143,73 @@ func buildModules(data *algorithm.ResumeData) []model.ModuleData {
 				"description":  project.Description,
 			})
 		}
-		modules = append(modules, model.ModuleData{
-			ModuleID: 5,
-			Title:    "项目经历",
-			Data:     projectData,
-		})
+		modules = appendModule(modules, registry, moduleregistry.CodeProject, projectData)
 	}
 
 	// 技能证书模块
 	if data.Skills != "" {
-		modules = append(modules, model.ModuleData{
-			ModuleID: 6,
-			Title:    "技能证书",
-			Data: []map[string]interface{}{
-				{"content": data.Skills},
-			},
+		modules = appendModule(modules, registry, moduleregistry.CodeSkills, []map[string]interface{}{
+			{"content": data.Skills},
 		})
 	}
 
 	// 自我评价模块
 	if data.SelfEval != "" {
+		modules = appendModule(modules, registry, moduleregistry.CodeSelfEval, []map[string]interface{}{
+			{"content": data.SelfEval},
+		})
+	}
+
+	// 自定义模块（如导入的获奖经历、语言能力），模块ID为新生成的雪花ID
+	for _, section := range data.Custom {
+		items := make([]map[string]interface{}, 0, len(section.Items))
+		for _, item := range section.Items {
+			fields := make(map[string]interface{}, len(item))
+			for label, v := range item {
+				key, ok := customFieldKeys[label]
+				if !ok {
+					key = label
+				}
+				fields[key] = v
+			}
+			items = append(items, fields)
+		}
+		model.EnsureItemIDs(items)
 		modules = append(modules, model.ModuleData{
-			ModuleID: 7,
-			Title:    "自我评价",
-			Data: []map[string]interface{}{
-				{"content": data.SelfEval},
-			},
+			ModuleID: snowflake.NextID(),
+			Title:    section.Title,
+			Data:     items,
+			Custom:   true,
 		})
 	}
 
 	return modules
 }
 
+// customFieldKeys 自定义模块条目常用字段显示名到字段名的对应，其他字段保留原名
+var customFieldKeys = map[string]string{
+	algorithm.CustomFieldTitle:       "title",
+	algorithm.CustomFieldSubtitle:    "subtitle",
+	algorithm.CustomFieldStartTime:   "start_time",
+	algorithm.CustomFieldEndTime:     "end_time",
+	algorithm.CustomFieldDescription: "description",
+}
+
+// appendModule 按编码追加模块，数据库中没有该模块时跳过
+func appendModule(modules []model.ModuleData, registry *moduleregistry.Registry, code string, data []map[string]interface{}) []model.ModuleData {
+	m, ok := registry.ByCode(code)
+	if !ok {
+		logx.Errorf("module not registered, skip: code=%s", code)
+		return modules
+	}
+	model.EnsureItemIDs(data)
+	return append(modules, model.ModuleData{
+		ModuleID: m.ID,
+		Title:    m.Title,
+		Data:     data,
+	})
+}
+
 // GetResumeContent 获取简历内容
 func (c *Client) GetResumeContent(ctx context.Context, resumeID int64) (*model.ResumeContent, error) {
 	collection := c.Database().Collection(resumeContentCollection)
@@ -175,47 +226,213 @@ func (c *Client) GetResumeContent(ctx context.Context, resumeID int64) (*model.R
 	return &result, nil
 }
 
-// UpdateModule 更新简历中的单个模块
-func (c *Client) UpdateModule(ctx context.Context, resumeID, moduleID int64, title string, data []map[string]interface{}) error {
+// UpdateModule 更新简历中的单个模块，模块不存在时追加
+// revision 为客户端读取时的内容版本号，不一致时返回 ErrRevisionConflict，成功时返回新的版本号
+func (c *Client) UpdateModule(ctx context.Context, resumeID, moduleID int64, title string, data []map[string]interface{}, revision int64) (int64, error) {
 	collection := c.Database().Collection(resumeContentCollection)
+	now := time.Now()
 
-	// 先查询现有内容
-	content, err := c.GetResumeContent(ctx, resumeID)
+	// 1. 模块已存在：按 module_id 原子更新数组中的对应元素
+	res, err := collection.UpdateOne(
+		ctx,
+		revisionFilter(bson.M{"mysql_id": resumeID, "modules.module_id": moduleID}, revision),
+		bson.M{
+			"$set": bson.M{
+				"modules.$[m].title": title,
+				"modules.$[m].data":  data,
+				"update_time":        now,
+			},
+			"$inc": bson.M{"revision": 1},
+		},
+		options.Update().SetArrayFilters(options.ArrayFilters{
+			Filters: []interface{}{bson.M{"m.module_id": moduleID}},
+		}),
+	)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	if content == nil {
-		return mongo.ErrNoDocuments
+	if res.MatchedCount > 0 {
+		return revision + 1, nil
 	}
 
-	// 更新或添加模块
-	found := false
-	for i, mod := range content.Modules {
-		if mod.ModuleID == moduleID {
-			content.Modules[i].Data = data
-			content.Modules[i].Title = title
-			found = true
-			break
-		}
+	// 2. 模块不存在：追加模块
+	return c.InsertModule(ctx, resumeID, model.ModuleData{ModuleID: moduleID, Title: title, Data: data}, revision)
+}
+
+// InsertModule 在简历末尾追加模块，模块已存在时按版本冲突处理
+// revision 规则同 UpdateModule
+func (c *Client) InsertModule(ctx context.Context, resumeID int64, module model.ModuleData, revision int64) (int64, error) {
+	collection := c.Database().Collection(resumeContentCollection)
+
+	// 使用聚合管道更新，兼容 modules 为 null 的旧文档；$literal 避免数据中以 $ 开头的字符串被当作字段路径
+	res, err := collection.UpdateOne(
+		ctx,
+		revisionFilter(bson.M{"mysql_id": resumeID, "modules.module_id": bson.M{"$ne": module.ModuleID}}, revision),
+		bson.A{bson.M{"$set": bson.M{
+			"modules": bson.M{"$concatArrays": bson.A{
+				bson.M{"$ifNull": bson.A{"$modules", bson.A{}}},
+				bson.M{"$literal": bson.A{module}},
+			}},
+			"update_time": time.Now(),
+			"revision":    bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$revision", 0}}, 1}},
+		}}},
+	)
+	if err != nil {
+		return 0, err
 	}
-	if !found {
-		content.Modules = append(content.Modules, model.ModuleData{
-			ModuleID: moduleID,
-			Title:    title,
-			Data:     data,
-		})
+	if res.MatchedCount == 0 {
+		return 0, c.updateMissReason(ctx, resumeID)
 	}
+	return revision + 1, nil
+}
+
+// RemoveModule 从简历中移除模块
+// revision 规则同 UpdateModule
+func (c *Client) RemoveModule(ctx context.Context, resumeID, moduleID int64, revision int64) (int64, error) {
+	collection := c.Database().Collection(resumeContentCollection)
 
-	// 更新文档
-	_, err = collection.UpdateOne(
+	res, err := collection.UpdateOne(
 		ctx,
-		bson.M{"mysql_id": resumeID},
+		revisionFilter(bson.M{"mysql_id": resumeID}, revision),
+		bson.M{
+			"$pull": bson.M{"modules": bson.M{"module_id": moduleID}},
+			"$set":  bson.M{"update_time": time.Now()},
+			"$inc":  bson.M{"revision": 1},
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if res.MatchedCount == 0 {
+		return 0, c.updateMissReason(ctx, resumeID)
+	}
+	return revision + 1, nil
+}
+
+// ReplaceModules 整体替换简历的模块数据（用于回滚整份简历）
+// revision 规则同 UpdateModule
+func (c *Client) ReplaceModules(ctx context.Context, resumeID int64, modules []model.ModuleData, revision int64) (int64, error) {
+	collection := c.Database().Collection(resumeContentCollection)
+
+	res, err := collection.UpdateOne(
+		ctx,
+		revisionFilter(bson.M{"mysql_id": resumeID}, revision),
 		bson.M{
 			"$set": bson.M{
-				"modules":     content.Modules,
+				"modules":     modules,
 				"update_time": time.Now(),
 			},
+			"$inc": bson.M{"revision": 1},
 		},
 	)
+	if err != nil {
+		return 0, err
+	}
+	if res.MatchedCount == 0 {
+		return 0, c.updateMissReason(ctx, resumeID)
+	}
+	return revision + 1, nil
+}
+
+// BackfillItemIDs 为旧文档补写条目ID
+// 只补充元数据不改变内容，因此不递增版本号；版本号已变化时放弃（下次读取再补）
+func (c *Client) BackfillItemIDs(ctx context.Context, resumeID int64, modules []model.ModuleData, revision int64) error {
+	collection := c.Database().Collection(resumeContentCollection)
+
+	_, err := collection.UpdateOne(
+		ctx,
+		revisionFilter(bson.M{"mysql_id": resumeID}, revision),
+		bson.M{"$set": bson.M{"modules": modules}},
+	)
+	return err
+}
+
+// revisionFilter 追加版本号条件，旧文档没有 revision 字段时视为 0
+func revisionFilter(filter bson.M, revision int64) bson.M {
+	if revision == 0 {
+		filter["revision"] = bson.M{"$in": bson.A{0, nil}}
+	} else {
+		filter["revision"] = revision
+	}
+	return filter
+}
+
+// updateMissReason 条件更新未命中时区分文档不存在和版本冲突
+func (c *Client) updateMissReason(ctx context.Context, resumeID int64) error {
+	content, err := c.GetResumeContent(ctx, resumeID)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		return mongo.ErrNoDocuments
+	}
+	return ErrRevisionConflict
+}
+
+// CountFilledModules 批量统计简历中已填写内容的模块数，只统计 moduleIDs 中的模块
+// 返回 resumeID -> 已填写模块数，未找到文档的简历不出现在结果中
+func (c *Client) CountFilledModules(ctx context.Context, resumeIDs []int64, moduleIDs []int64) (map[int64]int, error) {
+	result := make(map[int64]int, len(resumeIDs))
+	if len(resumeIDs) == 0 {
+		return result, nil
+	}
+
+	counted := make(map[int64]bool, len(moduleIDs))
+	for _, id := range moduleIDs {
+		counted[id] = true
+	}
+
+	collection := c.Database().Collection(resumeContentCollection)
+	cursor, err := collection.Find(ctx,
+		bson.M{"mysql_id": bson.M{"$in": resumeIDs}},
+		options.Find().SetProjection(bson.M{"mysql_id": 1, "modules": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var content model.ResumeContent
+		if err := cursor.Decode(&content); err != nil {
+			return nil, err
+		}
+		count := 0
+		for _, mod := range content.Modules {
+			if counted[mod.ModuleID] && isModuleFilled(mod) {
+				count++
+			}
+		}
+		result[content.MySQLID] = count
+	}
+	return result, cursor.Err()
+}
+
+// isModuleFilled 判断模块是否填写了内容（任意一项存在非空值）
+func isModuleFilled(mod model.ModuleData) bool {
+	for _, item := range mod.Data {
+		for k, v := range item {
+			if k == model.ItemIDKey {
+				continue
+			}
+			switch val := v.(type) {
+			case nil:
+				continue
+			case string:
+				if val != "" {
+					return true
+				}
+			default:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DeleteResumeContent 删除简历内容
+func (c *Client) DeleteResumeContent(ctx context.Context, resumeID int64) error {
+	collection := c.Database().Collection(resumeContentCollection)
+	_, err := collection.DeleteMany(ctx, bson.M{"mysql_id": resumeID})
 	return err
 }
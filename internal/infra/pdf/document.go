@@ -0,0 +1,184 @@
+package pdf
+
+import (
+	"sort"
+	"strings"
+
+	"cv2/internal/infra/algorithm"
+)
+
+// 排版用的简历结构
+// 把 ResumeData 整理为“模块 - 条目 - 段落”，PDF 和 HTML 预览共用，保证两者的模块顺序和内容一致
+
+// Section 模块
+type Section struct {
+	Title   string
+	Entries []Entry
+}
+
+// Entry 条目，Title 和 Period 都为空时只输出段落（如技能证书、自我评价）
+type Entry struct {
+	Title  string // 标题行，如 “公司 | 职位”
+	Period string // 时间段
+	Blocks []Block
+}
+
+// Block 段落，Label 不为空时带小标题（如 工作职责）
+type Block struct {
+	Label string
+	Text  string
+}
+
+// HeaderLines 首页页眉：姓名（为空时为“简历”）和求职意向、联系方式、个人信息等非空行
+func HeaderLines(data *algorithm.ResumeData) (string, []string) {
+	name := strings.TrimSpace(data.Name)
+	if name == "" {
+		name = "简历"
+	}
+	lines := nonEmpty(
+		join(" | ", label("求职意向", data.JobTitle), label("意向城市", data.TargetCity),
+			label("期望薪资", join(" - ", data.MinSalary, data.MaxSalary)), data.JobType),
+		join(" | ", data.Phone, data.Email, data.Location),
+		join(" | ", label("出生日期", data.Birthday), label("民族", data.Ethnicity), label("政治面貌", data.Politics)),
+	)
+	return name, lines
+}
+
+// Sections 按输出顺序返回非空模块
+func Sections(data *algorithm.ResumeData) []Section {
+	var sections []Section
+	add := func(title string, entries []Entry) {
+		if len(entries) > 0 {
+			sections = append(sections, Section{Title: title, Entries: entries})
+		}
+	}
+
+	var entries []Entry
+	for _, e := range data.Education {
+		entries = append(entries, entry(join(" | ", e.SchoolName, e.Degree, e.Major), period(e.StartTime, e.EndTime), text(e.Description)...))
+	}
+	add("教育经历", entries)
+
+	entries = nil
+	for _, w := range data.WorkExp {
+		entries = append(entries, entry(join(" | ", w.Company, w.Title, w.Department, w.EmploymentType), period(w.StartTime, w.EndTime),
+			labeled("工作职责", w.Responsibilities, "工作业绩", w.Achievements)...))
+	}
+	add("工作经历", entries)
+
+	entries = nil
+	for _, e := range data.InternExp {
+		entries = append(entries, entry(join(" | ", e.Company, e.Position), period(e.StartTime, e.EndTime), text(e.Description)...))
+	}
+	add("实习经历", entries)
+
+	entries = nil
+	for _, p := range data.ProjectExp {
+		entries = append(entries, entry(join(" | ", p.ProjectName, p.Role), period(p.StartTime, p.EndTime), text(p.Description)...))
+	}
+	add("项目经历", entries)
+
+	entries = nil
+	for _, c := range data.CampusExp {
+		entries = append(entries, entry(join(" | ", c.Title, c.Role), period(c.StartTime, c.EndTime), text(c.Description)...))
+	}
+	add("在校经历", entries)
+
+	if blocks := text(data.Skills); len(blocks) > 0 {
+		add("技能证书", []Entry{{Blocks: blocks}})
+	}
+
+	for _, c := range data.Custom {
+		entries = nil
+		for _, item := range c.Items {
+			entries = append(entries, customEntry(item))
+		}
+		add(c.Title, entries)
+	}
+
+	if blocks := text(data.SelfEval); len(blocks) > 0 {
+		add("自我评价", []Entry{{Blocks: blocks}})
+	}
+	return sections
+}
+
+// customEntry 自定义模块条目：名称/副标题/时间作为标题行，描述作为段落，其余字段按名称排序输出
+func customEntry(item map[string]string) Entry {
+	e := entry(join(" | ", item[algorithm.CustomFieldTitle], item[algorithm.CustomFieldSubtitle]),
+		period(item[algorithm.CustomFieldStartTime], item[algorithm.CustomFieldEndTime]),
+		text(item[algorithm.CustomFieldDescription])...)
+
+	var extra []string
+	for k, v := range item {
+		switch k {
+		case algorithm.CustomFieldTitle, algorithm.CustomFieldSubtitle, algorithm.CustomFieldStartTime,
+			algorithm.CustomFieldEndTime, algorithm.CustomFieldDescription:
+			continue
+		}
+		if line := label(k, v); line != "" {
+			extra = append(extra, line)
+		}
+	}
+	sort.Strings(extra)
+	for _, line := range extra {
+		e.Blocks = append(e.Blocks, Block{Text: line})
+	}
+	return e
+}
+
+func entry(title, when string, blocks ...Block) Entry {
+	return Entry{Title: title, Period: when, Blocks: blocks}
+}
+
+// text 非空文本转为段落
+func text(s string) []Block {
+	if s = strings.TrimSpace(s); s == "" {
+		return nil
+	}
+	return []Block{{Text: s}}
+}
+
+// labeled 按 (小标题, 文本) 成对传入，跳过空文本
+func labeled(pairs ...string) []Block {
+	var blocks []Block
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if s := strings.TrimSpace(pairs[i+1]); s != "" {
+			blocks = append(blocks, Block{Label: pairs[i], Text: s})
+		}
+	}
+	return blocks
+}
+
+// join 用 sep 连接非空字符串
+func join(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// label 输出 “名称：值”，值为空时返回空字符串
+func label(name, value string) string {
+	if strings.TrimSpace(value) == "" {
+		return ""
+	}
+	return name + "：" + strings.TrimSpace(value)
+}
+
+// period 输出时间段，如 2020.09 - 2024.06
+func period(start, end string) string {
+	return join(" - ", start, end)
+}
+
+func nonEmpty(values ...string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
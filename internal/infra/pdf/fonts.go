@@ -0,0 +1,64 @@
+package pdf
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// 构建时放入 fonts/ 目录的字体会嵌入二进制，说明见 fonts/README.md
+//
+//go:embed fonts
+var fontFS embed.FS
+
+const (
+	embeddedRegularFont = "fonts/regular.ttf"
+	embeddedBoldFont    = "fonts/bold.ttf"
+)
+
+// ErrNoFont 没有可用的中文字体
+var ErrNoFont = errors.New("no cjk font available")
+
+// Fonts 渲染使用的字体数据（TrueType）
+type Fonts struct {
+	Regular []byte
+	Bold    []byte
+}
+
+// LoadFonts 加载字体：优先使用配置的文件路径，其次使用嵌入的字体；粗体缺少时使用常规字重
+// 都没有时返回 ErrNoFont
+func LoadFonts(regularPath, boldPath string) (*Fonts, error) {
+	regular, err := loadFont(regularPath, embeddedRegularFont)
+	if err != nil {
+		return nil, err
+	}
+	if regular == nil {
+		return nil, ErrNoFont
+	}
+	bold, err := loadFont(boldPath, embeddedBoldFont)
+	if err != nil {
+		return nil, err
+	}
+	if bold == nil {
+		bold = regular
+	}
+	return &Fonts{Regular: regular, Bold: bold}, nil
+}
+
+// loadFont 读取配置路径的字体，未配置时读取嵌入的字体，都没有时返回 nil
+func loadFont(path, embedded string) ([]byte, error) {
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read font %s: %w", path, err)
+		}
+		return b, nil
+	}
+	b, err := fontFS.ReadFile(embedded)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return b, err
+}
@@ -0,0 +1,302 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"cv2/internal/infra/algorithm"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// 本地 PDF 渲染
+// 直接按结构化数据排版（不经过 Markdown），A4 纵向，模块顺序与 Markdown 模板一致；
+// 条目标题与第一行内容不跨页，第二页起带页眉，每页带页码
+
+const (
+	fontFamily = "cjk"
+	ptToMM     = 25.4 / 72
+	photoName  = "photo"
+)
+
+// Renderer 本地 PDF 渲染器
+type Renderer struct {
+	fonts *Fonts
+}
+
+// NewRenderer 创建渲染器
+func NewRenderer(fonts *Fonts) *Renderer {
+	return &Renderer{fonts: fonts}
+}
+
+// Render 按模板渲染简历 PDF，photo 为照片内容（JPEG/PNG，可为空）
+// 单页模板内容超出一页时逐步缩小字号和间距，缩到最小比例后仍超出则保留多页
+func (r *Renderer) Render(data *algorithm.ResumeData, templateCode string, photo []byte) ([]byte, error) {
+	t := LookupTemplate(templateCode)
+	scale := 1.0
+	for {
+		doc, err := r.layout(data, t.scaled(scale), photo)
+		if err != nil {
+			return nil, err
+		}
+		if !t.OnePage || doc.PageCount() <= 1 || scale*t.ScaleFactor < t.MinScale {
+			var buf bytes.Buffer
+			if err := doc.Output(&buf); err != nil {
+				return nil, fmt.Errorf("output pdf: %w", err)
+			}
+			return buf.Bytes(), nil
+		}
+		scale *= t.ScaleFactor
+	}
+}
+
+// layout 排版一次，返回未输出的文档
+func (r *Renderer) layout(data *algorithm.ResumeData, t Template, photo []byte) (*fpdf.Fpdf, error) {
+	doc := fpdf.New("P", "mm", "A4", "")
+	doc.SetCompression(true)
+	doc.SetTitle(strings.TrimSpace(data.Name+" 简历"), true)
+	doc.AddUTF8FontFromBytes(fontFamily, "", r.fonts.Regular)
+	doc.AddUTF8FontFromBytes(fontFamily, "B", r.fonts.Bold)
+	doc.SetMargins(t.Margin, t.Margin, t.Margin)
+	doc.SetAutoPageBreak(true, t.Bottom)
+	doc.SetCellMargin(0)
+	doc.AliasNbPages("")
+
+	l := &layouter{doc: doc, t: t}
+	pageW, _ := doc.GetPageSize()
+	l.width = pageW - 2*t.Margin
+
+	doc.SetHeaderFunc(l.pageHeader(data.Name))
+	doc.SetFooterFunc(l.pageFooter)
+	doc.AddPage()
+
+	l.header(data, photo)
+	l.body(data)
+
+	if err := doc.Error(); err != nil {
+		return nil, fmt.Errorf("layout pdf: %w", err)
+	}
+	return doc, nil
+}
+
+// layouter 单次排版的状态
+type layouter struct {
+	doc   *fpdf.Fpdf
+	t     Template
+	width float64 // 正文宽度
+}
+
+// lineHeight 指定字号的行高（mm）
+func (l *layouter) lineHeight(size float64) float64 {
+	return size * ptToMM * l.t.LineHeight
+}
+
+func (l *layouter) font(style string, size float64, color rgb) {
+	l.doc.SetFont(fontFamily, style, size)
+	l.doc.SetTextColor(color.r, color.g, color.b)
+}
+
+// ensure 当前页剩余空间不足 h 时换页
+func (l *layouter) ensure(h float64) {
+	_, pageH := l.doc.GetPageSize()
+	if l.doc.GetY()+h > pageH-l.t.Bottom {
+		l.doc.AddPage()
+	}
+}
+
+// pageHeader 第二页起的页眉：姓名 + 细线
+func (l *layouter) pageHeader(name string) func() {
+	return func() {
+		if l.doc.PageNo() <= 1 {
+			return
+		}
+		top := l.t.Margin / 2
+		l.font("", l.t.SmallSize, l.t.Muted)
+		l.doc.SetXY(l.t.Margin, top-l.lineHeight(l.t.SmallSize)/2)
+		l.doc.CellFormat(l.width, l.lineHeight(l.t.SmallSize), strings.TrimSpace(name+" · 简历"), "", 0, "R", false, 0, "")
+		l.doc.SetDrawColor(l.t.Muted.r, l.t.Muted.g, l.t.Muted.b)
+		l.doc.SetLineWidth(0.1)
+		l.doc.Line(l.t.Margin, top+l.lineHeight(l.t.SmallSize)/2, l.t.Margin+l.width, top+l.lineHeight(l.t.SmallSize)/2)
+		l.doc.SetXY(l.t.Margin, l.t.Margin)
+	}
+}
+
+// pageFooter 页码
+func (l *layouter) pageFooter() {
+	_, pageH := l.doc.GetPageSize()
+	l.font("", l.t.SmallSize, l.t.Muted)
+	l.doc.SetXY(l.t.Margin, pageH-l.t.Bottom/2-l.lineHeight(l.t.SmallSize)/2)
+	l.doc.CellFormat(l.width, l.lineHeight(l.t.SmallSize), fmt.Sprintf("%d / {nb}", l.doc.PageNo()), "", 0, "C", false, 0, "")
+}
+
+// header 首页页眉：姓名、求职意向、联系方式、个人信息和照片
+func (l *layouter) header(data *algorithm.ResumeData, photo []byte) {
+	t := l.t
+	name, lines := HeaderLines(data)
+
+	photoType := imageType(photo)
+	photoW, photoH := 0.0, 0.0
+	if photoType != "" {
+		photoW, photoH = t.PhotoWidth, t.PhotoWidth*1.25
+	}
+
+	nameH := t.NameSize * ptToMM * 1.4
+	textLH := l.lineHeight(t.TextSize)
+	textH := nameH + float64(len(lines))*textLH
+	top := t.Margin
+	height := textH
+	if photoH > height {
+		height = photoH
+	}
+
+	// 文字区域：照片在右侧，居中样式两侧同时让出照片宽度保证视觉居中
+	textX, textW, align := t.Margin, l.width, "L"
+	if photoW > 0 {
+		textW -= photoW + 4
+	}
+	textColor, nameColor := t.Text, t.Accent
+	switch t.Header {
+	case headerCentered:
+		align = "C"
+		if photoW > 0 {
+			textX, textW = t.Margin+photoW+4, l.width-2*(photoW+4)
+		}
+	case headerBand:
+		pageW, _ := l.doc.GetPageSize()
+		l.doc.SetFillColor(t.Accent.r, t.Accent.g, t.Accent.b)
+		l.doc.Rect(0, 0, pageW, top+height+t.Margin/2, "F")
+		textColor, nameColor = rgb{255, 255, 255}, rgb{255, 255, 255}
+	}
+
+	// 文字在照片高度内垂直居中
+	y := top + (height-textH)/2
+	l.doc.SetXY(textX, y)
+	l.font("B", t.NameSize, nameColor)
+	l.doc.CellFormat(textW, nameH, name, "", 2, align, false, 0, "")
+	l.font("", t.TextSize, textColor)
+	for _, line := range lines {
+		l.doc.SetX(textX)
+		l.doc.CellFormat(textW, textLH, line, "", 2, align, false, 0, "")
+	}
+
+	if photoType != "" {
+		opt := fpdf.ImageOptions{ImageType: photoType}
+		l.doc.RegisterImageOptionsReader(photoName, opt, bytes.NewReader(photo))
+		l.doc.ImageOptions(photoName, t.Margin+l.width-photoW, top, photoW, photoH, false, opt, 0, "")
+	}
+
+	bottom := top + height
+	if t.Header == headerBand {
+		bottom += t.Margin / 2
+	}
+	l.doc.SetXY(t.Margin, bottom+t.SectionGap/2)
+}
+
+// body 按模块顺序输出正文
+func (l *layouter) body(data *algorithm.ResumeData) {
+	for _, sec := range Sections(data) {
+		l.section(sec.Title)
+		for _, e := range sec.Entries {
+			if e.Title != "" || e.Period != "" {
+				l.entry(e.Title, e.Period)
+			}
+			for _, b := range e.Blocks {
+				if b.Label != "" {
+					l.labeled(b.Label, b.Text)
+				} else {
+					l.paragraph(b.Text)
+				}
+			}
+		}
+	}
+}
+
+// section 模块标题，与下方至少两行内容保持在同一页
+func (l *layouter) section(title string) {
+	t := l.t
+	titleH := l.lineHeight(t.TitleSize)
+	l.doc.Ln(t.SectionGap)
+	l.ensure(titleH + 2*l.lineHeight(t.TextSize) + t.EntryGap)
+
+	x, y := t.Margin, l.doc.GetY()
+	l.font("B", t.TitleSize, t.Accent)
+	switch t.Section {
+	case sectionUnderline:
+		l.doc.SetX(x)
+		l.doc.CellFormat(l.width, titleH, title, "", 2, "L", false, 0, "")
+		l.doc.SetDrawColor(t.Accent.r, t.Accent.g, t.Accent.b)
+		l.doc.SetLineWidth(0.3)
+		l.doc.Line(x, l.doc.GetY(), x+l.width, l.doc.GetY())
+	case sectionBar:
+		l.doc.SetFillColor(t.Accent.r, t.Accent.g, t.Accent.b)
+		l.doc.Rect(x, y+titleH*0.2, 1.2, titleH*0.6, "F")
+		l.doc.SetX(x + 3)
+		l.doc.CellFormat(l.width-3, titleH, title, "", 2, "L", false, 0, "")
+	case sectionFilled:
+		fill := t.Accent.Tint()
+		l.doc.SetFillColor(fill.r, fill.g, fill.b)
+		l.doc.SetX(x)
+		l.doc.CellFormat(l.width, titleH, " "+title, "", 2, "L", true, 0, "")
+	}
+	l.doc.Ln(t.EntryGap)
+}
+
+// entry 条目标题行：左侧标题（加粗，过长时换行），右侧时间
+func (l *layouter) entry(title, when string) {
+	t := l.t
+	lh := l.lineHeight(t.TextSize)
+	l.ensure(2 * lh)
+	l.doc.Ln(t.EntryGap / 2)
+
+	y := l.doc.GetY()
+	whenW := 0.0
+	if when != "" {
+		l.font("", t.SmallSize, t.Muted)
+		whenW = l.doc.GetStringWidth(when) + 2
+		l.doc.SetXY(t.Margin+l.width-whenW, y)
+		l.doc.CellFormat(whenW, lh, when, "", 0, "R", false, 0, "")
+	}
+	l.font("B", t.TextSize, t.Text)
+	l.doc.SetXY(t.Margin, y)
+	l.doc.MultiCell(l.width-whenW, lh, title, "", "L", false)
+}
+
+// paragraph 正文段落，保留原有换行
+func (l *layouter) paragraph(text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	l.font("", l.t.TextSize, l.t.Text)
+	l.doc.SetX(l.t.Margin)
+	l.doc.MultiCell(l.width, l.lineHeight(l.t.TextSize), text, "", "L", false)
+}
+
+// labeled 带小标题的段落，如 工作职责、工作业绩
+func (l *layouter) labeled(name, text string) {
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	lh := l.lineHeight(l.t.TextSize)
+	l.ensure(2 * lh)
+	l.font("B", l.t.TextSize, l.t.Muted)
+	l.doc.SetX(l.t.Margin)
+	l.doc.CellFormat(l.width, lh, name, "", 2, "L", false, 0, "")
+	l.paragraph(text)
+}
+
+// imageType 根据内容识别照片格式，不支持的格式返回空字符串
+func imageType(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	switch http.DetectContentType(b) {
+	case "image/jpeg":
+		return "JPG"
+	case "image/png":
+		return "PNG"
+	}
+	return ""
+}
@@ -0,0 +1,163 @@
+package pdf
+
+import "fmt"
+
+// 简历视觉模板
+// 模板只描述字号、间距、颜色和页眉、模块标题的样式，排版流程由 Renderer 统一处理；
+// HTML 预览（internal/infra/preview）使用同一套模板参数
+
+const (
+	TemplateClassic = "classic" // 经典：居中页眉，模块标题下划线
+	TemplateModern  = "modern"  // 现代：彩色页眉色块，模块标题左侧色条
+	TemplateCompact = "compact" // 紧凑：小字号、小间距，内容自动缩放到一页
+)
+
+// headerStyle 页眉样式
+type headerStyle string
+
+const (
+	headerCentered headerStyle = "centered" // 姓名和联系方式居中
+	headerBand     headerStyle = "band"     // 彩色色块内左对齐
+	headerLeft     headerStyle = "left"     // 左对齐，无背景
+)
+
+// sectionStyle 模块标题样式
+type sectionStyle string
+
+const (
+	sectionUnderline sectionStyle = "underline" // 标题下方通栏细线
+	sectionBar       sectionStyle = "bar"       // 标题左侧色条
+	sectionFilled    sectionStyle = "filled"    // 浅色底纹
+)
+
+type rgb struct{ r, g, b int }
+
+// Hex 返回 #rrggbb 格式的颜色
+func (c rgb) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.r, c.g, c.b)
+}
+
+// Tint 返回与白色混合后的浅色（用于底纹）
+func (c rgb) Tint() rgb {
+	mix := func(v int) int { return v + (255-v)*88/100 }
+	return rgb{mix(c.r), mix(c.g), mix(c.b)}
+}
+
+// Template 模板参数，长度单位为 mm，字号单位为 pt
+type Template struct {
+	Code        string
+	Name        string
+	Description string
+
+	Margin      float64 // 左右和上边距
+	Bottom      float64 // 下边距（页脚区域）
+	NameSize    float64 // 姓名字号
+	TitleSize   float64 // 模块标题字号
+	TextSize    float64 // 正文字号
+	SmallSize   float64 // 时间、页眉页脚字号
+	LineHeight  float64 // 正文行高与字号之比
+	SectionGap  float64 // 模块之间的间距
+	EntryGap    float64 // 条目之间的间距
+	PhotoWidth  float64 // 照片宽度（高度按 4:5）
+	Header      headerStyle
+	Section     sectionStyle
+	Accent      rgb  // 强调色
+	Text        rgb  // 正文颜色
+	Muted       rgb  // 次要文字颜色
+	OnePage     bool // 是否缩放到一页
+	MinScale    float64
+	ScaleFactor float64 // 每次缩小的比例
+}
+
+var templates = []Template{
+	{
+		Code:        TemplateClassic,
+		Name:        "经典",
+		Description: "居中页眉，黑白配色，适合大多数岗位",
+		Margin:      18,
+		Bottom:      16,
+		NameSize:    22,
+		TitleSize:   13,
+		TextSize:    10.5,
+		SmallSize:   9,
+		LineHeight:  1.6,
+		SectionGap:  5,
+		EntryGap:    2.5,
+		PhotoWidth:  25,
+		Header:      headerCentered,
+		Section:     sectionUnderline,
+		Accent:      rgb{33, 33, 33},
+		Text:        rgb{33, 33, 33},
+		Muted:       rgb{110, 110, 110},
+	},
+	{
+		Code:        TemplateModern,
+		Name:        "现代",
+		Description: "彩色页眉和模块色条，视觉层次清晰",
+		Margin:      16,
+		Bottom:      16,
+		NameSize:    24,
+		TitleSize:   13,
+		TextSize:    10.5,
+		SmallSize:   9,
+		LineHeight:  1.6,
+		SectionGap:  5,
+		EntryGap:    2.5,
+		PhotoWidth:  24,
+		Header:      headerBand,
+		Section:     sectionBar,
+		Accent:      rgb{37, 99, 235},
+		Text:        rgb{31, 41, 55},
+		Muted:       rgb{107, 114, 128},
+	},
+	{
+		Code:        TemplateCompact,
+		Name:        "紧凑单页",
+		Description: "小字号紧凑排版，内容自动压缩到一页",
+		Margin:      12,
+		Bottom:      10,
+		NameSize:    18,
+		TitleSize:   11,
+		TextSize:    9.5,
+		SmallSize:   8,
+		LineHeight:  1.45,
+		SectionGap:  3,
+		EntryGap:    1.5,
+		PhotoWidth:  20,
+		Header:      headerLeft,
+		Section:     sectionFilled,
+		Accent:      rgb{15, 118, 110},
+		Text:        rgb{33, 33, 33},
+		Muted:       rgb{100, 100, 100},
+		OnePage:     true,
+		MinScale:    0.7,
+		ScaleFactor: 0.92,
+	},
+}
+
+// Templates 返回全部模板
+func Templates() []Template {
+	return templates
+}
+
+// LookupTemplate 按编码查找模板，不存在时返回经典模板
+func LookupTemplate(code string) Template {
+	for _, t := range templates {
+		if t.Code == code {
+			return t
+		}
+	}
+	return templates[0]
+}
+
+// scaled 按比例缩小字号和间距（用于单页模板）
+func (t Template) scaled(scale float64) Template {
+	t.NameSize *= scale
+	t.TitleSize *= scale
+	t.TextSize *= scale
+	t.SmallSize *= scale
+	t.SectionGap *= scale
+	t.EntryGap *= scale
+	t.PhotoWidth *= scale
+	return t
+}
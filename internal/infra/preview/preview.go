@@ -0,0 +1,86 @@
+package preview
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+
+	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/pdf"
+)
+
+// 简历 HTML 预览
+// 使用与 PDF 相同的模板参数和模块结构（pdf.Template、pdf.Sections），页面按 A4 尺寸排版；
+// 所有用户内容经 html/template 转义，样式只来自模板参数
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var resumeTemplate = template.Must(template.ParseFS(templateFS, "templates/resume.html.tmpl"))
+
+// Options 预览选项
+type Options struct {
+	Print    bool   // 打印模式：去掉背景和阴影，按 A4 分页
+	PhotoURL string // 照片地址，调用方需保证为可信地址
+}
+
+// style 模板参数转换的 CSS 值
+type style struct {
+	Header, Section                 string
+	Accent, AccentTint, Text, Muted template.CSS
+	Margin, PhotoWidth              template.CSS
+	NameSize, TitleSize, TextSize   template.CSS
+	SmallSize, SectionGap, EntryGap template.CSS
+	LineHeight                      template.CSS
+}
+
+type page struct {
+	Name     string
+	Lines    []string
+	Photo    string
+	Sections []pdf.Section
+	Style    style
+	Print    bool
+}
+
+// Render 按模板渲染简历 HTML
+func Render(data *algorithm.ResumeData, t pdf.Template, opts Options) ([]byte, error) {
+	name, lines := pdf.HeaderLines(data)
+	p := page{
+		Name:     name,
+		Lines:    lines,
+		Photo:    opts.PhotoURL,
+		Sections: pdf.Sections(data),
+		Style:    newStyle(t),
+		Print:    opts.Print,
+	}
+
+	var buf bytes.Buffer
+	if err := resumeTemplate.Execute(&buf, p); err != nil {
+		return nil, fmt.Errorf("execute preview template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func newStyle(t pdf.Template) style {
+	mm := func(v float64) template.CSS { return template.CSS(fmt.Sprintf("%.2fmm", v)) }
+	pt := func(v float64) template.CSS { return template.CSS(fmt.Sprintf("%.2fpt", v)) }
+	return style{
+		Header:     string(t.Header),
+		Section:    string(t.Section),
+		Accent:     template.CSS(t.Accent.Hex()),
+		AccentTint: template.CSS(t.Accent.Tint().Hex()),
+		Text:       template.CSS(t.Text.Hex()),
+		Muted:      template.CSS(t.Muted.Hex()),
+		Margin:     mm(t.Margin),
+		PhotoWidth: mm(t.PhotoWidth),
+		NameSize:   pt(t.NameSize),
+		TitleSize:  pt(t.TitleSize),
+		TextSize:   pt(t.TextSize),
+		SmallSize:  pt(t.SmallSize),
+		SectionGap: mm(t.SectionGap),
+		EntryGap:   mm(t.EntryGap),
+		LineHeight: template.CSS(fmt.Sprintf("%.2f", t.LineHeight)),
+	}
+}
@@ -0,0 +1,96 @@
+package redact
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// removed 文本中被清空内容的占位符
+const removed = "***"
+
+// maskName 保留姓氏（第一个字），如 张三 -> 张*，欧阳娜娜 -> 欧***；英文名保留首字母
+func maskName(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	rest := name[size:]
+	if fields := strings.Fields(name); len(fields) > 1 {
+		rest = strings.Join(fields[1:], " ")
+	}
+	return string(r) + strings.Repeat("*", max(utf8.RuneCountInString(rest), 1))
+}
+
+// maskPhone 保留前 3 位和后 4 位数字，如 138****5678；位数不足时只保留后 2 位
+func maskPhone(phone string) string {
+	var digits []rune
+	for _, r := range phone {
+		if unicode.IsDigit(r) {
+			digits = append(digits, r)
+		}
+	}
+	// 去掉国家码
+	if len(digits) == 13 && string(digits[:2]) == "86" {
+		digits = digits[2:]
+	}
+	switch {
+	case len(digits) >= 11:
+		return string(digits[:3]) + strings.Repeat("*", len(digits)-7) + string(digits[len(digits)-4:])
+	case len(digits) > 2:
+		return strings.Repeat("*", len(digits)-2) + string(digits[len(digits)-2:])
+	}
+	return removed
+}
+
+// maskEmail 保留用户名首字符和域名，如 z***@qq.com
+func maskEmail(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 {
+		return removed
+	}
+	r, _ := utf8.DecodeRuneInString(email)
+	return string(r) + "***" + email[at:]
+}
+
+var birthYear = regexp.MustCompile(`^\s*(\d{4})`)
+
+// maskBirthday 只保留年份
+func maskBirthday(birthday string) string {
+	if m := birthYear.FindStringSubmatch(birthday); m != nil {
+		return m[1]
+	}
+	return removed
+}
+
+// maskAll 整体遮盖
+func maskAll(string) string {
+	return removed
+}
+
+// schoolKinds 学校类别
+var schoolKinds = []string{
+	"职业技术学院", "高等专科学校", "职业学院", "专科学校", "研究生院", "研究院", "科学院",
+	"大学", "学院", "中学", "学校",
+	"University", "College", "Institute", "School",
+}
+
+// maskSchool 只保留学校类别，如 北京大学 -> 某大学，清华大学附属中学 -> 某中学，Stanford University -> University；
+// 无法识别时为 某学校。中文名称取最后出现的类别（名称中心词在后），同一位置取较长的类别
+func maskSchool(school string) string {
+	best, end := "", -1
+	for _, kind := range schoolKinds {
+		i := strings.LastIndex(school, kind)
+		if i < 0 {
+			continue
+		}
+		if e := i + len(kind); e > end || e == end && len(kind) > len(best) {
+			best, end = kind, e
+		}
+	}
+	switch {
+	case best == "":
+		return "某学校"
+	case best[0] < utf8.RuneSelf:
+		return best
+	}
+	return "某" + best
+}
@@ -0,0 +1,184 @@
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"cv2/internal/infra/algorithm"
+)
+
+// 简历脱敏
+// 对 algorithm.ResumeData 脱敏后再交给各导出格式渲染，Markdown、PDF、JSON 等输出的脱敏结果一致
+
+// Version 脱敏规则版本，修改脱敏逻辑后递增，使已缓存的脱敏导出文件失效
+const Version = 1
+
+// Mode 字段处理方式
+type Mode string
+
+const (
+	Keep   Mode = "keep"   // 保留原值
+	Mask   Mode = "mask"   // 部分遮盖（如 张*、138****5678、某大学）
+	Remove Mode = "remove" // 清空
+)
+
+// Policy 脱敏策略
+type Policy struct {
+	Name      Mode
+	Phone     Mode
+	Email     Mode
+	Birthday  Mode // mask 只保留年份
+	Ethnicity Mode
+	Politics  Mode
+	School    Mode // mask 只保留学校类别（如 某大学）
+	Photo     Mode // 只支持 keep 和 remove
+	FreeText  bool // 识别并遮盖所有文本中的手机号、邮箱和身份证号
+}
+
+// Fingerprint 策略指纹，用于区分不同策略生成的导出文件
+func (p Policy) Fingerprint() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("v%d:%+v", Version, p)))
+	return hex.EncodeToString(sum[:4])
+}
+
+// Apply 返回脱敏后的简历副本，不修改 data
+// 姓名和学校名称脱敏时，文本中出现的原值也一并替换
+func Apply(data *algorithm.ResumeData, p Policy) *algorithm.ResumeData {
+	out := clone(data)
+
+	var replacer []string
+	if name := strings.TrimSpace(data.Name); p.Name != Keep && utf8.RuneCountInString(name) > 1 {
+		replacer = append(replacer, name, inText(name, p.Name, maskName))
+	}
+	out.Name = apply(out.Name, p.Name, maskName)
+	out.Phone = apply(out.Phone, p.Phone, maskPhone)
+	out.Email = apply(out.Email, p.Email, maskEmail)
+	out.Birthday = apply(out.Birthday, p.Birthday, maskBirthday)
+	out.Ethnicity = apply(out.Ethnicity, p.Ethnicity, maskAll)
+	out.Politics = apply(out.Politics, p.Politics, maskAll)
+	if p.Photo != Keep {
+		out.Photo = ""
+	}
+	for i := range out.Education {
+		school := strings.TrimSpace(out.Education[i].SchoolName)
+		if p.School != Keep && school != "" {
+			replacer = append(replacer, school, inText(school, p.School, maskSchool))
+		}
+		out.Education[i].SchoolName = apply(school, p.School, maskSchool)
+	}
+
+	r := newReplacer(replacer)
+	rewriteTexts(out, func(s string) string {
+		if r != nil {
+			s = r.Replace(s)
+		}
+		if p.FreeText {
+			s = maskText(s)
+		}
+		return s
+	})
+	return out
+}
+
+// apply 按方式处理字段值
+func apply(value string, mode Mode, mask func(string) string) string {
+	if value == "" {
+		return ""
+	}
+	switch mode {
+	case Keep:
+		return value
+	case Mask:
+		return mask(value)
+	}
+	return ""
+}
+
+// inText 返回文本中原值的替换内容，清空的字段在文本中用 *** 代替，避免语句不通
+func inText(value string, mode Mode, mask func(string) string) string {
+	if mode == Mask {
+		return mask(value)
+	}
+	return removed
+}
+
+// newReplacer 创建替换器，原值长的优先，避免 北京大学 先于 北京大学医学部 替换导致后者替换不完整
+func newReplacer(pairs []string) *strings.Replacer {
+	if len(pairs) == 0 {
+		return nil
+	}
+	type pair struct{ old, new string }
+	list := make([]pair, 0, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		list = append(list, pair{pairs[i], pairs[i+1]})
+	}
+	sort.SliceStable(list, func(i, j int) bool { return len(list[i].old) > len(list[j].old) })
+	args := make([]string, 0, len(pairs))
+	for _, p := range list {
+		args = append(args, p.old, p.new)
+	}
+	return strings.NewReplacer(args...)
+}
+
+// clone 深拷贝简历
+func clone(data *algorithm.ResumeData) *algorithm.ResumeData {
+	out := *data
+	out.Education = append([]algorithm.EducationExp(nil), data.Education...)
+	out.CampusExp = append([]algorithm.CampusExp(nil), data.CampusExp...)
+	out.InternExp = append([]algorithm.InternExp(nil), data.InternExp...)
+	out.WorkExp = append([]algorithm.WorkExp(nil), data.WorkExp...)
+	out.ProjectExp = append([]algorithm.ProjectExp(nil), data.ProjectExp...)
+	if data.Custom != nil {
+		out.Custom = make([]algorithm.CustomSection, len(data.Custom))
+		for i, sec := range data.Custom {
+			out.Custom[i] = algorithm.CustomSection{Title: sec.Title, Items: make([]map[string]string, len(sec.Items))}
+			for j, item := range sec.Items {
+				m := make(map[string]string, len(item))
+				for k, v := range item {
+					m[k] = v
+				}
+				out.Custom[i].Items[j] = m
+			}
+		}
+	}
+	return &out
+}
+
+// rewriteTexts 改写所有文本字段（结构化的个人信息字段按策略单独处理）
+func rewriteTexts(data *algorithm.ResumeData, fn func(string) string) {
+	list := []*string{&data.JobTitle, &data.Location, &data.Skills, &data.SelfEval}
+	for i := range data.Education {
+		e := &data.Education[i]
+		list = append(list, &e.Major, &e.Description)
+	}
+	for i := range data.CampusExp {
+		e := &data.CampusExp[i]
+		list = append(list, &e.Title, &e.Role, &e.Description)
+	}
+	for i := range data.InternExp {
+		e := &data.InternExp[i]
+		list = append(list, &e.Company, &e.Position, &e.Description)
+	}
+	for i := range data.WorkExp {
+		e := &data.WorkExp[i]
+		list = append(list, &e.Company, &e.Title, &e.Department, &e.Responsibilities, &e.Achievements)
+	}
+	for i := range data.ProjectExp {
+		e := &data.ProjectExp[i]
+		list = append(list, &e.ProjectName, &e.Role, &e.Description)
+	}
+	for _, s := range list {
+		*s = fn(*s)
+	}
+	for _, sec := range data.Custom {
+		for _, item := range sec.Items {
+			for k, v := range item {
+				item[k] = fn(v)
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package redact
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 文本中的个人信息识别
+// 身份证号、手机号按数字串匹配，前后紧邻数字时不算（避免把订单号、长数字中的片段当作手机号）
+
+var (
+	// idCardPattern 18 位（末位可为 X）或 15 位身份证号
+	idCardPattern = regexp.MustCompile(`\d{17}[\dXx]|\d{15}`)
+	// phonePattern 大陆手机号，允许 +86 前缀和空格、短横线分隔（138 1234 5678、138-1234-5678）
+	phonePattern = regexp.MustCompile(`(?:\+?86[\s-]?)?1[3-9]\d(?:[\s-]?\d{4}){2}`)
+	// emailPattern 邮箱地址
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+)
+
+// maskText 遮盖文本中的身份证号、手机号和邮箱
+func maskText(s string) string {
+	if s == "" {
+		return s
+	}
+	s = replaceDigits(s, idCardPattern, func(string) string { return removed })
+	s = replaceDigits(s, phonePattern, maskPhone)
+	return emailPattern.ReplaceAllStringFunc(s, maskEmail)
+}
+
+// replaceDigits 替换前后不紧邻数字的匹配
+func replaceDigits(s string, re *regexp.Regexp, fn func(string) string) string {
+	matches := re.FindAllStringIndex(s, -1)
+	if matches == nil {
+		return s
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		if digitBefore(s, m[0]) || digitAfter(s, m[1]) {
+			continue
+		}
+		b.WriteString(s[last:m[0]])
+		b.WriteString(fn(s[m[0]:m[1]]))
+		last = m[1]
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+func digitBefore(s string, i int) bool {
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return unicode.IsDigit(r)
+}
+
+func digitAfter(s string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return unicode.IsDigit(r)
+}
@@ -0,0 +1,55 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AppendModuleItemLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 新增模块条目
+func NewAppendModuleItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AppendModuleItemLogic {
+	return &AppendModuleItemLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AppendModuleItemLogic) AppendModuleItem(req *types.AppendModuleItemReq) (resp *types.ModuleItemResp, err error) {
+	if len(req.Item) == 0 {
+		return nil, errx.New(http.StatusBadRequest, "条目数据不能为空")
+	}
+
+	return editModuleItems(l.ctx, l.svcCtx, req.ResumeID, req.ModuleID, req.Revision,
+		func(items []map[string]interface{}) ([]map[string]interface{}, string, error) {
+			// 条目ID由服务端生成
+			item := make(map[string]interface{}, len(req.Item)+1)
+			for k, v := range req.Item {
+				item[k] = v
+			}
+			itemID := model.NewItemID()
+			item[model.ItemIDKey] = itemID
+
+			pos := clampPosition(req.Position, len(items))
+			items = append(items, nil)
+			copy(items[pos+1:], items[pos:])
+			items[pos] = item
+			return items, itemID, nil
+		})
+}
@@ -0,0 +1,99 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/pkg/snowflake"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateCustomModuleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 新增自定义模块
+func NewCreateCustomModuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCustomModuleLogic {
+	return &CreateCustomModuleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateCustomModuleLogic) CreateCustomModule(req *types.CreateCustomModuleReq) (resp *types.CustomModuleResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	title, err := normalizeCustomTitle(req.Title)
+	if err != nil {
+		return nil, err
+	}
+	_, content, err := loadOwnedContent(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, errx.New(http.StatusNotFound, "简历内容不存在")
+	}
+	if content.Revision != req.Revision {
+		return nil, errx.New(http.StatusConflict, "简历已被修改，请刷新后重试")
+	}
+
+	count := 0
+	for _, m := range content.Modules {
+		if m.Custom {
+			count++
+		}
+	}
+	if count >= maxCustomModules {
+		return nil, errx.Newf(http.StatusBadRequest, "每份简历最多添加 %d 个自定义模块", maxCustomModules)
+	}
+
+	tmpl, err := customTemplate(l.ctx, l.svcCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureHistoryBaseline(l.ctx, l.svcCtx, req.ResumeID, owner.UserID); err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "记录简历版本失败")
+	}
+
+	// 1. 追加空的自定义模块
+	mod := customModule(tmpl, snowflake.NextID(), title)
+	revision, err := l.svcCtx.Mongo.InsertModule(l.ctx, req.ResumeID, model.ModuleData{
+		ModuleID: mod.ID,
+		Title:    title,
+		Data:     []map[string]interface{}{},
+		Custom:   true,
+	}, req.Revision)
+	if err != nil {
+		return nil, contentWriteError(err, "新增自定义模块失败")
+	}
+
+	// 2. 重新渲染并写入版本快照（失败不影响操作结果）
+	afterContentChange(l.ctx, l.svcCtx, &model.ResumeContentHistory{
+		MySQLID:  req.ResumeID,
+		Action:   model.HistoryActionSave,
+		ModuleID: mod.ID,
+		AuthorID: owner.UserID,
+	})
+
+	l.Infof("custom module created: resume_id=%d, module_id=%d, title=%s", req.ResumeID, mod.ID, title)
+
+	return &types.CustomModuleResp{
+		Module:   buildModuleInfo(l.ctx, l.svcCtx, req.ResumeID, mod, []map[string]interface{}{}),
+		Revision: revision,
+	}, nil
+}
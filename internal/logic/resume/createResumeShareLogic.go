@@ -0,0 +1,82 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateResumeShareLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建简历分享链接
+func NewCreateResumeShareLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateResumeShareLogic {
+	return &CreateResumeShareLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateResumeShareLogic) CreateResumeShare(req *types.CreateResumeShareReq) (resp *types.ResumeShareItem, err error) {
+	r, err := loadOwnedResume(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 限制同时有效的链接数
+	now := time.Now()
+	active, err := l.svcCtx.Ent.ResumeShare.Query().
+		Where(
+			resumeshare.ResumeID(r.ID),
+			resumeshare.RevokedAtIsNil(),
+			resumeshare.ExpiresAtGT(now),
+		).
+		Count(l.ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询分享链接失败")
+	}
+	if active >= maxActiveShares {
+		return nil, errx.Newf(http.StatusBadRequest, "每份简历最多同时有 %d 个有效的分享链接，请先撤销不用的链接", maxActiveShares)
+	}
+
+	// 2. 生成令牌并保存
+	passwordHash, err := hashSharePassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
+	token, err := newShareToken()
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "生成分享令牌失败")
+	}
+	s, err := l.svcCtx.Ent.ResumeShare.Create().
+		SetResumeID(r.ID).
+		SetUserID(r.UserID).
+		SetTenantID(r.TenantID).
+		SetToken(token).
+		SetPermission(resumeshare.Permission(req.Permission)).
+		SetRedact(req.Redact).
+		SetPasswordHash(passwordHash).
+		SetExpiresAt(now.Add(time.Duration(req.ExpiresIn) * time.Second)).
+		Save(l.ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "创建分享链接失败")
+	}
+
+	l.Infof("resume share created: resume_id=%d, share_id=%d, permission=%s, redact=%t", r.ID, s.ID, s.Permission, s.Redact)
+	item := shareItem(l.svcCtx, s)
+	return &item, nil
+}
@@ -0,0 +1,140 @@
+package resume
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/module"
+	"cv2/internal/infra/moduleregistry"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+)
+
+// 用户自定义模块
+// 自定义模块只存在于简历内容中（ModuleData.Custom），模块ID在创建时生成；
+// 评分规则、维度、形态和 schema 沿用模块表中编码为 custom 的通用模块
+
+const (
+	maxCustomModules     = 10 // 每份简历最多的自定义模块数
+	maxCustomModuleTitle = 30 // 自定义模块标题最大长度（字符）
+)
+
+// isCustomModule 是否为通用模块或基于通用模块构造的自定义模块
+func isCustomModule(mod *ent.Module) bool {
+	return moduleCode(mod) == moduleregistry.CodeCustom
+}
+
+// registryModule 返回模块的注册信息，自定义模块沿用通用模块的规则
+func registryModule(registry *moduleregistry.Registry, mod *ent.Module) (moduleregistry.Module, bool) {
+	if isCustomModule(mod) {
+		return registry.Custom(mod.ID, mod.Title)
+	}
+	return registry.ByID(mod.ID)
+}
+
+// loadResumeModule 加载简历中的单个模块（含维度），不存在时返回 404
+func loadResumeModule(ctx context.Context, svcCtx *svc.ServiceContext, modules []model.ModuleData, moduleID int64) (*ent.Module, error) {
+	mods, err := loadResumeModules(ctx, svcCtx, modules, []int64{moduleID})
+	if err != nil {
+		return nil, err
+	}
+	if len(mods) == 0 {
+		return nil, errx.New(http.StatusNotFound, "模块不存在")
+	}
+	return mods[0], nil
+}
+
+// loadResumeModules 按模块ID加载简历中的模块（含维度）
+// 内置模块查询模块表；自定义模块在 modules 中查找标题，基于通用模块构造；找不到的模块ID忽略
+func loadResumeModules(ctx context.Context, svcCtx *svc.ServiceContext, modules []model.ModuleData, ids []int64) ([]*ent.Module, error) {
+	rows, err := svcCtx.Ent.Module.Query().
+		Where(module.IDIn(ids...)).
+		WithDimensions().
+		All(ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "获取模块信息失败")
+	}
+
+	result := make([]*ent.Module, 0, len(ids))
+	found := make(map[int64]bool, len(rows))
+	for _, row := range rows {
+		// 通用模块只作为规则模板，不能直接编辑
+		if isCustomModule(row) {
+			continue
+		}
+		result = append(result, row)
+		found[row.ID] = true
+	}
+
+	customTitles := make(map[int64]string)
+	for _, m := range modules {
+		if m.Custom {
+			customTitles[m.ModuleID] = m.Title
+		}
+	}
+
+	var tmpl *ent.Module
+	for _, id := range ids {
+		title, ok := customTitles[id]
+		if found[id] || !ok {
+			continue
+		}
+		if tmpl == nil {
+			if tmpl, err = customTemplate(ctx, svcCtx); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, customModule(tmpl, id, title))
+		found[id] = true
+	}
+	return result, nil
+}
+
+// customTemplate 查询通用模块（含维度）
+func customTemplate(ctx context.Context, svcCtx *svc.ServiceContext) (*ent.Module, error) {
+	m, ok := svcCtx.Modules.ByCode(moduleregistry.CodeCustom)
+	if !ok {
+		return nil, errx.New(http.StatusInternalServerError, "通用模块未配置")
+	}
+	tmpl, err := svcCtx.Ent.Module.Query().
+		Where(module.ID(m.ID)).
+		WithDimensions().
+		Only(ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "获取通用模块失败")
+	}
+	return tmpl, nil
+}
+
+// customModule 基于通用模块构造自定义模块，维度沿用通用模块
+func customModule(tmpl *ent.Module, id int64, title string) *ent.Module {
+	mod := *tmpl
+	mod.ID, mod.Title = id, title
+	return &mod
+}
+
+// findCustomModule 在简历内容中查找自定义模块，不存在或不是自定义模块时返回 404
+func findCustomModule(content *model.ResumeContent, moduleID int64) (*model.ModuleData, error) {
+	for i := range content.Modules {
+		if content.Modules[i].ModuleID == moduleID && content.Modules[i].Custom {
+			return &content.Modules[i], nil
+		}
+	}
+	return nil, errx.New(http.StatusNotFound, "自定义模块不存在")
+}
+
+// normalizeCustomTitle 校验并规整自定义模块标题
+func normalizeCustomTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", errx.New(http.StatusBadRequest, "模块标题不能为空")
+	}
+	if utf8.RuneCountInString(title) > maxCustomModuleTitle {
+		return "", errx.Newf(http.StatusBadRequest, "模块标题不能超过 %d 个字符", maxCustomModuleTitle)
+	}
+	return title, nil
+}
@@ -0,0 +1,80 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/ent/scorerun"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteCustomModuleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除自定义模块
+func NewDeleteCustomModuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteCustomModuleLogic {
+	return &DeleteCustomModuleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteCustomModuleLogic) DeleteCustomModule(req *types.DeleteCustomModuleReq) (resp *types.DeleteCustomModuleResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, content, err := loadOwnedContent(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, errx.New(http.StatusNotFound, "简历内容不存在")
+	}
+	if content.Revision != req.Revision {
+		return nil, errx.New(http.StatusConflict, "简历已被修改，请刷新后重试")
+	}
+	if _, err := findCustomModule(content, req.ModuleID); err != nil {
+		return nil, err
+	}
+
+	if err := ensureHistoryBaseline(l.ctx, l.svcCtx, req.ResumeID, owner.UserID); err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "记录简历版本失败")
+	}
+
+	// 1. 从简历内容中移除
+	revision, err := l.svcCtx.Mongo.RemoveModule(l.ctx, req.ResumeID, req.ModuleID, req.Revision)
+	if err != nil {
+		return nil, contentWriteError(err, "删除自定义模块失败")
+	}
+
+	// 2. 清除该模块的评分（数据为空时只清除不重算）
+	queueModuleScores(l.ctx, l.svcCtx, req.ResumeID, revision, content.Modules, map[int64][]map[string]interface{}{req.ModuleID: nil}, scorerun.TriggerSave)
+
+	// 3. 重新渲染并写入版本快照（失败不影响操作结果），可通过回滚恢复
+	afterContentChange(l.ctx, l.svcCtx, &model.ResumeContentHistory{
+		MySQLID:  req.ResumeID,
+		Action:   model.HistoryActionSave,
+		ModuleID: req.ModuleID,
+		AuthorID: owner.UserID,
+	})
+
+	l.Infof("custom module deleted: resume_id=%d, module_id=%d", req.ResumeID, req.ModuleID)
+
+	return &types.DeleteCustomModuleResp{
+		ModuleID: req.ModuleID,
+		Revision: revision,
+	}, nil
+}
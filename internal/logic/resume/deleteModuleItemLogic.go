@@ -0,0 +1,39 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteModuleItemLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除模块条目
+func NewDeleteModuleItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteModuleItemLogic {
+	return &DeleteModuleItemLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteModuleItemLogic) DeleteModuleItem(req *types.DeleteModuleItemReq) (resp *types.ModuleItemResp, err error) {
+	return editModuleItems(l.ctx, l.svcCtx, req.ResumeID, req.ModuleID, req.Revision,
+		func(items []map[string]interface{}) ([]map[string]interface{}, string, error) {
+			i, err := findModuleItem(items, req.ItemID)
+			if err != nil {
+				return nil, "", err
+			}
+			return append(items[:i], items[i+1:]...), req.ItemID, nil
+		})
+}
@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteResumeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除简历（移入回收站）
+func NewDeleteResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteResumeLogic {
+	return &DeleteResumeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteResumeLogic) DeleteResume(req *types.DeleteResumeReq) (resp *types.DeleteResumeResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 软删除：SoftDeleteMixin 会将删除转换为写入 deleted_at
+	n, err := l.svcCtx.Ent.Resume.Delete().
+		Where(resume.ID(req.ResumeID)).
+		Where(owner.predicates()...).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "删除简历失败")
+	}
+	if n == 0 {
+		return nil, errx.New(http.StatusNotFound, "简历不存在")
+	}
+
+	deletedAt := time.Now()
+	l.Infof("resume moved to trash: resume_id=%d, user_id=%d", req.ResumeID, owner.UserID)
+
+	return &types.DeleteResumeResp{
+		ResumeID:  req.ResumeID,
+		DeletedAt: deletedAt.Format(timeLayout),
+		PurgeAt:   trashPurgeAt(l.svcCtx, deletedAt).Format(timeLayout),
+	}, nil
+}
@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DiffResumeVersionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 对比简历版本
+func NewDiffResumeVersionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DiffResumeVersionsLogic {
+	return &DiffResumeVersionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DiffResumeVersionsLogic) DiffResumeVersions(req *types.DiffResumeVersionsReq) (resp *types.DiffResumeVersionsResp, err error) {
+	_, content, err := loadOwnedContent(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := loadResumeVersion(l.ctx, l.svcCtx, req.ResumeID, req.From)
+	if err != nil {
+		return nil, err
+	}
+
+	// 未指定目标版本时与当前内容对比
+	var to []model.ModuleData
+	if req.To != "" {
+		toVersion, err := loadResumeVersion(l.ctx, l.svcCtx, req.ResumeID, req.To)
+		if err != nil {
+			return nil, err
+		}
+		to = toVersion.Modules
+	} else if content != nil {
+		to = content.Modules
+	}
+
+	return &types.DiffResumeVersionsResp{
+		From:    req.From,
+		To:      req.To,
+		Changes: diffModules(from.Modules, to, req.ModuleID),
+	}, nil
+}
@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DownloadSharedResumeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 下载分享的简历 PDF（返回预签名下载 URL）
+func NewDownloadSharedResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DownloadSharedResumeLogic {
+	return &DownloadSharedResumeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DownloadSharedResumeLogic) DownloadSharedResume(req *types.ShareAccessReq) (resp *types.ShareDownloadResp, err error) {
+	s, r, content, err := accessShare(l.ctx, l.svcCtx, req)
+	if err != nil {
+		return nil, err
+	}
+	if s.Permission != resumeshare.PermissionDownload {
+		return nil, errx.New(http.StatusForbidden, "该分享链接不允许下载")
+	}
+
+	// 与导出接口共用同一份 PDF
+	objectKey, data, err := ensureExport(l.ctx, l.svcCtx, r, content, "pdf", s.Redact)
+	if err != nil {
+		return nil, err
+	}
+	fileName := exportFileName(data, exportFormats["pdf"])
+	downloadURL, err := l.svcCtx.MinIO.GetPresignedDownloadURL(l.ctx, objectKey, exportURLExpires, fileName)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "生成下载链接失败")
+	}
+	recordShareView(l.ctx, l.svcCtx, s, resumeshareview.ActionDownload, req)
+
+	return &types.ShareDownloadResp{
+		DownloadURL: downloadURL,
+		FileName:    fileName,
+		ExpiresIn:   int64(exportURLExpires.Seconds()),
+	}, nil
+}
@@ -0,0 +1,124 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/minio"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/infra/redact"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// exportURLExpires 导出下载链接有效期
+const exportURLExpires = 5 * time.Minute
+
+type ExportResumeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 导出简历（返回预签名下载 URL）
+func NewExportResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportResumeLogic {
+	return &ExportResumeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ExportResumeLogic) ExportResume(req *types.ExportResumeReq) (resp *types.ExportResumeResp, err error) {
+	format, ok := exportFormats[req.Format]
+	if !ok {
+		return nil, errx.Newf(http.StatusBadRequest, "不支持的导出格式: %s", req.Format)
+	}
+
+	r, content, err := loadOwnedContent(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, errx.New(http.StatusNotFound, "简历内容不存在")
+	}
+
+	// 1. 同一内容版本的导出文件只生成一次
+	objectKey, data, err := ensureExport(l.ctx, l.svcCtx, r, content, req.Format, req.Redact)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 生成短期有效的下载链接，文件名取候选人姓名
+	fileName := exportFileName(data, format)
+	downloadURL, err := l.svcCtx.MinIO.GetPresignedDownloadURL(l.ctx, objectKey, exportURLExpires, fileName)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "生成下载链接失败")
+	}
+
+	return &types.ExportResumeResp{
+		DownloadURL: downloadURL,
+		FileName:    fileName,
+		Format:      req.Format,
+		Revision:    content.Revision,
+		Redacted:    req.Redact,
+		ExpiresIn:   int64(exportURLExpires.Seconds()),
+	}, nil
+}
+
+// ensureExport 返回当前内容版本的导出文件及导出的数据，文件不存在时生成并上传
+// PDF 还按模板区分，不脱敏时与自动渲染的 PDF 使用同一个 key；脱敏文件按策略指纹区分，修改策略后重新生成
+func ensureExport(ctx context.Context, svcCtx *svc.ServiceContext, r *ent.Resume, content *model.ResumeContent, name string, redacted bool) (string, *algorithm.ResumeData, error) {
+	data := buildResumeData(svcCtx.Modules, content.Modules, 0)
+	object := exportFormats[name].object
+	if name == "pdf" {
+		object = string(r.Template) + ".pdf"
+	}
+	if redacted {
+		data = redact.Apply(data, svcCtx.Redaction)
+		object = "redacted-" + svcCtx.Redaction.Fingerprint() + "-" + object
+	}
+	objectKey := minio.RenderObjectKey(r.ID, content.Revision, object)
+	exists, err := svcCtx.MinIO.ObjectExists(ctx, objectKey)
+	if err != nil {
+		return "", nil, errx.Warp(http.StatusInternalServerError, err, "查询导出文件失败")
+	}
+	if !exists {
+		if err := generateExport(ctx, svcCtx, r.ID, content.Revision, name, string(r.Template), data, objectKey); err != nil {
+			return "", nil, err
+		}
+		// 清理旧版本的导出文件，当前对外的 PDF 可能尚未重新渲染，保留
+		currentKey, _ := svcCtx.MinIO.ObjectKeyFromURL(r.FilePath)
+		pruneRenders(ctx, svcCtx, r.ID, content.Revision, currentKey)
+	}
+	return objectKey, data, nil
+}
+
+// generateExport 生成导出文件并上传到 objectKey
+func generateExport(ctx context.Context, svcCtx *svc.ServiceContext, resumeID, revision int64, name, template string, data *algorithm.ResumeData, objectKey string) error {
+	if name == "pdf" {
+		if err := NewPDFGenerator(svcCtx.Algorithm, svcCtx.Markdown, svcCtx.PDF, svcCtx.MinIO).RenderAndUpload(ctx, resumeID, data, template, objectKey); err != nil {
+			return errx.Warp(http.StatusBadGateway, err, "生成 PDF 失败")
+		}
+		return nil
+	}
+
+	b, err := renderExport(ctx, svcCtx, data, name)
+	if err != nil {
+		return errx.Warpf(http.StatusBadGateway, err, "生成 %s 文件失败", name)
+	}
+	if err := svcCtx.MinIO.UploadBytes(ctx, objectKey, b, exportFormats[name].contentType); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "上传导出文件失败")
+	}
+	logx.WithContext(ctx).Infof("resume exported: resume_id=%d, revision=%d, key=%s", resumeID, revision, objectKey)
+	return nil
+}
@@ -29,9 +29,10 @@ func NewGenerateResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ge
 }
 
 func (l *GenerateResumeLogic) GenerateResume(req *types.GenerateResumeReq) (resp *types.GenerateResumeResp, err error) {
-	// TODO: 从 context 获取 userID 和 tenantID
-	userID := int64(1)   // 临时硬编码
-	tenantID := int64(1) // 临时硬编码
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// 1. 转换为 algorithm.QuestionAnswer
 	qas := make([]algorithm.QuestionAnswer, len(req.Questions))
@@ -61,8 +62,8 @@ func (l *GenerateResumeLogic) GenerateResume(req *types.GenerateResumeReq) (resp
 	err = l.svcCtx.Redis.HSet(l.ctx, key, map[string]any{
 		"status":    statusPending,
 		"resume_id": resumeID,
-		"user_id":   userID,
-		"tenant_id": tenantID,
+		"user_id":   owner.UserID,
+		"tenant_id": owner.TenantID,
 	}).Err()
 	if err != nil {
 		l.Errorf("redis hset failed: %v", err)
@@ -73,7 +74,7 @@ func (l *GenerateResumeLogic) GenerateResume(req *types.GenerateResumeReq) (resp
 	l.svcCtx.Redis.Expire(l.ctx, key, 12*time.Hour)
 
 	// 6. 启动后台监控
-	go l.monitorTask(context.Background(), taskID, resumeID, userID, tenantID)
+	go l.monitorTask(context.Background(), taskID, resumeID, owner)
 
 	l.Infof("resume generation task created: task_id=%s, resume_id=%d", taskID, resumeID)
 
@@ -86,7 +87,8 @@ func (l *GenerateResumeLogic) GenerateResume(req *types.GenerateResumeReq) (resp
 func (l *GenerateResumeLogic) monitorTask(
 	ctx context.Context,
 	taskID string,
-	resumeID, userID, tenantID int64,
+	resumeID int64,
+	owner *resumeOwner,
 ) {
 	ticker := time.NewTicker(20 * time.Second)
 	defer ticker.Stop()
@@ -106,7 +108,7 @@ func (l *GenerateResumeLogic) monitorTask(
 
 			switch status.Status {
 			case "SUCCESS":
-				l.handleSuccess(ctx, taskID, resumeID, userID, tenantID, status.Result)
+				l.handleSuccess(ctx, taskID, resumeID, owner, status.Result)
 				return
 			case "FAILURE":
 				updateRedisStatus(ctx, l.svcCtx, resumeTaskKeyPrefix, taskID, statusFailure, 0)
@@ -126,14 +128,15 @@ func (l *GenerateResumeLogic) monitorTask(
 func (l *GenerateResumeLogic) handleSuccess(
 	ctx context.Context,
 	taskID string,
-	resumeID, userID, tenantID int64,
+	resumeID int64,
+	owner *resumeOwner,
 	data *algorithm.ResumeData,
 ) {
 	// 1. 保存简历数据和评分
-	err := saveAndProcessResume(ctx, l.svcCtx, resumeID, userID, tenantID, nil, data)
+	err := saveAndProcessResume(ctx, l.svcCtx, resumeID, owner, nil, data)
 	if err != nil {
 		l.Errorf("save resume data failed: %v", err)
-		updateRedisStatus(ctx, l.svcCtx, resumeTaskKeyPrefix, taskID, statusFailure, 0)
+		updateRedisFailure(ctx, l.svcCtx, resumeTaskKeyPrefix, taskID, err)
 		return
 	}
 
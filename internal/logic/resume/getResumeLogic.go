@@ -7,6 +7,7 @@ import (
 	"context"
 	"net/http"
 
+	"cv2/internal/infra/ent"
 	"cv2/internal/infra/ent/resumescore"
 	"cv2/internal/pkg/errx"
 	"cv2/internal/svc"
@@ -31,19 +32,13 @@ func NewGetResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetResu
 }
 
 func (l *GetResumeLogic) GetResume(req *types.GetResumeReq) (resp *types.GetResumeResp, err error) {
-	// 1. 查询简历基本信息
-	resume, err := l.svcCtx.Ent.Resume.Get(l.ctx, req.ResumeID)
+	// 1. 查询简历基本信息及 MongoDB 内容（校验归属）
+	resume, content, err := loadOwnedContent(l.ctx, l.svcCtx, req.ResumeID)
 	if err != nil {
-		return nil, errx.Warp(http.StatusNotFound, err, "简历不存在")
+		return nil, err
 	}
 
-	// 2. 从 MongoDB 获取简历内容
-	content, err := l.svcCtx.Mongo.GetResumeContent(l.ctx, req.ResumeID)
-	if err != nil {
-		return nil, errx.Warp(http.StatusInternalServerError, err, "获取简历内容失败")
-	}
-
-	// 3. 查询所有模块（含维度）
+	// 2. 查询所有模块（含维度）
 	modules, err := l.svcCtx.Ent.Module.Query().
 		WithDimensions().
 		All(l.ctx)
@@ -51,7 +46,7 @@ func (l *GetResumeLogic) GetResume(req *types.GetResumeReq) (resp *types.GetResu
 		return nil, errx.Warp(http.StatusInternalServerError, err, "获取模块信息失败")
 	}
 
-	// 4. 查询简历得分
+	// 3. 查询简历得分
 	scores, err := l.svcCtx.Ent.ResumeScore.Query().
 		Where(resumescore.ResumeID(req.ResumeID)).
 		All(l.ctx)
@@ -59,14 +54,20 @@ func (l *GetResumeLogic) GetResume(req *types.GetResumeReq) (resp *types.GetResu
 		return nil, errx.Warp(http.StatusInternalServerError, err, "获取简历得分失败")
 	}
 
-	// 构建得分映射
-	moduleScoreMap := make(map[int64]float64)
-	dimScoreMap := make(map[int64]float64)
+	// 构建得分映射（自定义模块的维度得分按 scope_id 区分）
+	moduleScoreMap := make(map[int64]*ent.ResumeScore)
+	dimScoreMap := make(map[dimScoreKey]*ent.ResumeScore)
+	var moduleWeight float64
+	scoring := scoringDone
 	for _, s := range scores {
 		if s.TargetType == 0 { // 模块得分
-			moduleScoreMap[s.TargetID] = s.Score
+			moduleScoreMap[s.TargetID] = s
+			moduleWeight += s.Weight
+			if s.State == resumescore.StatePending {
+				scoring = scoringPending
+			}
 		} else { // 维度得分
-			dimScoreMap[s.TargetID] = s.Score
+			dimScoreMap[dimScoreKey{scopeID: s.ScopeID, dimID: s.TargetID}] = s
 		}
 	}
 
@@ -78,36 +79,96 @@ func (l *GetResumeLogic) GetResume(req *types.GetResumeReq) (resp *types.GetResu
 		}
 	}
 
-	// 5. 构建统一的模块列表（数据 + 得分）
+	// 4. 构建统一的模块列表（数据 + 得分），内置模块在前，自定义模块按添加顺序在后
 	var moduleInfos []types.ModuleInfo
-	var totalScore float64
+	var tmpl *ent.Module
 	for _, m := range modules {
-		mi := types.ModuleInfo{
-			ModuleID:   m.ID,
-			Title:      m.Title,
-			Score:      moduleScoreMap[m.ID],
-			Data:       moduleDataMap[m.ID],
-			Dimensions: []types.DimensionInfo{},
+		if isCustomModule(m) {
+			tmpl = m
+			continue
 		}
-		totalScore += moduleScoreMap[m.ID]
-
-		for _, dim := range m.Edges.Dimensions {
-			mi.Dimensions = append(mi.Dimensions, types.DimensionInfo{
-				DimensionID: dim.ID,
-				Title:       dim.Title,
-				Score:       dimScoreMap[dim.ID],
-			})
+		moduleInfos = append(moduleInfos, resumeModuleInfo(m, 0, moduleDataMap[m.ID], moduleScoreMap, dimScoreMap, moduleWeight))
+	}
+	if content != nil && tmpl != nil {
+		for _, cm := range content.Modules {
+			if !cm.Custom {
+				continue
+			}
+			mod := customModule(tmpl, cm.ModuleID, cm.Title)
+			moduleInfos = append(moduleInfos, resumeModuleInfo(mod, mod.ID, cm.Data, moduleScoreMap, dimScoreMap, moduleWeight))
 		}
-		moduleInfos = append(moduleInfos, mi)
+	}
+
+	var revision int64
+	if content != nil {
+		revision = content.Revision
 	}
 
 	return &types.GetResumeResp{
-		ResumeID:   resume.ID,
-		FileName:   resume.FileName,
-		Status:     resume.Status,
-		TotalScore: totalScore,
-		Modules:    moduleInfos,
-		CreatedAt:  resume.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:  resume.UpdatedAt.Format("2006-01-02 15:04:05"),
+		ResumeID:         resume.ID,
+		FileName:         resume.FileName,
+		Status:           resume.Status,
+		TotalScore:       weightedTotal(scores),
+		Modules:          moduleInfos,
+		Revision:         revision,
+		FilePath:         resume.FilePath,
+		RenderStatus:     string(resume.RenderStatus),
+		Template:         string(resume.Template),
+		Scoring:          scoring,
+		RenderedRevision: resume.RenderedRevision,
+		CreatedAt:        resume.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:        resume.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}, nil
 }
+
+// dimScoreKey 维度得分的索引，内置模块的 scopeID 为 0
+type dimScoreKey struct {
+	scopeID int64
+	dimID   int64
+}
+
+// resumeModuleInfo 构建单个模块的信息（数据 + 得分），moduleWeight 为简历中已评分模块的权重之和
+func resumeModuleInfo(m *ent.Module, scopeID int64, data []map[string]interface{}, moduleScores map[int64]*ent.ResumeScore, dimScores map[dimScoreKey]*ent.ResumeScore, moduleWeight float64) types.ModuleInfo {
+	dims := make(map[int64]*ent.ResumeScore, len(m.Edges.Dimensions))
+	for _, dim := range m.Edges.Dimensions {
+		if s, ok := dimScores[dimScoreKey{scopeID: scopeID, dimID: dim.ID}]; ok {
+			dims[dim.ID] = s
+		}
+	}
+	return moduleInfo(m, data, moduleScores[m.ID], dims, moduleWeight)
+}
+
+// moduleInfo 由模块得分和维度得分构建模块信息，未评分时得分和权重占比均为 0，评分状态为空
+// 权重占比按评分记录中保存的权重计算，与得分计算时使用的权重一致
+func moduleInfo(m *ent.Module, data []map[string]interface{}, moduleScore *ent.ResumeScore, dimScores map[int64]*ent.ResumeScore, moduleWeight float64) types.ModuleInfo {
+	mi := types.ModuleInfo{
+		ModuleID:   m.ID,
+		Code:       moduleCode(m),
+		Shape:      string(m.Shape),
+		Title:      m.Title,
+		Data:       data,
+		Dimensions: []types.DimensionInfo{},
+	}
+	if moduleScore != nil {
+		mi.Score = moduleScore.Score
+		mi.Weight = weightShare(moduleScore.Weight, moduleWeight)
+		mi.ScoreState = string(moduleScore.State)
+	}
+
+	var dimWeight float64
+	for _, s := range dimScores {
+		dimWeight += s.Weight
+	}
+	for _, dim := range m.Edges.Dimensions {
+		di := types.DimensionInfo{
+			DimensionID: dim.ID,
+			Title:       dim.Title,
+		}
+		if s, ok := dimScores[dim.ID]; ok {
+			di.Score = s.Score
+			di.Weight = weightShare(s.Weight, dimWeight)
+		}
+		mi.Dimensions = append(mi.Dimensions, di)
+	}
+	return mi
+}
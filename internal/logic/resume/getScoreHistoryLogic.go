@@ -0,0 +1,89 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetScoreHistoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取评分历史和得分变化趋势
+func NewGetScoreHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetScoreHistoryLogic {
+	return &GetScoreHistoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetScoreHistoryLogic) GetScoreHistory(req *types.ScoreHistoryReq) (resp *types.ScoreHistoryResp, err error) {
+	if _, err := loadOwnedResume(l.ctx, l.svcCtx, req.ResumeID); err != nil {
+		return nil, err
+	}
+
+	// 1. 最近的 limit 次评分，按时间升序返回
+	runs, err := l.svcCtx.Ent.ScoreRun.Query().
+		Where(scorerun.ResumeID(req.ResumeID)).
+		Order(ent.Desc(scorerun.FieldCreatedAt), ent.Desc(scorerun.FieldID)).
+		Limit(req.Limit).
+		All(l.ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询评分历史失败")
+	}
+	for i, j := 0, len(runs)-1; i < j; i, j = i+1, j-1 {
+		runs[i], runs[j] = runs[j], runs[i]
+	}
+
+	// 2. 这些评分记录中的模块得分
+	runIDs := make([]int64, 0, len(runs))
+	for _, run := range runs {
+		runIDs = append(runIDs, run.ID)
+	}
+	rows, err := l.svcCtx.Ent.ScoreHistory.Query().
+		Where(scorehistory.RunIDIn(runIDs...)).
+		All(l.ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询评分历史失败")
+	}
+
+	resp = &types.ScoreHistoryResp{
+		Runs:    make([]types.ScoreRunItem, 0, len(runs)),
+		Modules: scoreSeries(runs, rows),
+	}
+	for i, run := range runs {
+		item := types.ScoreRunItem{
+			RunID:       run.ID,
+			Trigger:     string(run.Trigger),
+			RuleVersion: run.RuleVersion,
+			TotalScore:  run.TotalScore,
+			Status:      string(run.Status),
+			Failures:    scoreFailures(run.Failures),
+			CreatedAt:   run.CreatedAt.Format(timeLayout),
+		}
+		if i > 0 {
+			item.Delta = run.TotalScore - runs[i-1].TotalScore
+		}
+		resp.Runs = append(resp.Runs, item)
+	}
+	if resp.Modules == nil {
+		resp.Modules = []types.ModuleScoreSeries{}
+	}
+	resp.Gains, resp.Regressions = scoreChanges(runs, resp.Modules, req.Top)
+	return resp, nil
+}
@@ -0,0 +1,42 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetScoreStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询评分状态
+func NewGetScoreStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetScoreStatusLogic {
+	return &GetScoreStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetScoreStatusLogic) GetScoreStatus(req *types.ScoreStatusReq) (resp *types.ScoreStatusResp, err error) {
+	if _, err := loadOwnedResume(l.ctx, l.svcCtx, req.ResumeID); err != nil {
+		return nil, err
+	}
+
+	resp, err = resumeScoreStatus(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询评分状态失败")
+	}
+	return resp, nil
+}
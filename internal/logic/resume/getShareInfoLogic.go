@@ -0,0 +1,42 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetShareInfoLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取分享链接信息
+func NewGetShareInfoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetShareInfoLogic {
+	return &GetShareInfoLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// GetShareInfo 返回访问分享链接前需要的信息（是否需要密码等），不记录访问
+func (l *GetShareInfoLogic) GetShareInfo(req *types.ShareInfoReq) (resp *types.ShareInfoResp, err error) {
+	s, err := loadActiveShare(l.ctx, l.svcCtx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ShareInfoResp{
+		Permission:      string(s.Permission),
+		RequirePassword: s.PasswordHash != "",
+		ExpiresAt:       formatOptionalTime(s.ExpiresAt),
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"cv2/internal/pkg/errx"
 	"cv2/internal/svc"
 	"cv2/internal/types"
+	"encoding/json"
 	"net/http"
 	"strconv"
 
@@ -31,6 +32,11 @@ func NewGetTaskStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Get
 }
 
 func (l *GetTaskStatusLogic) GetTaskStatus(req *types.TaskStatusReq) (resp *types.TaskStatusResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	taskID := req.TaskID
 	key := resumeTaskKeyPrefix + taskID
 
@@ -44,13 +50,22 @@ func (l *GetTaskStatusLogic) GetTaskStatus(req *types.TaskStatusReq) (resp *type
 		return nil, errx.Warp(http.StatusInternalServerError, err, "查询任务状态失败")
 	}
 
-	if len(result) == 0 {
+	// 任务不属于当前用户时按不存在处理
+	if len(result) == 0 || !owner.ownsTask(result) {
 		return nil, errx.New(http.StatusNotFound, "任务不存在")
 	}
 
 	// 构造响应
 	resp = &types.TaskStatusResp{
-		Status: result["status"],
+		Status:  result["status"],
+		Message: result["message"],
+	}
+
+	// 数据校验失败时返回字段错误
+	if errs, ok := result["errors"]; ok && errs != "" {
+		if err := json.Unmarshal([]byte(errs), &resp.Errors); err != nil {
+			l.Errorf("unmarshal task errors failed: task_id=%s, err=%v", taskID, err)
+		}
 	}
 
 	// 如果有 resume_id，转换并返回
@@ -0,0 +1,83 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"cv2/internal/pkg/errx"
+	"cv2/internal/pkg/snowflake"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportResumeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 导入其他格式的简历（JSON Resume、Europass）
+func NewImportResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportResumeLogic {
+	return &ImportResumeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ImportResumeLogic) ImportResume(format string, fileHeader *multipart.FileHeader) (resp *types.ImportResumeResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, ok := importFormats[format]
+	if !ok {
+		return nil, errx.Newf(http.StatusBadRequest, "不支持的导入格式: %s", format)
+	}
+	if fileHeader.Size > maxImportFileSize {
+		return nil, errx.New(http.StatusBadRequest, "文件过大（最大2MB）")
+	}
+
+	// 1. 读取并转换文件
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, errx.Warp(http.StatusBadRequest, err, "读取文件失败")
+	}
+	defer file.Close()
+	b, err := io.ReadAll(io.LimitReader(file, maxImportFileSize))
+	if err != nil {
+		return nil, errx.Warp(http.StatusBadRequest, err, "读取文件失败")
+	}
+	im, err := f.parse(b)
+	if err != nil {
+		return nil, errx.Warpf(http.StatusBadRequest, err, "文件不是有效的 %s 文档", f.name)
+	}
+	if im.Empty() {
+		return nil, errx.New(http.StatusBadRequest, "文件中没有可导入的简历内容")
+	}
+	if len(im.Data.Custom) > maxCustomModules {
+		return nil, errx.Newf(http.StatusBadRequest, "导入后的自定义模块超过 %d 个", maxCustomModules)
+	}
+
+	// 2. 与上传的简历走同样的保存流程，PDF 异步生成
+	resumeID := snowflake.NextID()
+	if err := saveAndProcessResume(l.ctx, l.svcCtx, resumeID, owner, nil, im.Data); err != nil {
+		return nil, err
+	}
+
+	l.Infof("resume imported: resume_id=%d, format=%s, mappings=%d", resumeID, format, len(im.Report.Mappings))
+
+	return &types.ImportResumeResp{
+		ResumeID: resumeID,
+		Format:   format,
+		Mappings: importMappings(im.Report),
+	}, nil
+}
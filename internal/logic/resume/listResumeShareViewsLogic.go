@@ -0,0 +1,76 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"cv2/internal/infra/ent/resumeshareview"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListResumeShareViewsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取分享链接访问记录
+func NewListResumeShareViewsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListResumeShareViewsLogic {
+	return &ListResumeShareViewsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListResumeShareViewsLogic) ListResumeShareViews(req *types.ListResumeShareViewsReq) (resp *types.ListResumeShareViewsResp, err error) {
+	s, err := loadOwnedShare(l.ctx, l.svcCtx, req.ResumeID, req.ShareID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 雪花ID随时间递增，按ID倒序即按访问时间倒序，游标为上一页最后一条的ID
+	query := l.svcCtx.Ent.ResumeShareView.Query().Where(resumeshareview.ShareID(s.ID))
+	if req.Cursor != "" {
+		before, err := strconv.ParseInt(req.Cursor, 10, 64)
+		if err != nil {
+			return nil, errx.Warp(http.StatusBadRequest, err, "分页游标无效")
+		}
+		query = query.Where(resumeshareview.IDLT(before))
+	}
+	rows, err := query.
+		Order(resumeshareview.ByID(sql.OrderDesc())).
+		Limit(req.Limit + 1).
+		All(l.ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询访问记录失败")
+	}
+
+	resp = &types.ListResumeShareViewsResp{Items: []types.ResumeShareViewItem{}}
+	if len(rows) > req.Limit {
+		rows = rows[:req.Limit]
+		resp.HasMore = true
+	}
+	for _, v := range rows {
+		resp.Items = append(resp.Items, types.ResumeShareViewItem{
+			ViewID:    v.ID,
+			Action:    string(v.Action),
+			Referrer:  v.Referrer,
+			UserAgent: v.UserAgent,
+			ViewedAt:  v.CreatedAt.Format(timeLayout),
+		})
+	}
+	if resp.HasMore {
+		resp.NextCursor = strconv.FormatInt(rows[len(rows)-1].ID, 10)
+	}
+	return resp, nil
+}
@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListResumeSharesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取简历分享链接列表
+func NewListResumeSharesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListResumeSharesLogic {
+	return &ListResumeSharesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListResumeSharesLogic) ListResumeShares(req *types.ListResumeSharesReq) (resp *types.ListResumeSharesResp, err error) {
+	if _, err := loadOwnedResume(l.ctx, l.svcCtx, req.ResumeID); err != nil {
+		return nil, err
+	}
+
+	rows, err := l.svcCtx.Ent.ResumeShare.Query().
+		Where(resumeshare.ResumeID(req.ResumeID)).
+		Order(resumeshare.ByCreatedAt(sql.OrderDesc()), resumeshare.ByID(sql.OrderDesc())).
+		All(l.ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询分享链接失败")
+	}
+
+	resp = &types.ListResumeSharesResp{Items: make([]types.ResumeShareItem, 0, len(rows))}
+	for _, s := range rows {
+		resp.Items = append(resp.Items, shareItem(l.svcCtx, s))
+	}
+	return resp, nil
+}
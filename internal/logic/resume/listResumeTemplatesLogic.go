@@ -0,0 +1,44 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+
+	"cv2/internal/infra/pdf"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListResumeTemplatesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取 PDF 模板列表
+func NewListResumeTemplatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListResumeTemplatesLogic {
+	return &ListResumeTemplatesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListResumeTemplatesLogic) ListResumeTemplates() (resp *types.ListResumeTemplatesResp, err error) {
+	templates := pdf.Templates()
+	resp = &types.ListResumeTemplatesResp{
+		Templates: make([]types.ResumeTemplateInfo, 0, len(templates)),
+	}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, types.ResumeTemplateInfo{
+			Code:        t.Code,
+			Name:        t.Name,
+			Description: t.Description,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,79 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ListResumeVersionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取简历版本列表
+func NewListResumeVersionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListResumeVersionsLogic {
+	return &ListResumeVersionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListResumeVersionsLogic) ListResumeVersions(req *types.ListResumeVersionsReq) (resp *types.ListResumeVersionsResp, err error) {
+	if _, err := loadOwnedResume(l.ctx, l.svcCtx, req.ResumeID); err != nil {
+		return nil, err
+	}
+
+	// 游标即上一页最后一个版本ID
+	var before primitive.ObjectID
+	if req.Cursor != "" {
+		before, err = primitive.ObjectIDFromHex(req.Cursor)
+		if err != nil {
+			return nil, errx.Warp(http.StatusBadRequest, err, "分页游标无效")
+		}
+	}
+
+	// 多取一条用于判断是否还有下一页
+	rows, err := l.svcCtx.Mongo.ListResumeHistory(l.ctx, req.ResumeID, before, int64(req.Limit+1))
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询简历版本失败")
+	}
+
+	resp = &types.ListResumeVersionsResp{Items: []types.ResumeVersionItem{}}
+	if len(rows) > req.Limit {
+		rows = rows[:req.Limit]
+		resp.HasMore = true
+	}
+
+	for _, h := range rows {
+		item := types.ResumeVersionItem{
+			VersionID:  h.ID.Hex(),
+			Action:     h.Action,
+			ModuleID:   h.ModuleID,
+			AuthorID:   h.AuthorID,
+			TotalScore: h.TotalScore,
+			CreatedAt:  h.CreateTime.Local().Format(timeLayout),
+		}
+		if !h.RevertFrom.IsZero() {
+			item.RevertFrom = h.RevertFrom.Hex()
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	if resp.HasMore {
+		resp.NextCursor = rows[len(rows)-1].ID.Hex()
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,279 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/predicate"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/moduleregistry"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+type ListResumesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取我的简历列表
+func NewListResumesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListResumesLogic {
+	return &ListResumesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListResumesLogic) ListResumes(req *types.ListResumesReq) (resp *types.ListResumesResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 构建筛选条件
+	preds := owner.predicates()
+	if req.Status != 0 {
+		preds = append(preds, resume.Status(req.Status))
+	}
+	if kw := strings.TrimSpace(req.Keyword); kw != "" {
+		preds = append(preds, resume.FileNameContains(kw))
+	}
+
+	ranges := []struct {
+		field string
+		value string
+		upper bool
+	}{
+		{resume.FieldCreatedAt, req.CreatedFrom, false},
+		{resume.FieldCreatedAt, req.CreatedTo, true},
+		{resume.FieldUpdatedAt, req.UpdatedFrom, false},
+		{resume.FieldUpdatedAt, req.UpdatedTo, true},
+	}
+	for _, r := range ranges {
+		if r.value == "" {
+			continue
+		}
+		t, dateOnly, err := parseQueryTime(r.value)
+		if err != nil {
+			return nil, errx.Warpf(http.StatusBadRequest, err, "时间格式错误: %s", r.value)
+		}
+		pred := sql.GTE
+		if r.upper {
+			pred = sql.LTE
+			// 只传日期时包含当天全天
+			if dateOnly {
+				t, pred = t.AddDate(0, 0, 1), sql.LT
+			}
+		}
+		field := r.field
+		preds = append(preds, func(s *sql.Selector) {
+			s.Where(pred(s.C(field), t))
+		})
+	}
+
+	// 2. 游标条件：按 (排序字段, id) 做 keyset 分页，游标记录生成时的排序字段和方向，与本次请求不一致时拒绝
+	sortField := resume.FieldUpdatedAt
+	if req.SortBy == resume.FieldCreatedAt {
+		sortField = resume.FieldCreatedAt
+	}
+	desc := req.Order != "asc"
+	if req.Cursor != "" {
+		cursor, err := decodeListCursor(req.Cursor)
+		if err != nil {
+			return nil, errx.Warp(http.StatusBadRequest, err, "分页游标无效")
+		}
+		if cursor.field != sortField || cursor.desc != desc {
+			return nil, errx.New(http.StatusBadRequest, "分页游标与排序条件不一致")
+		}
+		preds = append(preds, cursorPredicate(sortField, desc, cursor.value, cursor.id))
+	}
+
+	orderOpt := sql.OrderAsc()
+	if desc {
+		orderOpt = sql.OrderDesc()
+	}
+	query := l.svcCtx.Ent.Resume.Query().Where(preds...)
+	if sortField == resume.FieldCreatedAt {
+		query = query.Order(resume.ByCreatedAt(orderOpt), resume.ByID(orderOpt))
+	} else {
+		query = query.Order(resume.ByUpdatedAt(orderOpt), resume.ByID(orderOpt))
+	}
+
+	// 多取一条用于判断是否还有下一页
+	rows, err := query.Limit(req.Limit + 1).All(l.ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询简历列表失败")
+	}
+
+	resp = &types.ListResumesResp{Items: []types.ResumeListItem{}}
+	if len(rows) > req.Limit {
+		rows = rows[:req.Limit]
+		resp.HasMore = true
+	}
+	if len(rows) == 0 {
+		return resp, nil
+	}
+
+	// 3. 批量查询总分与模块填写情况
+	ids := make([]int64, 0, len(rows))
+	for _, r := range rows {
+		ids = append(ids, r.ID)
+	}
+
+	totalScores, err := l.sumModuleScores(ids)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "获取简历得分失败")
+	}
+
+	// 填写进度只统计必填的内置模块，不含通用模块、可选的工作经历和用户自定义模块
+	required := requiredModuleIDs(l.svcCtx.Modules)
+	filled, err := l.svcCtx.Mongo.CountFilledModules(l.ctx, ids, required)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "获取简历内容失败")
+	}
+	totalModules := len(required)
+
+	for _, r := range rows {
+		resp.Items = append(resp.Items, types.ResumeListItem{
+			ResumeID:         r.ID,
+			FileName:         r.FileName,
+			FilePath:         r.FilePath,
+			CoverImage:       r.CoverImage,
+			Status:           r.Status,
+			TotalScore:       totalScores[r.ID],
+			CompletedModules: filled[r.ID],
+			TotalModules:     totalModules,
+			CreatedAt:        r.CreatedAt.Format(timeLayout),
+			UpdatedAt:        r.UpdatedAt.Format(timeLayout),
+		})
+	}
+
+	if resp.HasMore {
+		last := rows[len(rows)-1]
+		sortValue := last.UpdatedAt
+		if sortField == resume.FieldCreatedAt {
+			sortValue = last.CreatedAt
+		}
+		resp.NextCursor = encodeListCursor(listCursor{field: sortField, desc: desc, value: sortValue, id: last.ID})
+	}
+
+	return resp, nil
+}
+
+// requiredModuleIDs 计入填写进度的模块ID
+func requiredModuleIDs(registry *moduleregistry.Registry) []int64 {
+	var ids []int64
+	for _, m := range registry.All() {
+		if m.Code == moduleregistry.CodeCustom || m.Code == moduleregistry.CodeWork {
+			continue
+		}
+		ids = append(ids, m.ID)
+	}
+	return ids
+}
+
+// sumModuleScores 按模块权重汇总每份简历的总分
+func (l *ListResumesLogic) sumModuleScores(resumeIDs []int64) (map[int64]float64, error) {
+	scores, err := l.svcCtx.Ent.ResumeScore.Query().
+		Where(
+			resumescore.ResumeIDIn(resumeIDs...),
+			resumescore.TargetType(0),
+		).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byResume := make(map[int64][]*ent.ResumeScore, len(resumeIDs))
+	for _, s := range scores {
+		byResume[s.ResumeID] = append(byResume[s.ResumeID], s)
+	}
+	result := make(map[int64]float64, len(byResume))
+	for id, list := range byResume {
+		result[id] = weightedTotal(list)
+	}
+	return result, nil
+}
+
+// cursorPredicate 构建 keyset 分页条件: (field, id) 严格位于游标之后
+func cursorPredicate(field string, desc bool, t time.Time, id int64) predicate.Resume {
+	cmp, idCmp := sql.GT, sql.GT
+	if desc {
+		cmp, idCmp = sql.LT, sql.LT
+	}
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			cmp(s.C(field), t),
+			sql.And(
+				sql.EQ(s.C(field), t),
+				idCmp(s.C(resume.FieldID), id),
+			),
+		))
+	}
+}
+
+// listCursor 简历列表的分页游标：排序字段、排序方向，以及上一页最后一条的排序值和ID
+type listCursor struct {
+	field string
+	desc  bool
+	value time.Time
+	id    int64
+}
+
+// encodeListCursor 将游标编码为 {排序字段}:{asc|desc}:{排序值纳秒}:{ID} 的 base64
+func encodeListCursor(c listCursor) string {
+	order := "asc"
+	if c.desc {
+		order = "desc"
+	}
+	raw := fmt.Sprintf("%s:%s:%d:%d", c.field, order, c.value.UnixNano(), c.id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeListCursor 解析分页游标
+func decodeListCursor(cursor string) (listCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return listCursor{}, err
+	}
+	parts := strings.SplitN(string(raw), ":", 4)
+	if len(parts) != 4 || (parts[1] != "asc" && parts[1] != "desc") {
+		return listCursor{}, errx.Newf(http.StatusBadRequest, "malformed cursor: %s", raw)
+	}
+	nanos, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return listCursor{}, err
+	}
+	id, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return listCursor{}, err
+	}
+	return listCursor{field: parts[0], desc: parts[1] == "desc", value: time.Unix(0, nanos), id: id}, nil
+}
+
+// parseQueryTime 解析查询参数中的时间，支持日期和日期时间两种格式
+func parseQueryTime(value string) (t time.Time, dateOnly bool, err error) {
+	if t, err = time.ParseInLocation(timeLayout, value, time.Local); err == nil {
+		return t, false, nil
+	}
+	t, err = time.ParseInLocation("2006-01-02", value, time.Local)
+	return t, true, err
+}
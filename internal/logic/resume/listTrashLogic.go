@@ -0,0 +1,85 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/schema"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListTrashLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取回收站简历列表
+func NewListTrashLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListTrashLogic {
+	return &ListTrashLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListTrashLogic) ListTrash(req *types.ListTrashReq) (resp *types.ListTrashResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preds := append(owner.predicates(), resume.DeletedAtNotNil())
+	if req.Cursor != "" {
+		cursor, err := decodeListCursor(req.Cursor)
+		if err != nil {
+			return nil, errx.Warp(http.StatusBadRequest, err, "分页游标无效")
+		}
+		if cursor.field != resume.FieldDeletedAt || !cursor.desc {
+			return nil, errx.New(http.StatusBadRequest, "分页游标与排序条件不一致")
+		}
+		preds = append(preds, cursorPredicate(resume.FieldDeletedAt, true, cursor.value, cursor.id))
+	}
+
+	// 按删除时间倒序，多取一条用于判断是否还有下一页
+	rows, err := l.svcCtx.Ent.Resume.Query().
+		Where(preds...).
+		Order(resume.ByDeletedAt(sql.OrderDesc()), resume.ByID(sql.OrderDesc())).
+		Limit(req.Limit + 1).
+		All(schema.SkipSoftDelete(l.ctx))
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询回收站失败")
+	}
+
+	resp = &types.ListTrashResp{Items: []types.TrashItem{}}
+	if len(rows) > req.Limit {
+		rows = rows[:req.Limit]
+		resp.HasMore = true
+	}
+
+	for _, r := range rows {
+		resp.Items = append(resp.Items, types.TrashItem{
+			ResumeID:   r.ID,
+			FileName:   r.FileName,
+			CoverImage: r.CoverImage,
+			DeletedAt:  r.DeletedAt.Format(timeLayout),
+			PurgeAt:    trashPurgeAt(l.svcCtx, *r.DeletedAt).Format(timeLayout),
+		})
+	}
+
+	if resp.HasMore {
+		last := rows[len(rows)-1]
+		resp.NextCursor = encodeListCursor(listCursor{field: resume.FieldDeletedAt, desc: true, value: *last.DeletedAt, id: last.ID})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,105 @@
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/ent/scorerun"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+)
+
+// moduleItemEdit 对模块条目列表的一次编辑
+// 入参为当前条目列表的副本，返回编辑后的列表和本次操作的条目ID
+type moduleItemEdit func(items []map[string]interface{}) ([]map[string]interface{}, string, error)
+
+// editModuleItems 在指定模块上执行条目级编辑，只重新计算该模块的评分
+// 写入以 revision 做乐观锁，与保存模块一致
+func editModuleItems(ctx context.Context, svcCtx *svc.ServiceContext, resumeID, moduleID, revision int64, edit moduleItemEdit) (*types.ModuleItemResp, error) {
+	owner, err := currentOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, content, err := loadOwnedContent(ctx, svcCtx, resumeID)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, errx.New(http.StatusNotFound, "简历内容不存在")
+	}
+	if content.Revision != revision {
+		return nil, errx.New(http.StatusConflict, "简历已被修改，请刷新后重试")
+	}
+
+	mod, err := loadResumeModule(ctx, svcCtx, content.Modules, moduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureHistoryBaseline(ctx, svcCtx, resumeID, owner.UserID); err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "记录简历版本失败")
+	}
+
+	// 1. 在副本上编辑条目
+	title := mod.Title
+	var items []map[string]interface{}
+	for _, m := range content.Modules {
+		if m.ModuleID == moduleID {
+			if m.Title != "" {
+				title = m.Title
+			}
+			items = append(items, m.Data...)
+			break
+		}
+	}
+	items, itemID, err := edit(items)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateModuleData(svcCtx, mod, items, "/data"); err != nil {
+		return nil, err
+	}
+
+	// 2. 写回 MongoDB
+	newRevision, err := svcCtx.Mongo.UpdateModule(ctx, resumeID, moduleID, title, items, revision)
+	if err != nil {
+		return nil, contentWriteError(err, "更新模块数据失败")
+	}
+
+	// 3. 只重新计算该模块的评分（后台评分）
+	queueModuleScores(ctx, svcCtx, resumeID, newRevision, content.Modules, map[int64][]map[string]interface{}{moduleID: items}, scorerun.TriggerSave)
+
+	// 4. 重新渲染并写入版本快照（失败不影响操作结果）
+	afterContentChange(ctx, svcCtx, &model.ResumeContentHistory{
+		MySQLID:  resumeID,
+		Action:   model.HistoryActionSave,
+		ModuleID: moduleID,
+		AuthorID: owner.UserID,
+	})
+
+	return &types.ModuleItemResp{
+		ItemID:   itemID,
+		Module:   buildModuleInfo(ctx, svcCtx, resumeID, mod, items),
+		Revision: newRevision,
+	}, nil
+}
+
+// findModuleItem 按条目ID查找下标，找不到时返回 404
+func findModuleItem(items []map[string]interface{}, itemID string) (int, error) {
+	for i, item := range items {
+		if model.ItemID(item) == itemID {
+			return i, nil
+		}
+	}
+	return -1, errx.New(http.StatusNotFound, "条目不存在")
+}
+
+// clampPosition 将目标位置限制在 [0, n]，负数或超出范围视为末尾
+func clampPosition(position, n int) int {
+	if position < 0 || position > n {
+		return n
+	}
+	return position
+}
@@ -0,0 +1,61 @@
+package resume
+
+import (
+	"fmt"
+	"net/http"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/pkg/jsonschema"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+)
+
+// 模块数据校验
+// 规则为模块表中的 data_schema（JSON Schema），由模块注册表编译缓存，条目ID不参与校验
+
+// validateModuleData 按模块的 schema 校验模块数据，pointer 为错误路径前缀（如 /data）
+// 校验失败时返回 400，响应 data 中带字段级错误
+func validateModuleData(svcCtx *svc.ServiceContext, mod *ent.Module, data []map[string]interface{}, pointer string) error {
+	m, ok := registryModule(svcCtx.Modules, mod)
+	if !ok {
+		return nil
+	}
+	return validationError(m.Validate(stripItemIDs(data), pointer))
+}
+
+// validateModules 校验简历的全部模块数据，错误路径为 /modules/{模块下标}/data/...，指向简历内容中的模块
+func validateModules(svcCtx *svc.ServiceContext, modules []model.ModuleData) error {
+	var errs jsonschema.Error
+	for i, mod := range modules {
+		m, ok := svcCtx.Modules.ByID(mod.ModuleID)
+		if mod.Custom {
+			m, ok = svcCtx.Modules.Custom(mod.ModuleID, mod.Title)
+		}
+		if !ok {
+			continue
+		}
+		errs = append(errs, m.Validate(stripItemIDs(mod.Data), fmt.Sprintf("/modules/%d/data", i))...)
+	}
+	return validationError(errs)
+}
+
+// validationError 把校验错误转换为 400 错误，没有错误时返回 nil
+func validationError(errs jsonschema.Error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errx.WithData(http.StatusBadRequest, "模块数据校验失败", &types.ValidationErrors{
+		Errors: fieldErrors(errs),
+	})
+}
+
+// fieldErrors 转换为接口返回的字段错误
+func fieldErrors(errs jsonschema.Error) []types.FieldError {
+	result := make([]types.FieldError, 0, len(errs))
+	for _, e := range errs {
+		result = append(result, types.FieldError{Pointer: e.Pointer, Message: e.Message})
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MoveModuleItemLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 移动模块条目
+func NewMoveModuleItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MoveModuleItemLogic {
+	return &MoveModuleItemLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *MoveModuleItemLogic) MoveModuleItem(req *types.MoveModuleItemReq) (resp *types.ModuleItemResp, err error) {
+	return editModuleItems(l.ctx, l.svcCtx, req.ResumeID, req.ModuleID, req.Revision,
+		func(items []map[string]interface{}) ([]map[string]interface{}, string, error) {
+			i, err := findModuleItem(items, req.ItemID)
+			if err != nil {
+				return nil, "", err
+			}
+			item := items[i]
+			items = append(items[:i], items[i+1:]...)
+
+			pos := clampPosition(req.Position, len(items))
+			items = append(items, nil)
+			copy(items[pos+1:], items[pos:])
+			items[pos] = item
+			return items, req.ItemID, nil
+		})
+}
@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/mergepatch"
+
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PatchModuleItemLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改模块条目（JSON Merge Patch）
+func NewPatchModuleItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PatchModuleItemLogic {
+	return &PatchModuleItemLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PatchModuleItemLogic) PatchModuleItem(req *types.PatchModuleItemReq) (resp *types.ModuleItemResp, err error) {
+	return editModuleItems(l.ctx, l.svcCtx, req.ResumeID, req.ModuleID, req.Revision,
+		func(items []map[string]interface{}) ([]map[string]interface{}, string, error) {
+			i, err := findModuleItem(items, req.ItemID)
+			if err != nil {
+				return nil, "", err
+			}
+			item := mergepatch.Apply(items[i], req.Patch)
+			// 条目ID不可修改
+			item[model.ItemIDKey] = req.ItemID
+			items[i] = item
+			return items, req.ItemID, nil
+		})
+}
@@ -5,34 +5,78 @@ import (
 	"fmt"
 
 	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/markdown"
 	"cv2/internal/infra/minio"
+	"cv2/internal/infra/pdf"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// maxPhotoSize 照片文件大小上限
+const maxPhotoSize = 5 << 20
+
 // PDFGenerator PDF 生成器
+// 配置了本地渲染器时按模板在本地排版，否则（或本地渲染失败时）经 Markdown 由算法服务生成
 type PDFGenerator struct {
 	logx.Logger
 	algorithm *algorithm.Client
+	markdown  *markdown.Renderer
+	pdf       *pdf.Renderer
 	minio     *minio.Client
 }
 
-// NewPDFGenerator 创建 PDF 生成器
-func NewPDFGenerator(algClient *algorithm.Client, minioClient *minio.Client) *PDFGenerator {
+// NewPDFGenerator 创建 PDF 生成器，pdfRenderer 可为 nil
+func NewPDFGenerator(algClient *algorithm.Client, mdRenderer *markdown.Renderer, pdfRenderer *pdf.Renderer, minioClient *minio.Client) *PDFGenerator {
 	return &PDFGenerator{
 		Logger:    logx.WithContext(context.Background()),
 		algorithm: algClient,
+		markdown:  mdRenderer,
+		pdf:       pdfRenderer,
 		minio:     minioClient,
 	}
 }
 
-// GenerateAndUpload 根据结构化数据生成 PDF 并上传
+// GenerateAndUpload 根据结构化数据生成 PDF 并上传（新生成的简历使用默认模板）
 // 返回 MinIO 中的 object key
 func (g *PDFGenerator) GenerateAndUpload(ctx context.Context, resumeID int64, data *algorithm.ResumeData) (string, error) {
-	// 1. 结构化数据转 Markdown（调用算法接口）
-	md, err := g.algorithm.Struct2Markdown(ctx, data, data.Name+"-简历")
+	filename := fmt.Sprintf("%s-简历.pdf", data.Name)
+	objectKey := minio.GenerateObjectKey(filename)
+	if err := g.RenderAndUpload(ctx, resumeID, data, pdf.TemplateClassic, objectKey); err != nil {
+		return "", err
+	}
+	return objectKey, nil
+}
+
+// RenderAndUpload 根据结构化数据按模板生成 PDF 并上传到指定的 object key
+func (g *PDFGenerator) RenderAndUpload(ctx context.Context, resumeID int64, data *algorithm.ResumeData, template, objectKey string) error {
+	pdfData, err := g.render(ctx, resumeID, data, template)
 	if err != nil {
-		return "", fmt.Errorf("struct to markdown: %w", err)
+		return err
+	}
+
+	if err := g.minio.UploadBytes(ctx, objectKey, pdfData, "application/pdf"); err != nil {
+		return fmt.Errorf("upload pdf: %w", err)
+	}
+	g.Infof("uploaded pdf for resume %d, template=%s, key=%s", resumeID, template, objectKey)
+
+	return nil
+}
+
+// render 生成 PDF 内容，本地渲染失败时回退到算法服务
+func (g *PDFGenerator) render(ctx context.Context, resumeID int64, data *algorithm.ResumeData, template string) ([]byte, error) {
+	if g.pdf != nil {
+		pdfData, err := g.pdf.Render(data, template, g.photo(ctx, data.Photo))
+		if err == nil {
+			g.Infof("rendered pdf locally for resume %d, size=%d bytes", resumeID, len(pdfData))
+			return pdfData, nil
+		}
+		g.Errorf("local pdf render failed, fallback to algorithm service: resume_id=%d, err=%v", resumeID, err)
+	}
+
+	// 1. 结构化数据转 Markdown（本地模板或算法接口）
+	md, err := g.markdown.Render(ctx, data, data.Name+"-简历")
+	if err != nil {
+		return nil, fmt.Errorf("struct to markdown: %w", err)
 	}
 	g.Infof("generated markdown for resume %d, length=%d", resumeID, len(md))
 
@@ -40,16 +84,25 @@ func (g *PDFGenerator) GenerateAndUpload(ctx context.Context, resumeID int64, da
 	filename := fmt.Sprintf("%s-简历.pdf", data.Name)
 	pdfData, err := g.algorithm.Markdown2PDF(ctx, md, filename)
 	if err != nil {
-		return "", fmt.Errorf("markdown to pdf: %w", err)
+		return nil, fmt.Errorf("markdown to pdf: %w", err)
 	}
 	g.Infof("generated pdf for resume %d, size=%d bytes", resumeID, len(pdfData))
+	return pdfData, nil
+}
 
-	// 3. 上传到 MinIO
-	objectKey := minio.GenerateObjectKey(filename)
-	if err := g.minio.UploadBytes(ctx, objectKey, pdfData, "application/pdf"); err != nil {
-		return "", fmt.Errorf("upload pdf: %w", err)
+// photo 下载简历照片，只支持当前 bucket 中的文件；获取失败时不显示照片
+func (g *PDFGenerator) photo(ctx context.Context, photoURL string) []byte {
+	if photoURL == "" {
+		return nil
 	}
-	g.Infof("uploaded pdf for resume %d, key=%s", resumeID, objectKey)
-
-	return objectKey, nil
+	key, ok := g.minio.ObjectKeyFromURL(photoURL)
+	if !ok {
+		return nil
+	}
+	b, err := g.minio.Download(ctx, key, maxPhotoSize)
+	if err != nil {
+		g.Errorf("download resume photo failed: key=%s, err=%v", key, err)
+		return nil
+	}
+	return b
 }
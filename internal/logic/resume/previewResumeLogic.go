@@ -0,0 +1,103 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/pdf"
+	"cv2/internal/infra/preview"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// previewVersion 预览页面版本，修改 HTML 模板或模板参数后递增，使已缓存的页面失效
+const previewVersion = 1
+
+// PreviewPage 预览页面
+type PreviewPage struct {
+	HTML                  []byte
+	ETag                  string
+	Immutable             bool // 请求指定了版本号，内容不会变化
+	ContentSecurityPolicy string
+}
+
+type PreviewResumeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 简历 HTML 预览（返回 text/html）
+func NewPreviewResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PreviewResumeLogic {
+	return &PreviewResumeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PreviewResumeLogic) PreviewResume(req *types.PreviewResumeReq) (*PreviewPage, error) {
+	r, content, err := loadOwnedContent(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, errx.New(http.StatusNotFound, "简历内容不存在")
+	}
+	if req.Revision != 0 && req.Revision != content.Revision {
+		return nil, errx.Newf(http.StatusConflict, "简历已更新，当前版本号为 %d", content.Revision)
+	}
+
+	template := req.Template
+	if template == "" {
+		template = string(r.Template)
+	}
+
+	data := buildResumeData(l.svcCtx.Modules, content.Modules, 0)
+	html, err := renderPreview(l.svcCtx, data, template, req.Print)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PreviewPage{
+		HTML:                  html,
+		ETag:                  fmt.Sprintf(`"preview-v%d-%d-%d-%s-%t"`, previewVersion, req.ResumeID, content.Revision, template, req.Print),
+		Immutable:             req.Revision != 0,
+		ContentSecurityPolicy: previewCSP(l.svcCtx),
+	}, nil
+}
+
+// renderPreview 按模板生成简历 HTML 预览
+func renderPreview(svcCtx *svc.ServiceContext, data *algorithm.ResumeData, template string, print bool) ([]byte, error) {
+	html, err := preview.Render(data, pdf.LookupTemplate(template), preview.Options{
+		Print:    print,
+		PhotoURL: previewPhotoURL(svcCtx, data.Photo),
+	})
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "生成预览失败")
+	}
+	return html, nil
+}
+
+// previewCSP 预览页面的 Content-Security-Policy
+// 页面只有内联样式和照片，不允许脚本
+func previewCSP(svcCtx *svc.ServiceContext) string {
+	return fmt.Sprintf("default-src 'none'; style-src 'unsafe-inline'; img-src %s; base-uri 'none'; form-action 'none'",
+		svcCtx.MinIO.Endpoint())
+}
+
+// previewPhotoURL 只显示当前 bucket 中的照片
+func previewPhotoURL(svcCtx *svc.ServiceContext, photo string) string {
+	if _, ok := svcCtx.MinIO.ObjectKeyFromURL(photo); !ok {
+		return ""
+	}
+	return photo
+}
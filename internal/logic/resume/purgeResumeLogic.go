@@ -0,0 +1,58 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/schema"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PurgeResumeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 彻底删除回收站中的简历
+func NewPurgeResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PurgeResumeLogic {
+	return &PurgeResumeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PurgeResumeLogic) PurgeResume(req *types.PurgeResumeReq) (resp *types.PurgeResumeResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只允许彻底删除回收站中的简历
+	r, err := l.svcCtx.Ent.Resume.Query().
+		Where(resume.ID(req.ResumeID), resume.DeletedAtNotNil()).
+		Where(owner.predicates()...).
+		Only(schema.SkipSoftDelete(l.ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(http.StatusNotFound, "回收站中不存在该简历")
+		}
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询简历失败")
+	}
+
+	if err := purgeResume(l.ctx, l.svcCtx, r); err != nil {
+		return nil, err
+	}
+
+	return &types.PurgeResumeResp{ResumeID: req.ResumeID}, nil
+}
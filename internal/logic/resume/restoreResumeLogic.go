@@ -0,0 +1,55 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/schema"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreResumeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 从回收站恢复简历
+func NewRestoreResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreResumeLogic {
+	return &RestoreResumeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RestoreResumeLogic) RestoreResume(req *types.RestoreResumeReq) (resp *types.RestoreResumeResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := l.svcCtx.Ent.Resume.Update().
+		Where(resume.ID(req.ResumeID), resume.DeletedAtNotNil()).
+		Where(owner.predicates()...).
+		ClearDeletedAt().
+		Save(schema.SkipSoftDelete(l.ctx))
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "恢复简历失败")
+	}
+	if n == 0 {
+		return nil, errx.New(http.StatusNotFound, "回收站中不存在该简历")
+	}
+
+	l.Infof("resume restored from trash: resume_id=%d, user_id=%d", req.ResumeID, owner.UserID)
+
+	return &types.RestoreResumeResp{ResumeID: req.ResumeID}, nil
+}
@@ -0,0 +1,113 @@
+package resume
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/predicate"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/contextx"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 简历访问控制
+// 所有按ID访问简历（MySQL 记录、MongoDB 内容、任务状态）的逻辑都必须经过这里，
+// 不属于当前用户的简历一律按不存在处理，避免泄露简历是否存在
+
+// resumeOwner 简历归属（当前登录用户）
+type resumeOwner struct {
+	UserID   int64
+	TenantID string
+}
+
+// currentOwner 从 context 获取当前登录用户的归属信息
+func currentOwner(ctx context.Context) (*resumeOwner, error) {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusUnauthorized, err, "获取用户信息失败")
+	}
+	tenantID, err := contextx.GetTenantID(ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusUnauthorized, err, "获取租户信息失败")
+	}
+	return &resumeOwner{UserID: userID, TenantID: tenantID}, nil
+}
+
+// predicates 返回限定归属的查询条件（命中 user_id + tenant_id 索引）
+func (o *resumeOwner) predicates() []predicate.Resume {
+	return []predicate.Resume{
+		resume.UserID(o.UserID),
+		resume.TenantID(o.TenantID),
+	}
+}
+
+// ownsTask 判断 Redis 任务是否属于当前用户
+func (o *resumeOwner) ownsTask(task map[string]string) bool {
+	return task["user_id"] == strconv.FormatInt(o.UserID, 10) &&
+		task["tenant_id"] == o.TenantID
+}
+
+// loadOwnedResume 加载当前用户的简历，不存在或不属于当前用户时返回 404
+func loadOwnedResume(ctx context.Context, svcCtx *svc.ServiceContext, resumeID int64) (*ent.Resume, error) {
+	owner, err := currentOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := svcCtx.Ent.Resume.Query().
+		Where(resume.ID(resumeID)).
+		Where(owner.predicates()...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(http.StatusNotFound, "简历不存在")
+		}
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询简历失败")
+	}
+	return r, nil
+}
+
+// loadOwnedContent 加载当前用户的简历及其 MongoDB 内容
+// 内容不存在时 content 为 nil
+func loadOwnedContent(ctx context.Context, svcCtx *svc.ServiceContext, resumeID int64) (*ent.Resume, *model.ResumeContent, error) {
+	r, err := loadOwnedResume(ctx, svcCtx, resumeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := loadContent(ctx, svcCtx, resumeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, content, nil
+}
+
+// loadContent 加载简历的 MongoDB 内容，调用方负责校验访问权限
+// 内容不存在时返回 nil
+func loadContent(ctx context.Context, svcCtx *svc.ServiceContext, resumeID int64) (*model.ResumeContent, error) {
+	content, err := svcCtx.Mongo.GetResumeContent(ctx, resumeID)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "获取简历内容失败")
+	}
+
+	// 旧数据没有条目ID，读取时补齐，保证条目级操作可用
+	if content != nil {
+		backfill := false
+		for _, mod := range content.Modules {
+			if model.EnsureItemIDs(mod.Data) {
+				backfill = true
+			}
+		}
+		if backfill {
+			if err := svcCtx.Mongo.BackfillItemIDs(ctx, resumeID, content.Modules, content.Revision); err != nil {
+				logx.WithContext(ctx).Errorf("backfill item ids failed: resume_id=%d, err=%v", resumeID, err)
+			}
+		}
+	}
+	return content, nil
+}
@@ -0,0 +1,224 @@
+package resume
+
+import (
+	"fmt"
+
+	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/moduleregistry"
+	"cv2/internal/infra/mongo/model"
+)
+
+// buildResumeData 把简历内容转换为算法服务的结构化数据（用于 AI 帮写和渲染）
+// maskModuleID 不为 0 时，该模块的描述替换为 [MASK]
+func buildResumeData(registry *moduleregistry.Registry, modules []model.ModuleData, maskModuleID int64) *algorithm.ResumeData {
+	// 转换为算法请求格式
+	data := &algorithm.ResumeData{}
+
+	for _, module := range modules {
+		isMask := maskModuleID != 0 && module.ModuleID == maskModuleID
+		if module.Custom {
+			fillCustom(data, module, isMask)
+			continue
+		}
+		// 按模块编码识别，不依赖标题
+		m, ok := registry.ByID(module.ModuleID)
+		if !ok {
+			continue
+		}
+		switch m.Code {
+		case moduleregistry.CodeBasicInfo:
+			fillBasicInfo(data, module.Data)
+		case moduleregistry.CodeEducation:
+			fillEducation(data, module.Data, isMask)
+		case moduleregistry.CodeCampus:
+			fillCampus(data, module.Data, isMask)
+		case moduleregistry.CodeIntern:
+			fillInternship(data, module.Data, isMask)
+		case moduleregistry.CodeWork:
+			fillWork(data, module.Data, isMask)
+		case moduleregistry.CodeProject:
+			fillProject(data, module.Data, isMask)
+		case moduleregistry.CodeSkills:
+			fillSkill(data, module.Data, isMask)
+		case moduleregistry.CodeSelfEval:
+			fillSelfEval(data, module.Data, isMask)
+		}
+	}
+
+	return data
+}
+
+// fillBasicInfo 填充基本信息
+func fillBasicInfo(data *algorithm.ResumeData, items []map[string]interface{}) {
+	if len(items) == 0 {
+		return
+	}
+	item := items[0]
+	data.Name = getString(item, "name")
+	data.Phone = getString(item, "phone")
+	data.Email = getString(item, "email")
+	data.JobTitle = getString(item, "job_title")
+	data.Birthday = getString(item, "birthday")
+	data.Ethnicity = getString(item, "ethnicity")
+	data.Politics = getString(item, "politics")
+	data.Location = getString(item, "location")
+	data.TargetCity = getString(item, "target_city")
+	data.MinSalary = getString(item, "min_salary")
+	data.MaxSalary = getString(item, "max_salary")
+	data.JobType = getString(item, "job_type")
+	data.Photo = getString(item, "photo")
+}
+
+// fillEducation 填充教育经历
+func fillEducation(data *algorithm.ResumeData, items []map[string]interface{}, isMask bool) {
+	for _, item := range items {
+		edu := algorithm.EducationExp{
+			SchoolName:  getString(item, "school_name"),
+			Degree:      getString(item, "degree"),
+			Major:       getString(item, "major"),
+			StartTime:   getString(item, "start_time"),
+			EndTime:     getString(item, "end_time"),
+			Description: getString(item, "description"),
+		}
+		if isMask {
+			edu.Description = algorithm.Mask
+		}
+		data.Education = append(data.Education, edu)
+	}
+}
+
+// fillCampus 填充在校经历
+func fillCampus(data *algorithm.ResumeData, items []map[string]interface{}, isMask bool) {
+	for _, item := range items {
+		campus := algorithm.CampusExp{
+			Title:       getString(item, "title"),
+			Role:        getString(item, "role"),
+			StartTime:   getString(item, "start_time"),
+			EndTime:     getString(item, "end_time"),
+			Description: getString(item, "description"),
+		}
+		if isMask {
+			campus.Description = algorithm.Mask
+		}
+		data.CampusExp = append(data.CampusExp, campus)
+	}
+}
+
+// fillInternship 填充实习经历
+func fillInternship(data *algorithm.ResumeData, items []map[string]interface{}, isMask bool) {
+	for _, item := range items {
+		intern := algorithm.InternExp{
+			Company:     getString(item, "company"),
+			Position:    getString(item, "position"),
+			StartTime:   getString(item, "start_time"),
+			EndTime:     getString(item, "end_time"),
+			Description: getString(item, "description"),
+		}
+		if isMask {
+			intern.Description = algorithm.Mask
+		}
+		data.InternExp = append(data.InternExp, intern)
+	}
+}
+
+// fillWork 填充工作经历，帮写时职责和业绩都交给 AI 生成
+func fillWork(data *algorithm.ResumeData, items []map[string]interface{}, isMask bool) {
+	for _, item := range items {
+		work := algorithm.WorkExp{
+			Company:          getString(item, "company"),
+			Title:            getString(item, "title"),
+			Department:       getString(item, "department"),
+			EmploymentType:   getString(item, "employment_type"),
+			StartTime:        getString(item, "start_time"),
+			EndTime:          getString(item, "end_time"),
+			Responsibilities: getString(item, "responsibilities"),
+			Achievements:     getString(item, "achievements"),
+		}
+		if isMask {
+			work.Responsibilities = algorithm.Mask
+			work.Achievements = algorithm.Mask
+		}
+		data.WorkExp = append(data.WorkExp, work)
+	}
+}
+
+// fillProject 填充项目经历
+func fillProject(data *algorithm.ResumeData, items []map[string]interface{}, isMask bool) {
+	for _, item := range items {
+		project := algorithm.ProjectExp{
+			ProjectName: getString(item, "project_name"),
+			Role:        getString(item, "role"),
+			StartTime:   getString(item, "start_time"),
+			EndTime:     getString(item, "end_time"),
+			Description: getString(item, "description"),
+		}
+		if isMask {
+			project.Description = algorithm.Mask
+		}
+		data.ProjectExp = append(data.ProjectExp, project)
+	}
+}
+
+// fillSkill 填充技能证书
+func fillSkill(data *algorithm.ResumeData, items []map[string]interface{}, isMask bool) {
+	if len(items) == 0 {
+		return
+	}
+	data.Skills = getString(items[0], "content")
+	if isMask {
+		data.Skills = algorithm.Mask
+	}
+}
+
+// fillSelfEval 填充自我评价
+func fillSelfEval(data *algorithm.ResumeData, items []map[string]interface{}, isMask bool) {
+	if len(items) == 0 {
+		return
+	}
+	data.SelfEval = getString(items[0], "content")
+	if isMask {
+		data.SelfEval = algorithm.Mask
+	}
+}
+
+// customFieldLabels 自定义模块常用字段的显示名，其他字段按原字段名输出
+var customFieldLabels = map[string]string{
+	"title":       algorithm.CustomFieldTitle,
+	"subtitle":    algorithm.CustomFieldSubtitle,
+	"start_time":  algorithm.CustomFieldStartTime,
+	"end_time":    algorithm.CustomFieldEndTime,
+	"description": algorithm.CustomFieldDescription,
+}
+
+// fillCustom 填充自定义模块
+func fillCustom(data *algorithm.ResumeData, module model.ModuleData, isMask bool) {
+	section := algorithm.CustomSection{Title: module.Title, Items: make([]map[string]string, 0, len(module.Data))}
+	for _, item := range module.Data {
+		fields := make(map[string]string, len(item))
+		for k, v := range item {
+			if k == model.ItemIDKey || v == nil {
+				continue
+			}
+			label, ok := customFieldLabels[k]
+			if !ok {
+				label = k
+			}
+			fields[label] = fmt.Sprint(v)
+		}
+		if isMask {
+			fields[algorithm.CustomFieldDescription] = algorithm.Mask
+		}
+		section.Items = append(section.Items, fields)
+	}
+	data.Custom = append(data.Custom, section)
+}
+
+// getString 从 map 中安全获取字符串
+func getString(m map[string]interface{}, key string) string {
+	if v, ok := m[key]; ok {
+		if s, ok := v.(string); ok {
+			return s
+		}
+	}
+	return ""
+}
@@ -0,0 +1,183 @@
+package resume
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/interop/europass"
+	"cv2/internal/infra/interop/jsonresume"
+	"cv2/internal/pkg/docx"
+	"cv2/internal/svc"
+)
+
+// 简历导出
+// 各格式都以 Markdown 为中间结果（pdf 由算法服务转换，docx、txt 在本地转换），json 直接导出结构化数据，
+// jsonresume 导出为 JSON Resume 格式（见 internal/infra/interop/jsonresume），
+// europass-xml、europass-json 导出为 Europass 格式（见 internal/infra/interop/europass）
+// Markdown 由 svcCtx.Markdown 生成，按配置使用本地模板或算法服务
+
+// exportFormat 导出格式
+type exportFormat struct {
+	ext         string
+	contentType string
+	object      string // 缓存的导出文件名（见 minio.RenderObjectKey），pdf 使用 pdfObjectKey
+}
+
+var exportFormats = map[string]exportFormat{
+	"pdf":           {".pdf", "application/pdf", ""},
+	"md":            {".md", "text/markdown; charset=utf-8", "resume.md"},
+	"docx":          {".docx", docx.ContentType, "resume.docx"},
+	"json":          {".json", "application/json; charset=utf-8", "resume.json"},
+	"txt":           {".txt", "text/plain; charset=utf-8", "resume.txt"},
+	"jsonresume":    {".json", "application/json; charset=utf-8", "resume.jsonresume.json"},
+	"europass-xml":  {".xml", "application/xml; charset=utf-8", "resume.europass.xml"},
+	"europass-json": {".json", "application/json; charset=utf-8", "resume.europass.json"},
+}
+
+// invalidFileNameChars 文件名中不允许的字符
+var invalidFileNameChars = regexp.MustCompile(`[\\/:*?"<>|\x00-\x1f]`)
+
+// exportFileName 根据候选人姓名生成下载文件名，如 张三-简历.pdf
+func exportFileName(data *algorithm.ResumeData, format exportFormat) string {
+	name := strings.TrimSpace(invalidFileNameChars.ReplaceAllString(data.Name, ""))
+	if name == "" {
+		return "简历" + format.ext
+	}
+	return name + "-简历" + format.ext
+}
+
+// renderExport 生成导出文件内容（pdf 由 PDFGenerator 直接上传，不经过这里）
+func renderExport(ctx context.Context, svcCtx *svc.ServiceContext, data *algorithm.ResumeData, format string) ([]byte, error) {
+	switch format {
+	case "json":
+		return marshalExport(data)
+	case "jsonresume":
+		return marshalExport(jsonresume.Export(data))
+	case "europass-xml":
+		return europass.EncodeXML(europass.Export(data))
+	case "europass-json":
+		return europass.EncodeJSON(europass.Export(data))
+	}
+
+	md, err := svcCtx.Markdown.Render(ctx, data, data.Name+"-简历")
+	if err != nil {
+		return nil, fmt.Errorf("struct to markdown: %w", err)
+	}
+	switch format {
+	case "md":
+		return []byte(md), nil
+	case "txt":
+		return []byte(markdownToText(md)), nil
+	case "docx":
+		return markdownToDocx(md).Bytes()
+	}
+	return nil, fmt.Errorf("unsupported export format: %s", format)
+}
+
+func marshalExport(v interface{}) ([]byte, error) {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal resume data: %w", err)
+	}
+	return b, nil
+}
+
+var (
+	mdHeading   = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	mdBullet    = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+(.*)$`)
+	mdRule      = regexp.MustCompile(`^\s*(?:-{3,}|\*{3,}|_{3,})\s*$`)
+	mdTableSep  = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	mdLink      = regexp.MustCompile(`!?\[([^\]]*)\]\(([^)]*)\)`)
+	mdEmphasis  = regexp.MustCompile(`(\*\*|__|\*|` + "`" + `)`)
+	mdLineBreak = regexp.MustCompile(`<br\s*/?>`)
+)
+
+// mdBlock Markdown 中的一行内容
+type mdBlock struct {
+	kind  string // heading / bullet / text
+	level int    // 标题级别
+	text  string
+}
+
+// parseMarkdown 按行解析简历 Markdown（标题、列表、表格行、普通段落），去除行内格式
+// 空行和分隔线忽略
+func parseMarkdown(md string) []mdBlock {
+	var blocks []mdBlock
+	for _, line := range strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n") {
+		line = strings.TrimRight(line, " \t")
+		if strings.TrimSpace(line) == "" || mdRule.MatchString(line) || mdTableSep.MatchString(line) {
+			continue
+		}
+		if m := mdHeading.FindStringSubmatch(line); m != nil {
+			blocks = append(blocks, mdBlock{kind: "heading", level: len(m[1]), text: inlineText(m[2])})
+			continue
+		}
+		if m := mdBullet.FindStringSubmatch(line); m != nil {
+			blocks = append(blocks, mdBlock{kind: "bullet", text: inlineText(m[1])})
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "|") {
+			// 表格行按单元格拼接
+			cells := strings.Split(strings.Trim(strings.TrimSpace(line), "|"), "|")
+			for i := range cells {
+				cells[i] = strings.TrimSpace(cells[i])
+			}
+			line = strings.Join(cells, "  ")
+		}
+		blocks = append(blocks, mdBlock{kind: "text", text: inlineText(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), ">")))})
+	}
+	return blocks
+}
+
+// inlineText 去除行内 Markdown 格式，链接保留文字和地址
+func inlineText(s string) string {
+	s = mdLineBreak.ReplaceAllString(s, "\n")
+	s = mdLink.ReplaceAllStringFunc(s, func(m string) string {
+		sub := mdLink.FindStringSubmatch(m)
+		if sub[1] == "" || sub[1] == sub[2] {
+			return sub[2]
+		}
+		return sub[1] + " (" + sub[2] + ")"
+	})
+	return strings.TrimSpace(mdEmphasis.ReplaceAllString(s, ""))
+}
+
+// markdownToText Markdown 转纯文本，标题前空一行，列表项以 “- ” 开头
+func markdownToText(md string) string {
+	var b strings.Builder
+	for _, block := range parseMarkdown(md) {
+		switch block.kind {
+		case "heading":
+			if b.Len() > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString(block.text)
+		case "bullet":
+			b.WriteString("- " + block.text)
+		default:
+			b.WriteString(block.text)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// markdownToDocx Markdown 转 Word 文档
+func markdownToDocx(md string) *docx.Document {
+	doc := docx.New()
+	for _, block := range parseMarkdown(md) {
+		switch block.kind {
+		case "heading":
+			doc.Heading(block.level, block.text)
+		case "bullet":
+			doc.Bullet(block.text)
+		default:
+			doc.Paragraph(block.text)
+		}
+	}
+	return doc
+}
@@ -0,0 +1,217 @@
+package resume
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 简历版本历史
+// 每次修改简历内容后，把修改后的全部模块数据和当时的评分写入 resume_content_history，
+// 快照只追加不修改，回滚也是写入一条新快照
+
+// 版本对比的变更类型
+const (
+	changeAdded    = "added"
+	changeRemoved  = "removed"
+	changeModified = "modified"
+)
+
+// recordResumeHistory 为简历当前的内容和评分写入快照
+// 评分在后台进行，快照中的得分是写入时的当前得分，修改的模块仍在评分时为修改前的得分（state 为 pending）
+// history 只需填写 MySQLID、Action、ModuleID、AuthorID 等元信息
+func recordResumeHistory(ctx context.Context, svcCtx *svc.ServiceContext, history *model.ResumeContentHistory) error {
+	content, err := svcCtx.Mongo.GetResumeContent(ctx, history.MySQLID)
+	if err != nil {
+		return fmt.Errorf("get resume content: %w", err)
+	}
+	if content != nil {
+		history.Modules = content.Modules
+		history.Revision = content.Revision
+	}
+
+	scores, err := svcCtx.Ent.ResumeScore.Query().
+		Where(resumescore.ResumeID(history.MySQLID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query resume scores: %w", err)
+	}
+	history.Scores = make([]model.ScoreSnapshot, 0, len(scores))
+	for _, s := range scores {
+		history.Scores = append(history.Scores, model.ScoreSnapshot{
+			TargetID:   s.TargetID,
+			TargetType: s.TargetType,
+			ScopeID:    s.ScopeID,
+			Score:      s.Score,
+			Weight:     s.Weight,
+			State:      string(s.State),
+		})
+	}
+	history.TotalScore = weightedTotal(scores)
+
+	if _, err := svcCtx.Mongo.InsertResumeHistory(ctx, history); err != nil {
+		return fmt.Errorf("insert resume history: %w", err)
+	}
+	return nil
+}
+
+// afterContentChange 简历内容修改后的后续处理：安排重新渲染 PDF（短时间内的多次修改合并为一次），写入版本快照
+// 内容已经写入，快照写入失败只记录日志，不影响操作结果（此时 history.ID 为空）
+func afterContentChange(ctx context.Context, svcCtx *svc.ServiceContext, history *model.ResumeContentHistory) {
+	scheduleRender(ctx, svcCtx, history.MySQLID)
+
+	if err := recordResumeHistory(ctx, svcCtx, history); err != nil {
+		logx.WithContext(ctx).Errorf("record resume history failed: resume_id=%d, err=%v", history.MySQLID, err)
+	}
+}
+
+// ensureHistoryBaseline 简历还没有任何快照时（历史数据），先把当前内容记为基线版本
+func ensureHistoryBaseline(ctx context.Context, svcCtx *svc.ServiceContext, resumeID, authorID int64) error {
+	exists, err := svcCtx.Mongo.HasResumeHistory(ctx, resumeID)
+	if err != nil {
+		return fmt.Errorf("check resume history: %w", err)
+	}
+	if exists {
+		return nil
+	}
+	return recordResumeHistory(ctx, svcCtx, &model.ResumeContentHistory{
+		MySQLID:  resumeID,
+		Action:   model.HistoryActionInit,
+		AuthorID: authorID,
+	})
+}
+
+// loadResumeVersion 加载简历的指定版本，版本ID非法或不存在时返回 404
+func loadResumeVersion(ctx context.Context, svcCtx *svc.ServiceContext, resumeID int64, versionID string) (*model.ResumeContentHistory, error) {
+	id, err := primitive.ObjectIDFromHex(versionID)
+	if err != nil {
+		return nil, errx.New(http.StatusNotFound, "版本不存在")
+	}
+	history, err := svcCtx.Mongo.GetResumeHistory(ctx, resumeID, id)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "获取简历版本失败")
+	}
+	if history == nil {
+		return nil, errx.New(http.StatusNotFound, "版本不存在")
+	}
+	return history, nil
+}
+
+// diffModules 对比两组模块数据，返回字段级变更
+// moduleID 不为 0 时只对比该模块
+func diffModules(from, to []model.ModuleData, moduleID int64) []types.VersionFieldChange {
+	fromMap := moduleDataMap(from)
+	toMap := moduleDataMap(to)
+
+	ids := make([]int64, 0, len(fromMap)+len(toMap))
+	for id := range unionModuleIDs(fromMap, toMap) {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	changes := []types.VersionFieldChange{}
+	for _, id := range ids {
+		if moduleID != 0 && id != moduleID {
+			continue
+		}
+		before, inFrom := fromMap[id]
+		after, inTo := toMap[id]
+		switch {
+		case !inFrom:
+			changes = append(changes, types.VersionFieldChange{
+				ModuleID: id, Title: after.Title, ItemIndex: -1, Op: changeAdded, After: after.Data,
+			})
+		case !inTo:
+			changes = append(changes, types.VersionFieldChange{
+				ModuleID: id, Title: before.Title, ItemIndex: -1, Op: changeRemoved, Before: before.Data,
+			})
+		default:
+			changes = append(changes, diffModuleItems(id, after.Title, before.Data, after.Data)...)
+		}
+	}
+	return changes
+}
+
+// diffModuleItems 按数据项下标逐字段对比同一模块的两份数据
+func diffModuleItems(moduleID int64, title string, from, to []map[string]interface{}) []types.VersionFieldChange {
+	var changes []types.VersionFieldChange
+	for i := 0; i < len(from) || i < len(to); i++ {
+		switch {
+		case i >= len(from):
+			changes = append(changes, types.VersionFieldChange{
+				ModuleID: moduleID, Title: title, ItemIndex: i, Op: changeAdded, After: to[i],
+			})
+		case i >= len(to):
+			changes = append(changes, types.VersionFieldChange{
+				ModuleID: moduleID, Title: title, ItemIndex: i, Op: changeRemoved, Before: from[i],
+			})
+		default:
+			for _, field := range unionKeys(from[i], to[i]) {
+				before, inFrom := from[i][field]
+				after, inTo := to[i][field]
+				change := types.VersionFieldChange{
+					ModuleID: moduleID, Title: title, ItemIndex: i, Field: field, Before: before, After: after,
+				}
+				switch {
+				case !inFrom:
+					change.Op = changeAdded
+				case !inTo:
+					change.Op = changeRemoved
+				case !reflect.DeepEqual(before, after):
+					change.Op = changeModified
+				default:
+					continue
+				}
+				changes = append(changes, change)
+			}
+		}
+	}
+	return changes
+}
+
+// moduleDataMap 按模块ID索引模块数据
+func moduleDataMap(modules []model.ModuleData) map[int64]model.ModuleData {
+	m := make(map[int64]model.ModuleData, len(modules))
+	for _, mod := range modules {
+		m[mod.ModuleID] = mod
+	}
+	return m
+}
+
+// unionModuleIDs 返回两组模块的模块ID并集
+func unionModuleIDs(a, b map[int64]model.ModuleData) map[int64]struct{} {
+	ids := make(map[int64]struct{}, len(a)+len(b))
+	for id := range a {
+		ids[id] = struct{}{}
+	}
+	for id := range b {
+		ids[id] = struct{}{}
+	}
+	return ids
+}
+
+// unionKeys 返回两个数据项的字段名并集（有序）
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,40 @@
+package resume
+
+import (
+	"cv2/internal/infra/interop"
+	"cv2/internal/infra/interop/europass"
+	"cv2/internal/infra/interop/jsonresume"
+	"cv2/internal/types"
+)
+
+// 简历导入
+// 其他工具导出的结构化简历直接转换为 algorithm.ResumeData，不经过算法服务解析，
+// 之后与上传、生成的简历走同样的保存流程（模块校验、评分、版本快照、生成 PDF）
+
+// maxImportFileSize 导入文件大小上限
+const maxImportFileSize = 2 << 20 // 2MB
+
+// importFormat 导入格式
+type importFormat struct {
+	name  string // 格式名称（用于错误提示）
+	parse func([]byte) (*interop.Importer, error)
+}
+
+var importFormats = map[string]importFormat{
+	"jsonresume":    {"JSON Resume", jsonresume.Import},
+	"europass-xml":  {"Europass XML", europass.ImportXML},
+	"europass-json": {"Europass JSON", europass.ImportJSON},
+}
+
+// importMappings 把导入报告转换为响应
+func importMappings(report interop.Report) []types.ImportMapping {
+	mappings := make([]types.ImportMapping, 0, len(report.Mappings))
+	for _, m := range report.Mappings {
+		mappings = append(mappings, types.ImportMapping{
+			Source: m.Source,
+			Target: m.Target,
+			Kind:   m.Kind,
+		})
+	}
+	return mappings
+}
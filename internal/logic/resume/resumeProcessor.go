@@ -2,14 +2,21 @@ package resume
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"mime/multipart"
 	"net/http"
 	"time"
 
 	"cv2/internal/infra/algorithm"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/scorerun"
 	"cv2/internal/infra/minio"
+	"cv2/internal/infra/mongo"
+	"cv2/internal/infra/mongo/model"
 	"cv2/internal/pkg/errx"
 	"cv2/internal/svc"
+	"cv2/internal/types"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -27,7 +34,8 @@ const (
 func saveAndProcessResume(
 	ctx context.Context,
 	svcCtx *svc.ServiceContext,
-	resumeID, userID, tenantID int64,
+	resumeID int64,
+	owner *resumeOwner,
 	fileHeader *multipart.FileHeader,
 	data *algorithm.ResumeData,
 ) error {
@@ -37,25 +45,48 @@ func saveAndProcessResume(
 		fileName = fileHeader.Filename
 	}
 
-	// 2. 事务保存数据
-	if err := saveResumeTransaction(ctx, svcCtx, resumeID, userID, tenantID, fileName, data); err != nil {
+	// 2. 构建模块数据并按模块 schema 校验
+	modules := mongo.BuildModules(data, svcCtx.Modules)
+	if err := validateModules(svcCtx, modules); err != nil {
 		return err
 	}
 
-	// 3. 异步处理文件
-	go asyncProcessFile(context.Background(), svcCtx, resumeID, fileHeader, data)
+	// 3. 事务保存数据
+	if err := saveResumeTransaction(ctx, svcCtx, resumeID, owner, fileName, data, modules); err != nil {
+		return err
+	}
+
+	// 4. 记录初始版本（失败不影响简历创建）
+	err := recordResumeHistory(ctx, svcCtx, &model.ResumeContentHistory{
+		MySQLID:  resumeID,
+		Action:   model.HistoryActionCreate,
+		AuthorID: owner.UserID,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("record resume history failed: resume_id=%d, err=%v", resumeID, err)
+	}
+
+	// 5. 异步处理文件
+	go asyncProcessFile(context.Background(), svcCtx, resumeID, owner, fileHeader, data)
 
 	return nil
 }
 
 // saveResumeTransaction 在事务中保存简历数据
+// 评分在开启事务前完成，事务只包含写入
 func saveResumeTransaction(
 	ctx context.Context,
 	svcCtx *svc.ServiceContext,
-	resumeID, userID, tenantID int64,
+	resumeID int64,
+	owner *resumeOwner,
 	fileName string,
 	data *algorithm.ResumeData,
+	modules []model.ModuleData,
 ) error {
+	// 1. 计算评分（单个模块评分失败不影响简历保存，记入评分历史）
+	calculator := NewScoreCalculator(ctx, svcCtx)
+	results := calculator.Evaluate(calculator.ResumeScoreJobs(data, modules))
+
 	// 开启事务
 	tx, err := svcCtx.Ent.Tx(ctx)
 	if err != nil {
@@ -63,11 +94,11 @@ func saveResumeTransaction(
 	}
 	defer tx.Rollback()
 
-	// 1. 创建简历主表记录
+	// 2. 创建简历主表记录
 	_, err = tx.Resume.Create().
 		SetID(resumeID).
-		SetUserID(userID).
-		SetTenantID(tenantID).
+		SetUserID(owner.UserID).
+		SetTenantID(owner.TenantID).
 		SetFileName(fileName).
 		SetCoverImage(svcCtx.Config.DefaultConfig.DefaultCoverImage).
 		SetFilePath("uploading..."). // 异步操作完成后更新
@@ -77,20 +108,21 @@ func saveResumeTransaction(
 		return errx.Warp(http.StatusInternalServerError, err, "创建简历记录失败")
 	}
 
-	// 2. 保存到 MongoDB（简历详细内容）
-	err = svcCtx.Mongo.SaveResumeContent(ctx, resumeID, data)
+	// 3. 保存到 MongoDB（简历详细内容）
+	err = svcCtx.Mongo.SaveResumeContent(ctx, resumeID, modules)
 	if err != nil {
 		return errx.Warp(http.StatusInternalServerError, err, "保存简历内容失败")
 	}
 
-	// 3. 计算并保存评分
-	calculator := NewScoreCalculator(ctx, svcCtx.Ent, svcCtx.Algorithm)
-	if err := calculator.CalculateResumeScore(tx, resumeID, data); err != nil {
-		logx.WithContext(ctx).Errorf("calculate resume score failed: %v", err)
-		// 评分失败不影响简历保存
+	// 4. 保存评分
+	if err := calculator.Save(tx, resumeID, results); err != nil {
+		return err
+	}
+	if err := recordScoreRun(ctx, tx, svcCtx, resumeID, scorerun.TriggerUpload, results); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "记录评分历史失败")
 	}
 
-	// 4. 提交事务
+	// 5. 提交事务
 	return tx.Commit()
 }
 
@@ -99,13 +131,14 @@ func asyncProcessFile(
 	ctx context.Context,
 	svcCtx *svc.ServiceContext,
 	resumeID int64,
+	owner *resumeOwner,
 	fileHeader *multipart.FileHeader,
 	data *algorithm.ResumeData,
 ) {
 	var (
 		objectKey string
 		err       error
-		logger    = logx.WithContext(ctx)
+		logger    = logx.WithContext(ctx).WithFields(logx.Field("user_id", owner.UserID), logx.Field("tenant_id", owner.TenantID))
 	)
 
 	if fileHeader != nil {
@@ -117,7 +150,7 @@ func asyncProcessFile(
 		}
 	} else {
 		// 模式2：生成 PDF 文件 (GenerateResumeLogic)
-		generator := NewPDFGenerator(svcCtx.Algorithm, svcCtx.MinIO)
+		generator := NewPDFGenerator(svcCtx.Algorithm, svcCtx.Markdown, svcCtx.PDF, svcCtx.MinIO)
 		objectKey, err = generator.GenerateAndUpload(ctx, resumeID, data)
 		if err != nil {
 			logger.Errorf("generate and upload pdf failed: resume_id=%d, err=%v", resumeID, err)
@@ -125,10 +158,13 @@ func asyncProcessFile(
 		}
 	}
 
-	// 更新简历表的 file_path
+	// 更新简历表的 file_path（限定归属，避免误改他人简历）
 	fileURL := svcCtx.MinIO.GetPublicURL(objectKey)
-	_, err = svcCtx.Ent.Resume.UpdateOneID(resumeID).
+	_, err = svcCtx.Ent.Resume.Update().
+		Where(resume.ID(resumeID)).
+		Where(owner.predicates()...).
 		SetFilePath(fileURL).
+		SetSourceFilePath(fileURL).
 		Save(ctx)
 	if err != nil {
 		logger.Errorf("update resume file_path failed: resume_id=%d, err=%v", resumeID, err)
@@ -147,3 +183,19 @@ func updateRedisStatus(ctx context.Context, svcCtx *svc.ServiceContext, keyPrefi
 	}
 	svcCtx.Redis.Expire(ctx, key, 12*time.Hour)
 }
+
+// updateRedisFailure 将任务标记为失败，数据校验失败时同时记录失败原因和字段错误
+func updateRedisFailure(ctx context.Context, svcCtx *svc.ServiceContext, keyPrefix, taskID string, err error) {
+	updateRedisStatus(ctx, svcCtx, keyPrefix, taskID, statusFailure, 0)
+
+	var e *errx.Error
+	if !errors.As(err, &e) {
+		return
+	}
+	validation, ok := e.Data().(*types.ValidationErrors)
+	if !ok {
+		return
+	}
+	fieldErrs, _ := json.Marshal(validation.Errors)
+	svcCtx.Redis.HSet(ctx, keyPrefix+taskID, "message", e.Error(), "errors", string(fieldErrs))
+}
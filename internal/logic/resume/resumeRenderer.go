@@ -0,0 +1,226 @@
+package resume
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/minio"
+	"cv2/internal/svc"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// 简历修改后自动重新渲染 PDF
+// 修改内容后把简历加入 Redis 有序集合（score 为计划渲染时间），同一简历的连续修改只推迟计划时间，合并为一次渲染；
+// 后台任务取出到期的简历，通过 ZREM 抢占保证多实例只渲染一次。
+// 新文件按内容版本号写入新的 object key，数据库切换 file_path 成功后才删除旧的渲染文件
+
+const (
+	renderQueueKey     = "resume_render:queue" // 待渲染简历，score 为计划渲染时间（毫秒）
+	renderFirstKey     = "resume_render:first" // 简历首次请求渲染的时间（毫秒），用于限制连续修改时的最长等待
+	renderPollInterval = 2 * time.Second
+	renderBatchSize    = 10
+	renderTimeout      = 2 * time.Minute
+)
+
+// StartResumeRenderer 启动简历重新渲染任务
+func StartResumeRenderer(svcCtx *svc.ServiceContext) {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(renderPollInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			renderDueResumes(context.Background(), svcCtx)
+		}
+	})
+}
+
+// scheduleRender 简历内容修改后安排重新渲染（失败只记录日志，不影响修改结果）
+func scheduleRender(ctx context.Context, svcCtx *svc.ServiceContext, resumeID int64) {
+	logger := logx.WithContext(ctx)
+	member := strconv.FormatInt(resumeID, 10)
+	now := time.Now()
+
+	// 每次修改把计划时间推迟到 now+debounce，但不晚于首次修改后的 maxWait
+	due := now.Add(time.Duration(svcCtx.Config.Render.DebounceSeconds) * time.Second)
+	svcCtx.Redis.HSetNX(ctx, renderFirstKey, member, now.UnixMilli())
+	if first, err := svcCtx.Redis.HGet(ctx, renderFirstKey, member).Int64(); err == nil {
+		limit := time.UnixMilli(first).Add(time.Duration(svcCtx.Config.Render.MaxWaitSeconds) * time.Second)
+		if due.After(limit) {
+			due = limit
+		}
+	}
+
+	err := svcCtx.Redis.ZAdd(ctx, renderQueueKey, redis.Z{Score: float64(due.UnixMilli()), Member: member}).Err()
+	if err != nil {
+		logger.Errorf("schedule resume render failed: resume_id=%d, err=%v", resumeID, err)
+		return
+	}
+
+	err = svcCtx.Ent.Resume.Update().
+		Where(resume.ID(resumeID)).
+		SetRenderStatus(resume.RenderStatusPending).
+		Exec(ctx)
+	if err != nil {
+		logger.Errorf("update render status failed: resume_id=%d, err=%v", resumeID, err)
+	}
+}
+
+// renderDueResumes 渲染到期的简历
+func renderDueResumes(ctx context.Context, svcCtx *svc.ServiceContext) {
+	logger := logx.WithContext(ctx)
+
+	members, err := svcCtx.Redis.ZRangeByScore(ctx, renderQueueKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(time.Now().UnixMilli(), 10),
+		Count: renderBatchSize,
+	}).Result()
+	if err != nil {
+		logger.Errorf("query render queue failed: %v", err)
+		return
+	}
+
+	for _, member := range members {
+		// ZREM 成功的实例负责渲染；渲染期间的新修改会重新入队
+		removed, err := svcCtx.Redis.ZRem(ctx, renderQueueKey, member).Result()
+		if err != nil || removed == 0 {
+			continue
+		}
+		svcCtx.Redis.HDel(ctx, renderFirstKey, member)
+
+		resumeID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		renderCtx, cancel := context.WithTimeout(ctx, renderTimeout)
+		if err := renderResume(renderCtx, svcCtx, resumeID); err != nil {
+			logger.Errorf("render resume failed: resume_id=%d, err=%v", resumeID, err)
+			setRenderStatus(ctx, svcCtx, resumeID, resume.RenderStatusFailed)
+		}
+		cancel()
+	}
+}
+
+// renderResume 按最新内容重新生成 PDF 并切换 file_path
+func renderResume(ctx context.Context, svcCtx *svc.ServiceContext, resumeID int64) error {
+	logger := logx.WithContext(ctx)
+
+	// 已删除（含回收站）的简历不再渲染
+	r, err := svcCtx.Ent.Resume.Get(ctx, resumeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	content, err := svcCtx.Mongo.GetResumeContent(ctx, resumeID)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		return nil
+	}
+	// 同一版本切换模板后也需要重新渲染，以 object key 判断当前文件是否最新
+	objectKey := pdfObjectKey(resumeID, content.Revision, string(r.Template))
+	currentKey, _ := svcCtx.MinIO.ObjectKeyFromURL(r.FilePath)
+	if r.RenderedRevision > content.Revision || (r.RenderedRevision == content.Revision && currentKey == objectKey) {
+		setRenderStatus(ctx, svcCtx, resumeID, resume.RenderStatusDone)
+		return nil
+	}
+
+	setRenderStatus(ctx, svcCtx, resumeID, resume.RenderStatusRendering)
+
+	// 1. 生成新文件（旧文件保持不变），该版本已导出过 PDF 时直接复用
+	exists, err := svcCtx.MinIO.ObjectExists(ctx, objectKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		data := buildResumeData(svcCtx.Modules, content.Modules, 0)
+		if err := NewPDFGenerator(svcCtx.Algorithm, svcCtx.Markdown, svcCtx.PDF, svcCtx.MinIO).RenderAndUpload(ctx, resumeID, data, string(r.Template), objectKey); err != nil {
+			return err
+		}
+	}
+
+	// 2. 切换 file_path，只允许用不旧于当前的版本且模板未变时覆盖，避免较慢的旧渲染覆盖新渲染
+	status := resume.RenderStatusDone
+	if _, err := svcCtx.Redis.ZScore(ctx, renderQueueKey, strconv.FormatInt(resumeID, 10)).Result(); err == nil {
+		status = resume.RenderStatusPending
+	}
+	update := svcCtx.Ent.Resume.Update().
+		Where(
+			resume.ID(resumeID),
+			resume.RenderedRevisionLTE(content.Revision),
+			resume.TemplateEQ(r.Template),
+		).
+		SetFilePath(svcCtx.MinIO.GetPublicURL(objectKey)).
+		SetRenderedRevision(content.Revision).
+		SetRenderStatus(status)
+	// 早期简历没有记录原始文件，第一次重新渲染前 file_path 仍是原始文件
+	if r.SourceFilePath == "" && currentKey != "" && !minio.IsRenderObjectKey(currentKey) {
+		update.SetSourceFilePath(r.FilePath)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		// 已有更新的渲染结果或模板已切换，丢弃本次文件（并发渲染同一文件时保留正在使用的）
+		if latest, err := svcCtx.Ent.Resume.Get(ctx, resumeID); err == nil {
+			if key, _ := svcCtx.MinIO.ObjectKeyFromURL(latest.FilePath); key == objectKey {
+				return nil
+			}
+		}
+		if err := svcCtx.MinIO.RemoveObject(ctx, objectKey); err != nil {
+			logger.Errorf("remove outdated render failed: key=%s, err=%v", objectKey, err)
+		}
+		return nil
+	}
+
+	// 3. 删除旧版本的渲染和导出文件（用户上传的原始文件不在渲染目录下，不受影响）
+	pruneRenders(ctx, svcCtx, resumeID, content.Revision, objectKey)
+
+	logger.Infof("resume rendered: resume_id=%d, revision=%d, key=%s", resumeID, content.Revision, objectKey)
+	return nil
+}
+
+// pdfObjectKey 简历 PDF 渲染文件的 object key，按内容版本号和模板区分
+func pdfObjectKey(resumeID, revision int64, template string) string {
+	return minio.RenderObjectKey(resumeID, revision, template+".pdf")
+}
+
+// pruneRenders 删除早于 revision 的渲染和导出文件（含脱敏导出），keepKey 为正在使用的 PDF，始终保留
+// 旧版本内容已不再对外提供，文件保留只会让回滚前的数据长期留在存储中；失败只记录日志，下次写入新文件时重试
+func pruneRenders(ctx context.Context, svcCtx *svc.ServiceContext, resumeID, revision int64, keepKey string) {
+	logger := logx.WithContext(ctx)
+
+	keys, err := svcCtx.MinIO.ListObjectKeys(ctx, minio.RenderPrefix(resumeID))
+	if err != nil {
+		logger.Errorf("list renders failed: resume_id=%d, err=%v", resumeID, err)
+		return
+	}
+	for _, key := range keys {
+		if rev, ok := minio.RenderObjectRevision(key); !ok || rev >= revision || key == keepKey {
+			continue
+		}
+		if err := svcCtx.MinIO.RemoveObject(ctx, key); err != nil {
+			logger.Errorf("remove previous render failed: key=%s, err=%v", key, err)
+		}
+	}
+}
+
+// setRenderStatus 更新渲染状态（失败只记录日志）
+func setRenderStatus(ctx context.Context, svcCtx *svc.ServiceContext, resumeID int64, status resume.RenderStatus) {
+	err := svcCtx.Ent.Resume.Update().
+		Where(resume.ID(resumeID)).
+		SetRenderStatus(status).
+		Exec(ctx)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("update render status failed: resume_id=%d, status=%s, err=%v", resumeID, status, err)
+	}
+}
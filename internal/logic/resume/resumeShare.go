@@ -0,0 +1,294 @@
+package resume
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 简历分享链接
+// 分享令牌是随机字符串，持有令牌的人不需要登录即可在线查看简历（download 权限还可以下载 PDF），
+// 始终展示简历的最新内容（脱敏分享按 svcCtx.Redaction 脱敏）。链接过期或被撤销后立即失效；设置了密码时通过 X-Share-Password 请求头校验，
+// 连续输错 shareMaxPasswordFailures 次后锁定 sharePasswordLockTime
+
+const (
+	shareTokenBytes          = 24 // 令牌随机字节数（base64url 编码后 32 个字符）
+	maxActiveShares          = 20 // 每份简历最多同时有效的分享链接数
+	sharePasswordMinLen      = 4
+	sharePasswordMaxLen      = 64
+	shareMaxPasswordFailures = 10
+	sharePasswordLockTime    = 15 * time.Minute
+	sharePasswordFailPrefix  = "resume_share:password_fail:"
+	maxReferrerLen           = 512 // 与 cv_resume_share_view.referrer 长度一致
+)
+
+// 分享链接状态
+const (
+	shareStatusActive  = "active"
+	shareStatusExpired = "expired"
+	shareStatusRevoked = "revoked"
+)
+
+// newShareToken 生成分享令牌
+func newShareToken() (string, error) {
+	b := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSharePassword 计算访问密码的哈希，密码为空时返回空字符串
+func hashSharePassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	if n := utf8.RuneCountInString(password); n < sharePasswordMinLen || n > sharePasswordMaxLen {
+		return "", errx.Newf(http.StatusBadRequest, "访问密码长度须为 %d-%d 位", sharePasswordMinLen, sharePasswordMaxLen)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", errx.Warp(http.StatusInternalServerError, err, "设置访问密码失败")
+	}
+	return string(hash), nil
+}
+
+// shareStatus 返回分享链接当前状态
+func shareStatus(s *ent.ResumeShare, now time.Time) string {
+	switch {
+	case s.RevokedAt != nil:
+		return shareStatusRevoked
+	case s.ExpiresAt != nil && !now.Before(*s.ExpiresAt):
+		return shareStatusExpired
+	}
+	return shareStatusActive
+}
+
+// shareURL 返回分享地址
+func shareURL(svcCtx *svc.ServiceContext, token string) string {
+	if base := svcCtx.Config.Share.BaseURL; base != "" {
+		return base + token
+	}
+	return "/api/share/" + token
+}
+
+// formatOptionalTime 格式化可空时间，为空时返回空字符串
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(timeLayout)
+}
+
+// shareItem 转换为响应
+func shareItem(svcCtx *svc.ServiceContext, s *ent.ResumeShare) types.ResumeShareItem {
+	return types.ResumeShareItem{
+		ShareID:      s.ID,
+		ResumeID:     s.ResumeID,
+		Token:        s.Token,
+		URL:          shareURL(svcCtx, s.Token),
+		Permission:   string(s.Permission),
+		HasPassword:  s.PasswordHash != "",
+		Redact:       s.Redact,
+		Status:       shareStatus(s, time.Now()),
+		ExpiresAt:    formatOptionalTime(s.ExpiresAt),
+		RevokedAt:    formatOptionalTime(s.RevokedAt),
+		ViewCount:    s.ViewCount,
+		LastViewedAt: formatOptionalTime(s.LastViewedAt),
+		CreatedAt:    s.CreatedAt.Format(timeLayout),
+	}
+}
+
+// loadOwnedShare 加载当前用户简历下的分享链接，不存在时返回 404
+func loadOwnedShare(ctx context.Context, svcCtx *svc.ServiceContext, resumeID, shareID int64) (*ent.ResumeShare, error) {
+	if _, err := loadOwnedResume(ctx, svcCtx, resumeID); err != nil {
+		return nil, err
+	}
+	s, err := svcCtx.Ent.ResumeShare.Query().
+		Where(resumeshare.ID(shareID), resumeshare.ResumeID(resumeID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(http.StatusNotFound, "分享链接不存在")
+		}
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询分享链接失败")
+	}
+	return s, nil
+}
+
+// loadActiveShare 按令牌加载有效的分享链接
+// 令牌不存在返回 404，已过期或已撤销返回 410
+func loadActiveShare(ctx context.Context, svcCtx *svc.ServiceContext, token string) (*ent.ResumeShare, error) {
+	s, err := svcCtx.Ent.ResumeShare.Query().
+		Where(resumeshare.Token(token)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(http.StatusNotFound, "分享链接不存在")
+		}
+		return nil, errx.Warp(http.StatusInternalServerError, err, "查询分享链接失败")
+	}
+	switch shareStatus(s, time.Now()) {
+	case shareStatusRevoked:
+		return nil, errx.New(http.StatusGone, "分享链接已失效")
+	case shareStatusExpired:
+		return nil, errx.New(http.StatusGone, "分享链接已过期")
+	}
+	return s, nil
+}
+
+// accessShare 校验令牌和访问密码，返回分享链接及简历
+// 简历已删除（包括移入回收站）时按不存在处理
+func accessShare(ctx context.Context, svcCtx *svc.ServiceContext, req *types.ShareAccessReq) (*ent.ResumeShare, *ent.Resume, *model.ResumeContent, error) {
+	s, err := loadActiveShare(ctx, svcCtx, req.Token)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := checkSharePassword(ctx, svcCtx, s, req.Password); err != nil {
+		return nil, nil, nil, err
+	}
+
+	r, err := svcCtx.Ent.Resume.Query().Where(resume.ID(s.ResumeID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, nil, errx.New(http.StatusNotFound, "简历不存在")
+		}
+		return nil, nil, nil, errx.Warp(http.StatusInternalServerError, err, "查询简历失败")
+	}
+	content, err := loadContent(ctx, svcCtx, r.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if content == nil {
+		return nil, nil, nil, errx.New(http.StatusNotFound, "简历内容不存在")
+	}
+	return s, r, content, nil
+}
+
+// checkSharePassword 校验访问密码，连续输错过多时暂时锁定
+func checkSharePassword(ctx context.Context, svcCtx *svc.ServiceContext, s *ent.ResumeShare, password string) error {
+	if s.PasswordHash == "" {
+		return nil
+	}
+	if password == "" {
+		return errx.New(http.StatusUnauthorized, "需要访问密码")
+	}
+
+	key := sharePasswordFailPrefix + strconv.FormatInt(s.ID, 10)
+	if failures, err := svcCtx.Redis.Get(ctx, key).Int(); err == nil && failures >= shareMaxPasswordFailures {
+		return errx.New(http.StatusTooManyRequests, "密码错误次数过多，请稍后再试")
+	}
+	if bcrypt.CompareHashAndPassword([]byte(s.PasswordHash), []byte(password)) != nil {
+		if n, err := svcCtx.Redis.Incr(ctx, key).Result(); err == nil && n == 1 {
+			svcCtx.Redis.Expire(ctx, key, sharePasswordLockTime)
+		}
+		return errx.New(http.StatusForbidden, "访问密码错误")
+	}
+	svcCtx.Redis.Del(ctx, key)
+	return nil
+}
+
+// recordShareView 记录访问并累加访问次数（失败只记录日志，不影响访问）
+func recordShareView(ctx context.Context, svcCtx *svc.ServiceContext, s *ent.ResumeShare, action resumeshareview.Action, req *types.ShareAccessReq) {
+	now := time.Now()
+	err := svcCtx.Ent.ResumeShareView.Create().
+		SetShareID(s.ID).
+		SetResumeID(s.ResumeID).
+		SetAction(action).
+		SetReferrer(cleanReferrer(req.Referer)).
+		SetUserAgent(coarseUserAgent(req.UserAgent)).
+		SetCreatedAt(now).
+		Exec(ctx)
+	if err == nil {
+		err = svcCtx.Ent.ResumeShare.UpdateOneID(s.ID).
+			AddViewCount(1).
+			SetLastViewedAt(now).
+			Exec(ctx)
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("record share view failed: share_id=%d, err=%v", s.ID, err)
+	}
+}
+
+// cleanReferrer 只保留来源页面的协议、域名和路径，去掉查询参数和片段
+func cleanReferrer(referrer string) string {
+	u, err := url.Parse(referrer)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	s := u.Scheme + "://" + u.Host + u.EscapedPath()
+	if len(s) > maxReferrerLen {
+		s = strings.ToValidUTF8(s[:maxReferrerLen], "")
+	}
+	return s
+}
+
+// 客户端识别规则，按顺序匹配第一个包含关键字的规则
+// Edge、Opera 的 UA 同时包含 Chrome，需要排在前面
+var (
+	uaBrowsers = []struct{ keyword, name string }{
+		{"MicroMessenger", "WeChat"},
+		{"DingTalk", "DingTalk"},
+		{"Edg", "Edge"},
+		{"OPR", "Opera"},
+		{"Firefox", "Firefox"},
+		{"Chrome", "Chrome"},
+		{"CriOS", "Chrome"},
+		{"Safari", "Safari"},
+	}
+	uaSystems = []struct{ keyword, name string }{
+		{"Android", "Android"},
+		{"iPhone", "iOS"},
+		{"iPad", "iOS"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	}
+	uaBots = []string{"bot", "spider", "crawler", "curl", "wget", "python", "preview"}
+)
+
+// coarseUserAgent 把 User-Agent 归类为 浏览器/系统（如 Chrome/Windows），不保存原始值
+func coarseUserAgent(ua string) string {
+	if ua == "" {
+		return ""
+	}
+	lower := strings.ToLower(ua)
+	for _, bot := range uaBots {
+		if strings.Contains(lower, bot) {
+			return "Bot"
+		}
+	}
+	browser, system := "Other", "Other"
+	for _, b := range uaBrowsers {
+		if strings.Contains(ua, b.keyword) {
+			browser = b.name
+			break
+		}
+	}
+	for _, s := range uaSystems {
+		if strings.Contains(ua, s.keyword) {
+			system = s.name
+			break
+		}
+	}
+	return browser + "/" + system
+}
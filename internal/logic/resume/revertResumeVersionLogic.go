@@ -0,0 +1,132 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"cv2/internal/infra/ent/scorerun"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevertResumeVersionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 回滚简历到指定版本
+func NewRevertResumeVersionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevertResumeVersionLogic {
+	return &RevertResumeVersionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevertResumeVersionLogic) RevertResumeVersion(req *types.RevertResumeVersionReq) (resp *types.RevertResumeVersionResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, content, err := loadOwnedContent(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, errx.New(http.StatusNotFound, "简历内容不存在")
+	}
+
+	version, err := loadResumeVersion(l.ctx, l.svcCtx, req.ResumeID, req.VersionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 计算需要回滚的模块（只处理内容有变化的模块）
+	current := moduleDataMap(content.Modules)
+	target := moduleDataMap(version.Modules)
+	changed := make(map[int64][]map[string]interface{})
+	for id := range unionModuleIDs(current, target) {
+		if req.ModuleID != 0 && id != req.ModuleID {
+			continue
+		}
+		if !reflect.DeepEqual(current[id].Data, target[id].Data) {
+			changed[id] = target[id].Data
+		}
+	}
+
+	// 2. 写回 MongoDB（以读取时的版本号做乐观锁，期间有其他保存则返回 409）
+	revision := content.Revision
+	if req.ModuleID == 0 {
+		revision, err = l.svcCtx.Mongo.ReplaceModules(l.ctx, req.ResumeID, version.Modules, content.Revision)
+	} else if data, ok := changed[req.ModuleID]; ok {
+		before, inCurrent := current[req.ModuleID]
+		after, inTarget := target[req.ModuleID]
+		switch {
+		case !inCurrent:
+			// 模块已被删除（如自定义模块），按快照原样恢复
+			revision, err = l.svcCtx.Mongo.InsertModule(l.ctx, req.ResumeID, after, content.Revision)
+		case !inTarget && before.Custom:
+			// 自定义模块在该版本之后才创建，回滚即删除
+			revision, err = l.svcCtx.Mongo.RemoveModule(l.ctx, req.ResumeID, req.ModuleID, content.Revision)
+		default:
+			title := after.Title
+			if title == "" {
+				title = before.Title
+			}
+			revision, err = l.svcCtx.Mongo.UpdateModule(l.ctx, req.ResumeID, req.ModuleID, title, data, content.Revision)
+		}
+	}
+	if err != nil {
+		return nil, contentWriteError(err, "回滚模块数据失败")
+	}
+
+	// 回滚已经写入，之后的评分和快照失败只记录日志，仍返回回滚后的版本号
+
+	// 3. 重新计算受影响模块的评分（后台评分）
+	// 回滚前后的模块都可能包含自定义模块
+	modules := append(append([]model.ModuleData{}, content.Modules...), version.Modules...)
+	queueModuleScores(l.ctx, l.svcCtx, req.ResumeID, revision, modules, changed, scorerun.TriggerRescore)
+
+	// 4. 重新渲染，回滚本身也记录为新版本
+	history := &model.ResumeContentHistory{
+		MySQLID:    req.ResumeID,
+		Action:     model.HistoryActionRevert,
+		ModuleID:   req.ModuleID,
+		AuthorID:   owner.UserID,
+		RevertFrom: version.ID,
+	}
+	afterContentChange(l.ctx, l.svcCtx, history)
+
+	moduleIDs := make([]int64, 0, len(changed))
+	for id := range changed {
+		moduleIDs = append(moduleIDs, id)
+	}
+	sort.Slice(moduleIDs, func(i, j int) bool { return moduleIDs[i] < moduleIDs[j] })
+
+	resp = &types.RevertResumeVersionResp{
+		ResumeID:  req.ResumeID,
+		ModuleIDs: make([]string, 0, len(moduleIDs)),
+		Revision:  revision,
+	}
+	if !history.ID.IsZero() {
+		resp.VersionID = history.ID.Hex()
+	}
+	for _, id := range moduleIDs {
+		resp.ModuleIDs = append(resp.ModuleIDs, strconv.FormatInt(id, 10))
+	}
+
+	l.Infof("resume reverted: resume_id=%d, version_id=%s, modules=%v", req.ResumeID, req.VersionID, moduleIDs)
+
+	return resp, nil
+}
@@ -0,0 +1,50 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeResumeShareLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 撤销简历分享链接
+func NewRevokeResumeShareLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeResumeShareLogic {
+	return &RevokeResumeShareLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevokeResumeShareLogic) RevokeResumeShare(req *types.RevokeResumeShareReq) (resp *types.ResumeShareItem, err error) {
+	s, err := loadOwnedShare(l.ctx, l.svcCtx, req.ResumeID, req.ShareID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 重复撤销直接返回
+	if s.RevokedAt == nil {
+		s, err = s.Update().SetRevokedAt(time.Now()).Save(l.ctx)
+		if err != nil {
+			return nil, errx.Warp(http.StatusInternalServerError, err, "撤销分享链接失败")
+		}
+		l.Infof("resume share revoked: resume_id=%d, share_id=%d", s.ResumeID, s.ID)
+	}
+
+	item := shareItem(l.svcCtx, s)
+	return &item, nil
+}
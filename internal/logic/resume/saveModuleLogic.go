@@ -5,15 +5,22 @@ package resume
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
-	"cv2/internal/infra/ent/module"
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/predicate"
 	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/schema"
+	"cv2/internal/infra/moduleregistry"
+	"cv2/internal/infra/mongo"
+	"cv2/internal/infra/mongo/model"
 	"cv2/internal/pkg/errx"
 	"cv2/internal/svc"
 	"cv2/internal/types"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
 )
 
 type SaveModuleLogic struct {
@@ -32,126 +39,174 @@ func NewSaveModuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveMo
 }
 
 func (l *SaveModuleLogic) SaveModule(req *types.SaveModuleReq) (resp *types.SaveModuleResp, err error) {
-	// 1. 查询模块信息
-	mod, err := l.svcCtx.Ent.Module.Query().
-		Where(module.ID(req.ModuleID)).
-		WithDimensions().
-		First(l.ctx)
+	// 0. 校验简历归属
+	owner, err := currentOwner(l.ctx)
 	if err != nil {
-		return nil, errx.Warp(http.StatusNotFound, err, "模块不存在")
+		return nil, err
+	}
+	_, content, err := loadOwnedContent(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+	var modules []model.ModuleData
+	if content != nil {
+		modules = content.Modules
 	}
 
-	// 获取模块下的维度ID列表
-	dimIDs := make([]int64, 0, len(mod.Edges.Dimensions))
-	for _, dim := range mod.Edges.Dimensions {
-		dimIDs = append(dimIDs, dim.ID)
+	// 首次编辑前补录基线版本，保证修改前的内容可回滚
+	if err := ensureHistoryBaseline(l.ctx, l.svcCtx, req.ResumeID, owner.UserID); err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "记录简历版本失败")
 	}
 
-	// 2. 开启事务
-	tx, err := l.svcCtx.Ent.Tx(l.ctx)
-	if err != nil {
-		return nil, errx.Warp(http.StatusInternalServerError, err, "开启事务失败")
-	}
-	defer tx.Rollback()
-
-	// 3. 删除该模块的旧评分（模块得分 + 维度得分）
-	_, err = tx.ResumeScore.Delete().
-		Where(
-			resumescore.ResumeID(req.ResumeID),
-			resumescore.Or(
-				resumescore.And(
-					resumescore.TargetType(0),
-					resumescore.TargetID(req.ModuleID),
-				),
-				resumescore.And(
-					resumescore.TargetType(1),
-					resumescore.TargetIDIn(dimIDs...),
-				),
-			),
-		).Exec(l.ctx)
+	// 1. 查询模块信息（内置模块或简历中的自定义模块）
+	mod, err := loadResumeModule(l.ctx, l.svcCtx, modules, req.ModuleID)
 	if err != nil {
-		return nil, errx.Warp(http.StatusInternalServerError, err, "删除旧评分失败")
+		return nil, err
 	}
 
-	// 4. 更新 MongoDB 中的模块数据
-	err = l.svcCtx.Mongo.UpdateModule(l.ctx, req.ResumeID, req.ModuleID, mod.Title, req.Data)
-	if err != nil {
-		return nil, errx.Warp(http.StatusInternalServerError, err, "更新模块数据失败")
+	// 按模块的 schema 校验数据
+	if err := validateModuleData(l.svcCtx, mod, req.Data, "/data"); err != nil {
+		return nil, err
 	}
 
-	// 5. 重新计算该模块的评分
-	calculator := NewScoreCalculator(l.ctx, l.svcCtx.Ent, l.svcCtx.Algorithm)
-	moduleData := l.convertToModuleData(mod.Title, req.Data)
-	err = calculator.ScoreSingleModule(tx, req.ResumeID, mod.Title, moduleData)
+	// 2. 更新 MongoDB 中的模块数据（校验版本号，保留客户端带上的条目ID）
+	model.EnsureItemIDs(req.Data)
+	revision, err := l.svcCtx.Mongo.UpdateModule(l.ctx, req.ResumeID, req.ModuleID, mod.Title, req.Data, req.Revision)
 	if err != nil {
-		l.Errorf("score module failed: %v", err)
-		// 评分失败不影响保存，继续提交
-	}
-
-	// 6. 提交事务
-	if err := tx.Commit(); err != nil {
-		return nil, errx.Warp(http.StatusInternalServerError, err, "提交事务失败")
-	}
-
-	// 7. 查询新的评分结果
-	scores, err := l.svcCtx.Ent.ResumeScore.Query().
-		Where(
-			resumescore.ResumeID(req.ResumeID),
-			resumescore.Or(
-				resumescore.And(
-					resumescore.TargetType(0),
-					resumescore.TargetID(req.ModuleID),
-				),
-				resumescore.And(
-					resumescore.TargetType(1),
-					resumescore.TargetIDIn(dimIDs...),
-				),
-			),
-		).All(l.ctx)
+		return nil, contentWriteError(err, "更新模块数据失败")
+	}
+
+	// 3. 模块得分标记为等待评分（保留修改前的得分），并加入后台评分队列（force 时不使用评分缓存）
+	// 数据已经保存，标记失败只记录日志，返回 stale 提示得分不是最新的；评分结果通过评分状态接口查询或订阅
+	scoring := resumescore.StateStale
+	if err := markScorePending(l.ctx, l.svcCtx.Ent, req.ResumeID, req.ModuleID, revision); err != nil {
+		l.Errorf("mark score pending failed: resume_id=%d, module_id=%d, err=%v", req.ResumeID, req.ModuleID, err)
+	} else {
+		scoring = scheduleScore(l.ctx, l.svcCtx, req.ResumeID, req.ModuleID, revision, scoreJob{
+			Trigger: saveModuleTrigger(req.Source),
+			Force:   req.Force,
+		})
+	}
+
+	// 4. 重新渲染并写入版本快照（失败不影响保存结果）
+	afterContentChange(l.ctx, l.svcCtx, &model.ResumeContentHistory{
+		MySQLID:  req.ResumeID,
+		Action:   model.HistoryActionSave,
+		ModuleID: req.ModuleID,
+		AuthorID: owner.UserID,
+	})
+
+	// 5. 返回修改前的得分，新的得分在后台评分完成后更新
+	info := buildModuleInfo(l.ctx, l.svcCtx, req.ResumeID, mod, req.Data)
+
+	return &types.SaveModuleResp{
+		ModuleID:   req.ModuleID,
+		Title:      mod.Title,
+		Score:      info.Score,
+		Data:       req.Data,
+		Dimensions: info.Dimensions,
+		Revision:   revision,
+		Scoring:    string(scoring),
+	}, nil
+}
+
+// buildModuleInfo 查询模块的最新评分，构建模块信息（数据 + 得分）
+// 评分查询失败只记录日志，得分按 0 返回
+func buildModuleInfo(ctx context.Context, svcCtx *svc.ServiceContext, resumeID int64, mod *ent.Module, data []map[string]interface{}) types.ModuleInfo {
+	scores, err := svcCtx.Ent.ResumeScore.Query().
+		Where(resumescore.Or(
+			moduleScoresPredicate(resumeID, mod),
+			resumescore.And(resumescore.ResumeID(resumeID), resumescore.TargetType(0)),
+		)).All(ctx)
 	if err != nil {
-		l.Errorf("query scores failed: %v", err)
+		logx.WithContext(ctx).Errorf("query scores failed: %v", err)
 	}
 
-	// 构建得分映射
-	var moduleScore float64
-	dimScoreMap := make(map[int64]float64)
+	// 构建得分映射，模块的权重占比需要简历中全部已评分模块的权重
+	var moduleScore *ent.ResumeScore
+	var moduleWeight float64
+	dimScoreMap := make(map[int64]*ent.ResumeScore)
 	for _, s := range scores {
 		if s.TargetType == 0 {
-			moduleScore = s.Score
+			moduleWeight += s.Weight
+			if s.TargetID == mod.ID {
+				moduleScore = s
+			}
 		} else {
-			dimScoreMap[s.TargetID] = s.Score
+			dimScoreMap[s.TargetID] = s
 		}
 	}
 
-	// 构建维度得分列表
-	var dimensions []types.DimensionInfo
+	return moduleInfo(mod, data, moduleScore, dimScoreMap, moduleWeight)
+}
+
+// deleteModuleScores 删除简历中某个模块的评分（模块得分 + 维度得分）
+// 得分是可重新计算的派生数据，直接物理删除，不保留软删除记录
+func deleteModuleScores(ctx context.Context, tx *ent.Tx, resumeID int64, mod *ent.Module) error {
+	_, err := tx.ResumeScore.Delete().
+		Where(moduleScoresPredicate(resumeID, mod)).
+		Exec(schema.SkipSoftDelete(ctx))
+	return err
+}
+
+// moduleScoresPredicate 简历中某个模块的评分（模块得分 + 维度得分）
+// 自定义模块共用通用模块的维度，维度得分按 scope_id 区分
+func moduleScoresPredicate(resumeID int64, mod *ent.Module) predicate.ResumeScore {
+	dimIDs := make([]int64, 0, len(mod.Edges.Dimensions))
 	for _, dim := range mod.Edges.Dimensions {
-		dimensions = append(dimensions, types.DimensionInfo{
-			DimensionID: dim.ID,
-			Title:       dim.Title,
-			Score:       dimScoreMap[dim.ID],
-		})
+		dimIDs = append(dimIDs, dim.ID)
+	}
+	var scopeID int64
+	if isCustomModule(mod) {
+		scopeID = mod.ID
 	}
 
-	return &types.SaveModuleResp{
-		ModuleID:   req.ModuleID,
-		Title:      mod.Title,
-		Score:      moduleScore,
-		Data:       req.Data,
-		Dimensions: dimensions,
-	}, nil
+	return resumescore.And(
+		resumescore.ResumeID(resumeID),
+		resumescore.Or(
+			resumescore.And(
+				resumescore.TargetType(0),
+				resumescore.TargetID(mod.ID),
+			),
+			resumescore.And(
+				resumescore.TargetType(1),
+				resumescore.TargetIDIn(dimIDs...),
+				resumescore.ScopeID(scopeID),
+			),
+		),
+	)
 }
 
-// convertToModuleData 将请求数据转换为评分所需的格式
-func (l *SaveModuleLogic) convertToModuleData(moduleTitle string, data []map[string]interface{}) interface{} {
-	// 根据模块类型返回不同格式的数据
-	switch moduleTitle {
-	case "基本信息":
+// moduleCode 返回模块编码，未设置时为空字符串
+func moduleCode(mod *ent.Module) string {
+	if mod.Code == nil {
+		return ""
+	}
+	return *mod.Code
+}
+
+// contentWriteError 转换 MongoDB 简历内容写入错误
+func contentWriteError(err error, msg string) error {
+	switch {
+	case errors.Is(err, mongo.ErrRevisionConflict):
+		return errx.New(http.StatusConflict, "简历已被修改，请刷新后重试")
+	case errors.Is(err, mongodriver.ErrNoDocuments):
+		return errx.New(http.StatusNotFound, "简历内容不存在")
+	default:
+		return errx.Warp(http.StatusInternalServerError, err, msg)
+	}
+}
+
+// convertToModuleData 将模块数据转换为评分所需的格式
+func convertToModuleData(shape string, data []map[string]interface{}) interface{} {
+	// 根据模块数据形态返回不同格式的数据
+	switch shape {
+	case moduleregistry.ShapeObject:
 		if len(data) > 0 {
-			return data[0]
+			return stripItemIDs(data[:1])[0]
 		}
 		return map[string]interface{}{}
-	case "技能证书", "自我评价":
+	case moduleregistry.ShapeText:
 		if len(data) > 0 {
 			if content, ok := data[0]["content"].(string); ok {
 				return content
@@ -159,6 +214,21 @@ func (l *SaveModuleLogic) convertToModuleData(moduleTitle string, data []map[str
 		}
 		return ""
 	default:
-		return data
+		return stripItemIDs(data)
+	}
+}
+
+// stripItemIDs 去掉条目ID，条目ID不参与评分
+func stripItemIDs(data []map[string]interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(data))
+	for _, item := range data {
+		cp := make(map[string]interface{}, len(item))
+		for k, v := range item {
+			if k != model.ItemIDKey {
+				cp[k] = v
+			}
+		}
+		result = append(result, cp)
 	}
+	return result
 }
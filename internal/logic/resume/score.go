@@ -2,46 +2,56 @@ package resume
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"time"
 
 	"cv2/internal/infra/algorithm"
 	"cv2/internal/infra/ent"
 	"cv2/internal/infra/ent/dimension"
+	"cv2/internal/infra/moduleregistry"
+	"cv2/internal/infra/mongo/model"
 	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
 )
 
-// 简历模块定义
-const (
-	ModuleBasicInfo = "基本信息"
-	ModuleEducation = "教育背景"
-	ModuleCampus    = "在校经历"
-	ModuleIntern    = "实习经历"
-	ModuleProject   = "项目经历"
-	ModuleSkills    = "技能证书"
-	ModuleSelfEval  = "自我评价"
-)
+// dimensionMeta 评分维度的ID、权重和满分
+type dimensionMeta struct {
+	id       int64 // 默认评分规则没有对应的维度记录，为 0
+	weight   float64
+	maxScore float64 // 判定项中的最高分，维度得分按此换算为百分制
+}
 
-// getScoringRulesByModuleID 根据模块ID从数据库获取评分规则
-func (c *ScoreCalculator) getScoringRulesByModuleID(moduleID int64) (map[string][]algorithm.RuleItem, error) {
+// getScoringRulesByModuleID 根据模块ID从数据库获取评分规则，以及按维度标题索引的维度信息
+func (c *ScoreCalculator) getScoringRulesByModuleID(moduleID int64) (map[string][]algorithm.RuleItem, map[string]dimensionMeta, error) {
 	// 查询该模块下的所有维度
 	dimensions, err := c.entClient.Dimension.Query().
 		Where(dimension.ModuleIDEQ(moduleID)).
 		All(c.ctx)
 
 	if err != nil {
-		return nil, fmt.Errorf("query dimensions failed: %w", err)
+		return nil, nil, fmt.Errorf("query dimensions failed: %w", err)
 	}
 
 	if len(dimensions) == 0 {
 		c.Infof("no dimensions found for module_id=%d, using default rules", moduleID)
-		return c.getDefaultScoringRules(), nil
+		rules := c.getDefaultScoringRules()
+		metas := make(map[string]dimensionMeta, len(rules))
+		for title, items := range rules {
+			metas[title] = dimensionMeta{weight: 1, maxScore: maxJudgmentScore(items)}
+		}
+		return rules, metas, nil
 	}
 
 	// 构造评分规则
 	rules := make(map[string][]algorithm.RuleItem)
+	metas := make(map[string]dimensionMeta)
 	for _, dim := range dimensions {
 		// 从 judgment 字段解析规则项
 		if len(dim.Judgment) == 0 {
@@ -62,11 +72,26 @@ func (c *ScoreCalculator) getScoringRulesByModuleID(moduleID int64) (map[string]
 
 		if len(ruleItems) > 0 {
 			rules[dim.Title] = ruleItems
+			metas[dim.Title] = dimensionMeta{id: dim.ID, weight: dim.Weight, maxScore: maxJudgmentScore(ruleItems)}
 		}
 	}
 
 	c.Infof("loaded %d scoring rules for module_id=%d", len(rules), moduleID)
-	return rules, nil
+	return rules, metas, nil
+}
+
+// maxJudgmentScore 判定项中的最高分，没有正分时按 100 分计
+func maxJudgmentScore(items []algorithm.RuleItem) float64 {
+	var full float64
+	for _, item := range items {
+		if item.JudgmentScore > full {
+			full = item.JudgmentScore
+		}
+	}
+	if full <= 0 {
+		return maxScore
+	}
+	return full
 }
 
 // getDefaultScoringRules 获取默认评分规则（当数据库中没有配置时使用）
@@ -87,168 +112,301 @@ func (c *ScoreCalculator) getDefaultScoringRules() map[string][]algorithm.RuleIt
 }
 
 // ScoreCalculator 评分计算器
+// 评分分两步：Evaluate 在事务外并发调用算法服务，Save 再在一个短事务中写入结果，避免调用期间占用数据库连接
 type ScoreCalculator struct {
 	logx.Logger
-	ctx       context.Context
-	entClient *ent.Client
-	algClient *algorithm.Client
-	// 缓存模块ID到模块名称的映射
-	moduleCache map[int64]string
+	ctx           context.Context
+	entClient     *ent.Client
+	algClient     *algorithm.Client
+	modules       *moduleregistry.Registry
+	redis         *redis.Client
+	workers       int
+	moduleTimeout time.Duration
+	cacheTTL      time.Duration
+	force         bool // 跳过评分缓存，强制调用算法服务
 }
 
 // NewScoreCalculator 创建评分计算器
-func NewScoreCalculator(ctx context.Context, entClient *ent.Client, algClient *algorithm.Client) *ScoreCalculator {
+func NewScoreCalculator(ctx context.Context, svcCtx *svc.ServiceContext) *ScoreCalculator {
+	workers := svcCtx.Config.Scoring.Workers
+	if workers <= 0 {
+		workers = 1
+	}
 	return &ScoreCalculator{
-		Logger:      logx.WithContext(ctx),
-		ctx:         ctx,
-		entClient:   entClient,
-		algClient:   algClient,
-		moduleCache: make(map[int64]string),
+		Logger:        logx.WithContext(ctx),
+		ctx:           ctx,
+		entClient:     svcCtx.Ent,
+		algClient:     svcCtx.Algorithm,
+		modules:       svcCtx.Modules,
+		redis:         svcCtx.Redis,
+		workers:       workers,
+		moduleTimeout: time.Duration(svcCtx.Config.Scoring.ModuleTimeoutSeconds) * time.Second,
+		cacheTTL:      time.Duration(svcCtx.Config.Scoring.CacheTTLSeconds) * time.Second,
 	}
 }
 
-// ScoreSingleModule 对单个模块进行评分（公开方法，供外部调用）
-func (c *ScoreCalculator) ScoreSingleModule(tx *ent.Tx, resumeID int64, moduleName string, moduleData interface{}) error {
-	return c.scoreModule(tx, resumeID, moduleName, moduleData)
+// WithForce 设置是否跳过评分缓存
+func (c *ScoreCalculator) WithForce(force bool) *ScoreCalculator {
+	c.force = force
+	return c
 }
 
-// CalculateResumeScore 计算整份简历的评分（在事务中执行）
-func (c *ScoreCalculator) CalculateResumeScore(tx *ent.Tx, resumeID int64, data *algorithm.ResumeData) error {
-	// 定义需要评分的模块
-	modules := []struct {
-		name string
-		data interface{}
-	}{
-		{ModuleBasicInfo, c.extractBasicInfo(data)},
-		{ModuleEducation, data.Education},
-		{ModuleCampus, data.CampusExp},
-		{ModuleIntern, data.InternExp},
-		{ModuleProject, data.ProjectExp},
-		{ModuleSkills, data.Skills},
-		{ModuleSelfEval, data.SelfEval},
-	}
-
-	// 计算每个模块的评分
-	for _, module := range modules {
-		if err := c.scoreModule(tx, resumeID, module.name, module.data); err != nil {
-			c.Errorf("score module failed: module=%s, error=%v", module.name, err)
-			// 单个模块评分失败不影响其他模块，继续执行
-			continue
-		}
-	}
+// ModuleScoreJob 待评分的模块
+type ModuleScoreJob struct {
+	Module moduleregistry.Module // 自定义模块使用通用模块的评分规则
+	Data   interface{}           // 发给算法服务的模块数据
+}
 
-	return nil
+// ModuleScoreResult 模块评分结果，Err 不为空表示该模块评分失败
+type ModuleScoreResult struct {
+	Module     moduleregistry.Module
+	Score      float64
+	Dimensions []DimensionScore
+	Err        error
 }
 
-// scoreModule 对单个模块进行评分
-func (c *ScoreCalculator) scoreModule(tx *ent.Tx, resumeID int64, moduleName string, moduleData interface{}) error {
-	// 1. 获取模块ID
-	moduleID := c.getModuleID(moduleName)
-	if moduleID == 0 {
-		c.Errorf("unknown module name: %s, skip scoring", moduleName)
-		return nil
-	}
+// DimensionScore 维度得分（百分制）
+type DimensionScore struct {
+	DimensionID int64 // 默认评分规则没有维度记录，为 0
+	Title       string
+	Score       float64
+	Weight      float64
+}
 
-	// 2. 从数据库获取该模块的评分规则
-	rules, err := c.getScoringRulesByModuleID(moduleID)
-	if err != nil {
-		return fmt.Errorf("get scoring rules failed: %w", err)
-	}
+// errScoreAborted 评分任务异常退出（panic）时的错误
+var errScoreAborted = errors.New("score task aborted")
 
-	if len(rules) == 0 {
-		c.Errorf("no scoring rules for module: %s (id=%d)", moduleName, moduleID)
-		return nil
-	}
+// 评分失败原因
+const (
+	scoreFailTimeout = "timeout" // 评分超时
+	scoreFailError   = "error"   // 评分服务异常或没有可用的评分规则
+)
 
-	// 3. 准备评分请求
-	section := map[string]interface{}{
-		moduleName: moduleData,
+// scoreFailureReason 评分失败原因分类
+func scoreFailureReason(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return scoreFailTimeout
 	}
+	return scoreFailError
+}
 
-	req := &algorithm.ScoreRequest{
-		Section: section,
-		Rules:   rules,
+// ResumeScoreJobs 整份简历需要评分的模块：内置模块按结构化数据评分，自定义模块（如导入的获奖经历）使用通用模块的评分规则
+func (c *ScoreCalculator) ResumeScoreJobs(data *algorithm.ResumeData, modules []model.ModuleData) []ModuleScoreJob {
+	// 定义需要评分的模块（按模块编码）
+	type moduleInput struct {
+		code string
+		data interface{}
 	}
-
-	// 4. 调用算法服务评分
-	scores, err := c.algClient.ScoreResume(c.ctx, req)
-	if err != nil {
-		return fmt.Errorf("call algorithm service: %w", err)
+	inputs := []moduleInput{
+		{moduleregistry.CodeBasicInfo, c.extractBasicInfo(data)},
+		{moduleregistry.CodeEducation, data.Education},
+		{moduleregistry.CodeCampus, data.CampusExp},
+		{moduleregistry.CodeIntern, data.InternExp},
+		{moduleregistry.CodeProject, data.ProjectExp},
+		{moduleregistry.CodeSkills, data.Skills},
+		{moduleregistry.CodeSelfEval, data.SelfEval},
+	}
+	// 工作经历只对有全职经历的简历评分，避免学生简历因空模块拉低总分
+	if len(data.WorkExp) > 0 {
+		inputs = append(inputs, moduleInput{moduleregistry.CodeWork, data.WorkExp})
 	}
 
-	// 4. 保存评分结果
-	return c.saveModuleScores(tx, resumeID, moduleName, scores)
+	jobs := make([]ModuleScoreJob, 0, len(inputs)+len(modules))
+	for _, in := range inputs {
+		m, ok := c.modules.ByCode(in.code)
+		if !ok {
+			c.Errorf("module not registered: code=%s, skip scoring", in.code)
+			continue
+		}
+		jobs = append(jobs, ModuleScoreJob{Module: m, Data: in.data})
+	}
+	for _, mod := range modules {
+		if !mod.Custom || len(mod.Data) == 0 {
+			continue
+		}
+		m, ok := c.modules.Custom(mod.ModuleID, mod.Title)
+		if !ok {
+			continue
+		}
+		jobs = append(jobs, ModuleScoreJob{Module: m, Data: stripItemIDs(mod.Data)})
+	}
+	return jobs
 }
 
-// saveModuleScores 保存模块评分（在事务中执行）
-func (c *ScoreCalculator) saveModuleScores(tx *ent.Tx, resumeID int64, moduleName string, scores []*algorithm.DimScore) error {
-	if len(scores) == 0 {
-		c.Infof("no scores returned for module: %s", moduleName)
-		return nil
+// Evaluate 并发评分，同时评分的模块数和单个模块的超时按 Scoring 配置
+// 不访问事务；单个模块失败不影响其他模块，结果与 jobs 一一对应
+func (c *ScoreCalculator) Evaluate(jobs []ModuleScoreJob) []ModuleScoreResult {
+	results := make([]ModuleScoreResult, len(jobs))
+	runner := threading.NewTaskRunner(c.workers)
+	for i, job := range jobs {
+		results[i] = ModuleScoreResult{Module: job.Module, Err: errScoreAborted}
+		runner.Schedule(func() {
+			results[i] = c.evaluateModule(job)
+		})
 	}
+	runner.Wait()
 
-	// 计算模块总分（加权平均）
-	var totalScore float64
-	var totalWeight float64 = float64(len(scores))
-
-	for _, score := range scores {
-		totalScore += score.Score
+	for _, r := range results {
+		if r.Err != nil {
+			c.Errorf("score module failed: module=%s, error=%v", r.Module.Title, r.Err)
+		}
 	}
+	return results
+}
 
-	moduleScore := totalScore / totalWeight
+// evaluateModule 对单个模块评分
+func (c *ScoreCalculator) evaluateModule(job ModuleScoreJob) ModuleScoreResult {
+	m := job.Module
+	result := ModuleScoreResult{Module: m}
 
-	// 保存模块总分（target_type=0 表示模块）
-	moduleID := c.getModuleID(moduleName)
-	_, err := tx.ResumeScore.Create().
-		SetResumeID(resumeID).
-		SetTargetID(moduleID).
-		SetTargetType(0).
-		SetScore(moduleScore).
-		SetWeight(1.0).
-		Save(c.ctx)
+	// 1. 从数据库获取该模块的评分规则
+	rules, metas, err := c.getScoringRulesByModuleID(m.RuleModuleID)
 	if err != nil {
-		return errx.Warpf(http.StatusInternalServerError, err, "保存模块得分失败: module=%s", moduleName)
+		result.Err = fmt.Errorf("get scoring rules failed: %w", err)
+		return result
+	}
+	if len(rules) == 0 {
+		result.Err = fmt.Errorf("no scoring rules for module: %s (id=%d)", m.Title, m.ID)
+		return result
 	}
 
-	c.Infof("saved module score: module=%s, score=%.2f", moduleName, moduleScore)
-
-	// 查询模块下的维度，构建维度名到ID的映射
-	dimensions, err := c.entClient.Dimension.Query().
-		Where(dimension.ModuleIDEQ(moduleID)).
-		All(c.ctx)
-	if err != nil {
-		c.Errorf("query dimensions failed: %v", err)
+	// 2. 调用算法服务评分（单个模块超时），模块数据和评分规则不变时使用缓存的结果
+	req := &algorithm.ScoreRequest{
+		Section: map[string]interface{}{m.Title: job.Data},
+		Rules:   rules,
 	}
-	dimNameToID := make(map[string]int64)
-	for _, dim := range dimensions {
-		dimNameToID[dim.Title] = dim.ID
+	ctx := c.ctx
+	if c.moduleTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.moduleTimeout)
+		defer cancel()
+	}
+	scores, err := c.scoreWithCache(ctx, req)
+	if err != nil {
+		result.Err = fmt.Errorf("call algorithm service: %w", err)
+		return result
 	}
 
-	// 保存各维度得分（target_type=1 表示维度）
+	// 3. 维度得分换算为百分制，模块得分为维度得分按维度权重的加权平均
+	var weightedSum, totalWeight float64
 	for _, score := range scores {
-		dimensionID, ok := dimNameToID[score.Rule]
+		meta, ok := metas[score.Rule]
 		if !ok {
 			c.Errorf("dimension not found: %s", score.Rule)
 			continue
 		}
+		normalized := normalizeScore(score.Score, meta.maxScore)
+		result.Dimensions = append(result.Dimensions, DimensionScore{
+			DimensionID: meta.id,
+			Title:       score.Rule,
+			Score:       normalized,
+			Weight:      meta.weight,
+		})
+		weightedSum += normalized * meta.weight
+		totalWeight += meta.weight
+	}
+	if len(result.Dimensions) == 0 {
+		result.Err = fmt.Errorf("no known dimension in scores: module=%s", m.Title)
+		return result
+	}
+	if totalWeight > 0 {
+		result.Score = weightedSum / totalWeight
+	}
+	return result
+}
+
+// Save 在事务中保存评分结果，评分失败的模块不写入得分
+// 写入失败时返回错误，调用方需回滚事务，避免提交只有部分模块或部分维度的得分
+// 评分记录中保存模块和维度当时的权重，总分和响应中的权重占比都按记录计算，调整权重后重新评分才会生效
+func (c *ScoreCalculator) Save(tx *ent.Tx, resumeID int64, results []ModuleScoreResult) error {
+	for _, r := range results {
+		if r.Err != nil {
+			continue
+		}
+		if err := c.saveModuleScores(tx, resumeID, r); err != nil {
+			c.Errorf("save module score failed: module=%s, error=%v", r.Module.Title, err)
+			return err
+		}
+	}
+	return nil
+}
 
-		_, err := tx.ResumeScore.Create().
-			SetResumeID(resumeID).
-			SetTargetID(dimensionID).
-			SetTargetType(1).
-			SetScore(score.Score).
-			SetWeight(1.0 / totalWeight).
-			Save(c.ctx)
-		if err != nil {
-			c.Errorf("save dimension score failed: dimension=%s, error=%v", score.Rule, err)
+// saveModuleScores 保存单个模块的评分（在事务中执行）
+// 模块总分和各维度得分在同一条批量插入中写入，不会只写入其中一部分
+func (c *ScoreCalculator) saveModuleScores(tx *ent.Tx, resumeID int64, r ModuleScoreResult) error {
+	m := r.Module
+
+	// 模块总分（target_type=0 表示模块）
+	builders := make([]*ent.ResumeScoreCreate, 0, len(r.Dimensions)+1)
+	builders = append(builders, tx.ResumeScore.Create().
+		SetResumeID(resumeID).
+		SetTargetID(m.ID).
+		SetTargetType(0).
+		SetScore(r.Score).
+		SetWeight(m.Weight))
+
+	// 各维度得分（target_type=1 表示维度）
+	// 自定义模块共用通用模块的维度，用 scope_id 区分所属的自定义模块
+	var scopeID int64
+	if m.IsCustom() {
+		scopeID = m.ID
+	}
+	for _, d := range r.Dimensions {
+		if d.DimensionID == 0 {
+			// 默认评分规则没有维度记录，只计入模块得分
 			continue
 		}
-		c.Infof("saved dimension score: module=%s, dimension=%s, score=%.2f", moduleName, score.Rule, score.Score)
+		builders = append(builders, tx.ResumeScore.Create().
+			SetResumeID(resumeID).
+			SetTargetID(d.DimensionID).
+			SetTargetType(1).
+			SetScopeID(scopeID).
+			SetScore(d.Score).
+			SetWeight(d.Weight))
+	}
+	if err := tx.ResumeScore.CreateBulk(builders...).Exec(c.ctx); err != nil {
+		return errx.Warpf(http.StatusInternalServerError, err, "保存模块得分失败: module=%s", m.Title)
 	}
 
+	c.Infof("saved module score: module=%s, score=%.2f, dimensions=%d", m.Title, r.Score, len(builders)-1)
 	return nil
 }
 
+// maxScore 百分制满分
+const maxScore = 100
+
+// normalizeScore 按维度满分把得分换算为百分制
+func normalizeScore(score, full float64) float64 {
+	if full <= 0 {
+		full = maxScore
+	}
+	return math.Min(math.Max(score*maxScore/full, 0), maxScore)
+}
+
+// weightShare 权重在同级权重之和中的占比，用于向前端说明得分构成
+func weightShare(weight, total float64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return weight / total
+}
+
+// weightedTotal 简历总分：已评分模块的得分按模块权重加权平均（百分制），没有模块得分时为 0
+func weightedTotal(scores []*ent.ResumeScore) float64 {
+	var sum, totalWeight float64
+	for _, s := range scores {
+		if s.TargetType != 0 {
+			continue
+		}
+		sum += s.Score * s.Weight
+		totalWeight += s.Weight
+	}
+	if totalWeight <= 0 {
+		return 0
+	}
+	return sum / totalWeight
+}
+
 // extractBasicInfo 提取基本信息
 func (c *ScoreCalculator) extractBasicInfo(data *algorithm.ResumeData) map[string]interface{} {
 	return map[string]interface{}{
@@ -263,24 +421,6 @@ func (c *ScoreCalculator) extractBasicInfo(data *algorithm.ResumeData) map[strin
 	}
 }
 
-// getModuleID 获取模块ID（简化版，实际应该从配置或数据库获取）
-func (c *ScoreCalculator) getModuleID(moduleName string) int64 {
-	moduleIDs := map[string]int64{
-		ModuleBasicInfo: 1,
-		ModuleEducation: 2,
-		ModuleCampus:    3,
-		ModuleIntern:    4,
-		ModuleProject:   5,
-		ModuleSkills:    6,
-		ModuleSelfEval:  7,
-	}
-
-	if id, ok := moduleIDs[moduleName]; ok {
-		return id
-	}
-	return 0
-}
-
 // GetResumeScores 获取简历评分
 func (c *ScoreCalculator) GetResumeScores(resumeID int64) (map[string]interface{}, error) {
 	// 使用 ent 的查询方法需要导入 resumescore 包
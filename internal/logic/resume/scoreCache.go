@@ -0,0 +1,118 @@
+package resume
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"cv2/internal/infra/algorithm"
+	"cv2/internal/pkg/metrics"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 评分缓存
+// 算法服务的评分结果（维度原始得分）按 规则版本 + 模块数据哈希 缓存在 Redis 中，
+// 模块数据不变、评分规则不变时直接使用缓存，不再调用算法服务（如重复保存未修改的模块）。
+// 缓存的是换算前的原始得分，维度满分和权重在读取缓存后按最新的规则计算，调整权重不需要清除缓存
+
+const scoreCachePrefix = "resume_score:cache:"
+
+// 评分缓存结果，对应指标 cv2_business_score_cache_total 的 result 标签
+const (
+	scoreCacheHit    = "hit"
+	scoreCacheMiss   = "miss"
+	scoreCacheBypass = "bypass" // 强制重新评分
+)
+
+// scoreCacheKey 评分缓存键：resume_score:cache:{规则版本}:{模块数据哈希}
+// 规则版本取该模块评分规则（维度及判定项）的哈希，只有发给算法服务的规则变化时缓存才失效；
+// 模块标题是评分请求的一部分（算法服务按标题识别模块），一并计入数据哈希
+func scoreCacheKey(req *algorithm.ScoreRequest) (string, error) {
+	rules, err := canonicalJSON(req.Rules)
+	if err != nil {
+		return "", err
+	}
+	section, err := canonicalJSON(req.Section)
+	if err != nil {
+		return "", err
+	}
+	ruleSum := sha256.Sum256(rules)
+	sectionSum := sha256.Sum256(section)
+	return scoreCachePrefix + hex.EncodeToString(ruleSum[:8]) + ":" + hex.EncodeToString(sectionSum[:]), nil
+}
+
+// canonicalJSON 规范化的 JSON：结构体和 map 统一转成按键排序的对象，数字保留原始写法
+// 上传时的结构化数据（结构体）和编辑保存的模块数据（map）内容相同时结果一致
+func canonicalJSON(v interface{}) ([]byte, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var generic interface{}
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return json.Marshal(generic)
+}
+
+// loadCachedScores 读取缓存的评分结果，没有缓存或读取失败时返回 false
+func (c *ScoreCalculator) loadCachedScores(key string) ([]*algorithm.DimScore, bool) {
+	data, err := c.redis.Get(c.ctx, key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			c.Errorf("get score cache failed: key=%s, err=%v", key, err)
+		}
+		return nil, false
+	}
+	var scores []*algorithm.DimScore
+	if err := json.Unmarshal(data, &scores); err != nil || len(scores) == 0 {
+		c.Errorf("decode score cache failed: key=%s, err=%v", key, err)
+		return nil, false
+	}
+	return scores, true
+}
+
+// storeCachedScores 缓存评分结果（失败只记录日志）
+func (c *ScoreCalculator) storeCachedScores(key string, scores []*algorithm.DimScore) {
+	if len(scores) == 0 || c.cacheTTL <= 0 {
+		return
+	}
+	data, err := json.Marshal(scores)
+	if err != nil {
+		return
+	}
+	if err := c.redis.Set(c.ctx, key, data, c.cacheTTL).Err(); err != nil {
+		c.Errorf("set score cache failed: key=%s, err=%v", key, err)
+	}
+}
+
+// scoreWithCache 调用算法服务评分，优先使用缓存；force 为 true 时跳过缓存读取，评分结果仍会写入缓存
+func (c *ScoreCalculator) scoreWithCache(ctx context.Context, req *algorithm.ScoreRequest) ([]*algorithm.DimScore, error) {
+	key, err := scoreCacheKey(req)
+	if err != nil {
+		// 数据无法序列化时算法服务也无法评分，直接调用以返回原始错误
+		return c.algClient.ScoreResume(ctx, req)
+	}
+
+	if c.force {
+		metrics.ScoreCacheTotal.WithLabelValues(scoreCacheBypass).Inc()
+	} else if scores, ok := c.loadCachedScores(key); ok {
+		metrics.ScoreCacheTotal.WithLabelValues(scoreCacheHit).Inc()
+		return scores, nil
+	} else {
+		metrics.ScoreCacheTotal.WithLabelValues(scoreCacheMiss).Inc()
+	}
+
+	scores, err := c.algClient.ScoreResume(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	c.storeCachedScores(key, scores)
+	return scores, nil
+}
@@ -0,0 +1,226 @@
+package resume
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+)
+
+// 评分历史
+// 每次评分（上传、编辑保存、采纳 AI 帮写、重新评分）在评分所在的事务中追加一条 score_run，
+// 并把评分后全部已评分模块的得分写入 score_history，只追加不修改。resume_score 只保存当前得分
+
+// saveModuleTrigger 保存模块的评分触发方式
+func saveModuleTrigger(source string) scorerun.Trigger {
+	if source == "ai" {
+		return scorerun.TriggerAiApply
+	}
+	return scorerun.TriggerSave
+}
+
+// recordScoreRun 在事务中追加评分记录，返回错误时调用方需回滚事务（评分记录和明细要么都写入，要么都不写入）
+// results 为本次的评分结果，成功的模块记为重新评分，失败的模块及原因写入 failures；其余模块沿用上一次评分记录中的标题
+func recordScoreRun(ctx context.Context, tx *ent.Tx, svcCtx *svc.ServiceContext, resumeID int64, trigger scorerun.Trigger, results []ModuleScoreResult) error {
+	scores, err := tx.ResumeScore.Query().
+		Where(resumescore.ResumeID(resumeID), resumescore.TargetType(0)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query module scores: %w", err)
+	}
+
+	titles, err := lastScoreTitles(ctx, tx, resumeID)
+	if err != nil {
+		return err
+	}
+	rescored := make(map[int64]bool, len(results))
+	var failures []map[string]string
+	for _, r := range results {
+		if r.Err != nil {
+			failures = append(failures, map[string]string{
+				"module_id": strconv.FormatInt(r.Module.ID, 10),
+				"title":     r.Module.Title,
+				"reason":    scoreFailureReason(r.Err),
+				"error":     r.Err.Error(),
+			})
+			continue
+		}
+		titles[r.Module.ID] = r.Module.Title
+		rescored[r.Module.ID] = true
+	}
+
+	status := scorerun.StatusSuccess
+	if len(failures) > 0 {
+		status = scorerun.StatusPartial
+		if len(failures) == len(results) {
+			status = scorerun.StatusFailed
+		}
+	}
+
+	run, err := tx.ScoreRun.Create().
+		SetResumeID(resumeID).
+		SetTrigger(trigger).
+		SetRuleVersion(svcCtx.Modules.RuleVersion()).
+		SetTotalScore(weightedTotal(scores)).
+		SetStatus(status).
+		SetFailures(failures).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create score run: %w", err)
+	}
+	builders := make([]*ent.ScoreHistoryCreate, 0, len(scores))
+	for _, s := range scores {
+		if !s.Scored {
+			// 等待首次评分的占位记录
+			continue
+		}
+		title, ok := titles[s.TargetID]
+		if !ok {
+			if m, found := svcCtx.Modules.ByID(s.TargetID); found {
+				title = m.Title
+			}
+		}
+		builders = append(builders, tx.ScoreHistory.Create().
+			SetRunID(run.ID).
+			SetResumeID(resumeID).
+			SetModuleID(s.TargetID).
+			SetTitle(title).
+			SetScore(s.Score).
+			SetWeight(s.Weight).
+			SetRescored(rescored[s.TargetID]).
+			SetCreatedAt(run.CreatedAt))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+	if err := tx.ScoreHistory.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("create score history: %w", err)
+	}
+	return nil
+}
+
+// lastScoreTitles 上一次评分记录中各模块的标题
+func lastScoreTitles(ctx context.Context, tx *ent.Tx, resumeID int64) (map[int64]string, error) {
+	titles := make(map[int64]string)
+	last, err := tx.ScoreRun.Query().
+		Where(scorerun.ResumeID(resumeID)).
+		Order(ent.Desc(scorerun.FieldCreatedAt), ent.Desc(scorerun.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return titles, nil
+		}
+		return nil, fmt.Errorf("query last score run: %w", err)
+	}
+	rows, err := tx.ScoreHistory.Query().
+		Where(scorehistory.RunID(last.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query last score history: %w", err)
+	}
+	for _, row := range rows {
+		titles[row.ModuleID] = row.Title
+	}
+	return titles, nil
+}
+
+// scoreFailures 转换评分失败的模块，不返回原始错误信息
+func scoreFailures(failures []map[string]string) []types.ScoreFailure {
+	result := make([]types.ScoreFailure, 0, len(failures))
+	for _, f := range failures {
+		id, _ := strconv.ParseInt(f["module_id"], 10, 64)
+		result = append(result, types.ScoreFailure{
+			ModuleID: id,
+			Title:    f["title"],
+			Reason:   f["reason"],
+		})
+	}
+	return result
+}
+
+// scoreSeries 按评分记录（时间升序）构建各模块的得分曲线，模块按首次出现的顺序排列
+func scoreSeries(runs []*ent.ScoreRun, rows []*ent.ScoreHistory) []types.ModuleScoreSeries {
+	byRun := make(map[int64][]*ent.ScoreHistory, len(runs))
+	for _, row := range rows {
+		byRun[row.RunID] = append(byRun[row.RunID], row)
+	}
+
+	var series []types.ModuleScoreSeries
+	index := make(map[int64]int)
+	for _, run := range runs {
+		list := byRun[run.ID]
+		sort.Slice(list, func(i, j int) bool { return list[i].ModuleID < list[j].ModuleID })
+		for _, row := range list {
+			i, ok := index[row.ModuleID]
+			if !ok {
+				i = len(series)
+				index[row.ModuleID] = i
+				series = append(series, types.ModuleScoreSeries{ModuleID: row.ModuleID})
+			}
+			series[i].Title = row.Title
+			series[i].Points = append(series[i].Points, types.ScorePoint{
+				RunID:     run.ID,
+				Score:     row.Score,
+				CreatedAt: run.CreatedAt.Format(timeLayout),
+			})
+		}
+	}
+	return series
+}
+
+// scoreChanges 计算各模块相邻两次评分间的得分变化，返回提升最多和下降最多的各 top 条
+// 只对比相邻两次评分中都有得分的模块，得分不变的不计入
+func scoreChanges(runs []*ent.ScoreRun, series []types.ModuleScoreSeries, top int) (gains, regressions []types.ScoreChange) {
+	runByID := make(map[int64]*ent.ScoreRun, len(runs))
+	next := make(map[int64]int64, len(runs))
+	for i, run := range runs {
+		runByID[run.ID] = run
+		if i > 0 {
+			next[runs[i-1].ID] = run.ID
+		}
+	}
+
+	gains, regressions = []types.ScoreChange{}, []types.ScoreChange{}
+	for _, s := range series {
+		for i := 1; i < len(s.Points); i++ {
+			from, to := s.Points[i-1], s.Points[i]
+			// 中间有评分记录没有该模块的得分（如模块评分失败或被删除）时不算相邻
+			if next[from.RunID] != to.RunID || to.Score == from.Score {
+				continue
+			}
+			change := types.ScoreChange{
+				ModuleID:  s.ModuleID,
+				Title:     s.Title,
+				FromRunID: from.RunID,
+				ToRunID:   to.RunID,
+				Trigger:   string(runByID[to.RunID].Trigger),
+				Before:    from.Score,
+				After:     to.Score,
+				Delta:     to.Score - from.Score,
+				CreatedAt: to.CreatedAt,
+			}
+			if change.Delta > 0 {
+				gains = append(gains, change)
+			} else {
+				regressions = append(regressions, change)
+			}
+		}
+	}
+
+	sort.SliceStable(gains, func(i, j int) bool { return gains[i].Delta > gains[j].Delta })
+	sort.SliceStable(regressions, func(i, j int) bool { return regressions[i].Delta < regressions[j].Delta })
+	if len(gains) > top {
+		gains = gains[:top]
+	}
+	if len(regressions) > top {
+		regressions = regressions[:top]
+	}
+	return gains, regressions
+}
@@ -0,0 +1,422 @@
+package resume
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/scorerun"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// 后台评分
+// 保存模块时在事务中把模块得分标记为 pending（记下保存后的内容版本号，没有得分的模块创建占位记录），
+// 提交后把 简历ID:模块ID 加入 Redis 有序集合，接口立即返回。后台任务通过 ZREM 抢占（与读取、删除任务参数在同一脚本中原子执行）后按 MongoDB 中最新的模块数据评分，
+// 写入前校验得分仍是 pending 且版本号未变：评分期间模块再次保存时丢弃本次结果，由新的任务重新评分（数据相同时命中评分缓存）。
+// 每个任务结束后向 resume_score:events:{简历ID} 发布通知，订阅评分状态的连接据此推送最新状态
+
+const (
+	scoreQueueKey      = "resume_score:queue"   // 待评分模块（简历ID:模块ID），score 为入队时间（毫秒）
+	scoreJobKey        = "resume_score:job"     // 评分任务参数（简历ID:模块ID -> scoreJob）
+	scoreEventPrefix   = "resume_score:events:" // 评分状态变化通知频道
+	scorePollInterval  = time.Second
+	scoreBatchSize     = 20
+	scoreSweepInterval = time.Minute
+)
+
+// 简历整体的评分状态
+const (
+	scoringPending = "pending" // 有模块正在后台评分
+	scoringDone    = "done"
+)
+
+// scoreClaimScript 抢占评分任务：从队列移除成员并取出任务参数，三步原子执行，
+// 避免移除后、读取前重新入队的新参数被误删；队列中已没有该成员（被其他实例抢占）时返回 nil
+var scoreClaimScript = redis.NewScript(`
+if redis.call('ZREM', KEYS[1], ARGV[1]) == 0 then
+	return false
+end
+local payload = redis.call('HGET', KEYS[2], ARGV[1]) or ''
+redis.call('HDEL', KEYS[2], ARGV[1])
+return payload
+`)
+
+// scoreJob 评分任务参数，同一模块排队期间多次保存时以最后一次为准
+type scoreJob struct {
+	Trigger scorerun.Trigger `json:"trigger"`
+	Force   bool             `json:"force"` // 跳过评分缓存
+}
+
+// StartScoreWorker 启动后台评分任务
+func StartScoreWorker(svcCtx *svc.ServiceContext) {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(scorePollInterval)
+		defer ticker.Stop()
+
+		lastSweep := time.Now()
+		for range ticker.C {
+			scoreQueuedModules(context.Background(), svcCtx)
+			if time.Since(lastSweep) >= scoreSweepInterval {
+				sweepStuckScores(context.Background(), svcCtx)
+				lastSweep = time.Now()
+			}
+		}
+	})
+}
+
+// scoreQueueMember 评分队列成员：简历ID:模块ID
+func scoreQueueMember(resumeID, moduleID int64) string {
+	return strconv.FormatInt(resumeID, 10) + ":" + strconv.FormatInt(moduleID, 10)
+}
+
+// parseScoreQueueMember 解析评分队列成员
+func parseScoreQueueMember(member string) (resumeID, moduleID int64, err error) {
+	r, m, ok := strings.Cut(member, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid score queue member: %s", member)
+	}
+	if resumeID, err = strconv.ParseInt(r, 10, 64); err != nil {
+		return 0, 0, err
+	}
+	if moduleID, err = strconv.ParseInt(m, 10, 64); err != nil {
+		return 0, 0, err
+	}
+	return resumeID, moduleID, nil
+}
+
+// markScorePending 把模块得分标记为等待评分，revision 为保存后的内容版本号
+// 模块还没有得分时创建占位记录（权重为 0，不计入总分）；client 可以是事务的客户端
+func markScorePending(ctx context.Context, client *ent.Client, resumeID, moduleID, revision int64) error {
+	n, err := client.ResumeScore.Update().
+		Where(
+			resumescore.ResumeID(resumeID),
+			resumescore.TargetType(0),
+			resumescore.TargetID(moduleID),
+		).
+		SetState(resumescore.StatePending).
+		SetRevision(revision).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	return client.ResumeScore.Create().
+		SetResumeID(resumeID).
+		SetTargetID(moduleID).
+		SetTargetType(0).
+		SetState(resumescore.StatePending).
+		SetRevision(revision).
+		SetScored(false).
+		Exec(ctx)
+}
+
+// scheduleScore 把模块加入评分队列，加入失败时模块得分标记为 stale，返回模块当前的评分状态
+func scheduleScore(ctx context.Context, svcCtx *svc.ServiceContext, resumeID, moduleID, revision int64, job scoreJob) resumescore.State {
+	member := scoreQueueMember(resumeID, moduleID)
+	payload, _ := json.Marshal(job)
+	// 任务参数和队列成员在同一事务中写入，抢占方不会读到只写入了一半的任务
+	_, err := svcCtx.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, scoreJobKey, member, payload)
+		pipe.ZAdd(ctx, scoreQueueKey, redis.Z{Score: float64(time.Now().UnixMilli()), Member: member})
+		return nil
+	})
+	if err == nil {
+		return resumescore.StatePending
+	}
+
+	logx.WithContext(ctx).Errorf("schedule module score failed: resume_id=%d, module_id=%d, err=%v", resumeID, moduleID, err)
+	finishPendingScore(ctx, svcCtx.Ent, resumeID, moduleID, revision, resumescore.StateStale)
+	return resumescore.StateStale
+}
+
+// queueModuleScores 内容修改后把受影响模块的评分交给后台评分，data 为模块ID到修改后模块数据的映射
+// 有数据的模块标记为 pending 并加入评分队列（后台按最新数据评分），数据为空（含已移除）的模块直接清除评分；
+// modules 用于查找自定义模块，需包含修改前后的模块。内容已经写入，失败只记录日志（模块保留修改前的得分）
+func queueModuleScores(ctx context.Context, svcCtx *svc.ServiceContext, resumeID, revision int64, modules []model.ModuleData, data map[int64][]map[string]interface{}, trigger scorerun.Trigger) {
+	if len(data) == 0 {
+		return
+	}
+	if err := markModulesPending(ctx, svcCtx, resumeID, revision, modules, data); err != nil {
+		logx.WithContext(ctx).Errorf("queue module scores failed: resume_id=%d, err=%v", resumeID, err)
+		return
+	}
+	for id, items := range data {
+		if len(items) > 0 {
+			scheduleScore(ctx, svcCtx, resumeID, id, revision, scoreJob{Trigger: trigger})
+		}
+	}
+}
+
+// markModulesPending 在事务中把有数据的模块得分标记为 pending，清除数据为空的模块的评分
+func markModulesPending(ctx context.Context, svcCtx *svc.ServiceContext, resumeID, revision int64, modules []model.ModuleData, data map[int64][]map[string]interface{}) error {
+
+	moduleIDs := make([]int64, 0, len(data))
+	for id := range data {
+		moduleIDs = append(moduleIDs, id)
+	}
+	mods, err := loadResumeModules(ctx, svcCtx, modules, moduleIDs)
+	if err != nil {
+		return err
+	}
+
+	tx, err := svcCtx.Ent.Tx(ctx)
+	if err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "开启事务失败")
+	}
+	defer tx.Rollback()
+
+	for _, mod := range mods {
+		if len(data[mod.ID]) == 0 {
+			if err := deleteModuleScores(ctx, tx, resumeID, mod); err != nil {
+				return errx.Warp(http.StatusInternalServerError, err, "删除旧评分失败")
+			}
+			continue
+		}
+		if err := markScorePending(ctx, tx.Client(), resumeID, mod.ID, revision); err != nil {
+			return errx.Warp(http.StatusInternalServerError, err, "更新评分状态失败")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "提交事务失败")
+	}
+	return nil
+}
+
+// finishPendingScore 把仍在等待同一版本评分的模块得分改为 state（失败只记录日志）
+func finishPendingScore(ctx context.Context, client *ent.Client, resumeID, moduleID, revision int64, state resumescore.State) {
+	err := client.ResumeScore.Update().
+		Where(
+			resumescore.ResumeID(resumeID),
+			resumescore.TargetType(0),
+			resumescore.TargetID(moduleID),
+			resumescore.StateEQ(resumescore.StatePending),
+			resumescore.Revision(revision),
+		).
+		SetState(state).
+		Exec(ctx)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("update score state failed: resume_id=%d, module_id=%d, state=%s, err=%v", resumeID, moduleID, state, err)
+	}
+}
+
+// scoreQueuedModules 处理评分队列中的模块，同时处理的模块数按 Scoring.Workers
+func scoreQueuedModules(ctx context.Context, svcCtx *svc.ServiceContext) {
+	logger := logx.WithContext(ctx)
+
+	members, err := svcCtx.Redis.ZRange(ctx, scoreQueueKey, 0, scoreBatchSize-1).Result()
+	if err != nil {
+		logger.Errorf("query score queue failed: %v", err)
+		return
+	}
+
+	runner := threading.NewTaskRunner(max(svcCtx.Config.Scoring.Workers, 1))
+	for _, member := range members {
+		// 抢占成功的实例负责评分；评分期间的新保存会重新入队
+		payload, err := scoreClaimScript.Run(ctx, svcCtx.Redis, []string{scoreQueueKey, scoreJobKey}, member).Text()
+		if err != nil {
+			if !errors.Is(err, redis.Nil) {
+				logger.Errorf("claim score job failed: member=%s, err=%v", member, err)
+			}
+			continue
+		}
+		var job scoreJob
+		if payload != "" {
+			_ = json.Unmarshal([]byte(payload), &job)
+		}
+		if job.Trigger == "" {
+			job.Trigger = scorerun.TriggerSave
+		}
+
+		resumeID, moduleID, err := parseScoreQueueMember(member)
+		if err != nil {
+			logger.Error(err)
+			continue
+		}
+		runner.Schedule(func() {
+			runScoreJob(ctx, svcCtx, resumeID, moduleID, job)
+		})
+	}
+	runner.Wait()
+}
+
+// runScoreJob 对等待评分的模块评分，结束后通知订阅方
+func runScoreJob(ctx context.Context, svcCtx *svc.ServiceContext, resumeID, moduleID int64, job scoreJob) {
+	logger := logx.WithContext(ctx)
+
+	// 已评分完成（同一模块重复入队）或已删除的模块不再评分
+	pending, err := svcCtx.Ent.ResumeScore.Query().
+		Where(
+			resumescore.ResumeID(resumeID),
+			resumescore.TargetType(0),
+			resumescore.TargetID(moduleID),
+			resumescore.StateEQ(resumescore.StatePending),
+		).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			logger.Errorf("query pending score failed: resume_id=%d, module_id=%d, err=%v", resumeID, moduleID, err)
+		}
+		return
+	}
+
+	if err := scorePendingModule(ctx, svcCtx, pending, job); err != nil {
+		logger.Errorf("score module failed: resume_id=%d, module_id=%d, err=%v", resumeID, moduleID, err)
+		finishPendingScore(ctx, svcCtx.Ent, resumeID, moduleID, pending.Revision, resumescore.StateFailed)
+	}
+	svcCtx.Redis.Publish(ctx, scoreEventPrefix+strconv.FormatInt(resumeID, 10), moduleID)
+}
+
+// scorePendingModule 按最新的模块数据评分，在事务中替换模块得分并记录评分历史
+// 评分失败时保留原得分，状态改为 failed；写入前得分已不是该版本的 pending 时丢弃结果；
+// 简历已删除或没有内容时无法评分，状态直接改为 stale，不等待超时回收
+func scorePendingModule(ctx context.Context, svcCtx *svc.ServiceContext, pending *ent.ResumeScore, job scoreJob) error {
+	resumeID, moduleID := pending.ResumeID, pending.TargetID
+
+	// 1. 读取最新的模块数据
+	if _, err := svcCtx.Ent.Resume.Get(ctx, resumeID); err != nil {
+		if ent.IsNotFound(err) {
+			finishPendingScore(ctx, svcCtx.Ent, resumeID, moduleID, pending.Revision, resumescore.StateStale)
+			return nil
+		}
+		return err
+	}
+	content, err := svcCtx.Mongo.GetResumeContent(ctx, resumeID)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		finishPendingScore(ctx, svcCtx.Ent, resumeID, moduleID, pending.Revision, resumescore.StateStale)
+		return nil
+	}
+	mod, err := loadResumeModule(ctx, svcCtx, content.Modules, moduleID)
+	if err != nil {
+		return err
+	}
+	reg, ok := registryModule(svcCtx.Modules, mod)
+	if !ok {
+		return fmt.Errorf("module not registered: module_id=%d", moduleID)
+	}
+
+	// 2. 在事务外评分
+	calculator := NewScoreCalculator(ctx, svcCtx).WithForce(job.Force)
+	data := moduleDataMap(content.Modules)[moduleID].Data
+	results := calculator.Evaluate([]ModuleScoreJob{{Module: reg, Data: convertToModuleData(string(mod.Shape), data)}})
+
+	// 3. 写入评分结果
+	tx, err := svcCtx.Ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 按版本条件更新认领该次评分：更新持有行锁，期间的保存（把 revision 改为新版本）等待本事务提交后再标记 pending，
+	// 不会在检查之后、写入之前提交新版本而被本次结果覆盖；没有更新到记录说明已有新版本，丢弃结果
+	state := resumescore.StateDone
+	if results[0].Err != nil {
+		state = resumescore.StateFailed
+	}
+	claimed, err := tx.ResumeScore.Update().
+		Where(
+			resumescore.ID(pending.ID),
+			resumescore.StateEQ(resumescore.StatePending),
+			resumescore.Revision(pending.Revision),
+		).
+		SetState(state).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if claimed == 0 {
+		return nil
+	}
+
+	if results[0].Err == nil {
+		if err := deleteModuleScores(ctx, tx, resumeID, mod); err != nil {
+			return err
+		}
+		if err := calculator.Save(tx, resumeID, results); err != nil {
+			return err
+		}
+	}
+	// 评分历史与得分在同一事务中写入，失败时一起回滚，不留下缺少明细的评分记录
+	if err := recordScoreRun(ctx, tx, svcCtx, resumeID, job.Trigger, results); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// sweepStuckScores 把超过 Scoring.PendingTimeoutSeconds 仍在等待、且不在评分队列中的模块得分标记为 stale
+// 用于回收评分期间实例退出等原因丢失的任务
+func sweepStuckScores(ctx context.Context, svcCtx *svc.ServiceContext) {
+	logger := logx.WithContext(ctx)
+	timeout := time.Duration(svcCtx.Config.Scoring.PendingTimeoutSeconds) * time.Second
+
+	rows, err := svcCtx.Ent.ResumeScore.Query().
+		Where(
+			resumescore.TargetType(0),
+			resumescore.StateEQ(resumescore.StatePending),
+			resumescore.UpdatedAtLT(time.Now().Add(-timeout)),
+		).
+		Limit(scoreBatchSize).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("query stuck scores failed: %v", err)
+		return
+	}
+	for _, row := range rows {
+		member := scoreQueueMember(row.ResumeID, row.TargetID)
+		if _, err := svcCtx.Redis.ZScore(ctx, scoreQueueKey, member).Result(); err == nil {
+			continue
+		}
+		finishPendingScore(ctx, svcCtx.Ent, row.ResumeID, row.TargetID, row.Revision, resumescore.StateStale)
+		svcCtx.Redis.Publish(ctx, scoreEventPrefix+strconv.FormatInt(row.ResumeID, 10), row.TargetID)
+		logger.Infof("score marked stale: resume_id=%d, module_id=%d", row.ResumeID, row.TargetID)
+	}
+}
+
+// resumeScoreStatus 查询简历各模块的评分状态和当前总分
+func resumeScoreStatus(ctx context.Context, svcCtx *svc.ServiceContext, resumeID int64) (*types.ScoreStatusResp, error) {
+	scores, err := svcCtx.Ent.ResumeScore.Query().
+		Where(resumescore.ResumeID(resumeID), resumescore.TargetType(0)).
+		Order(ent.Asc(resumescore.FieldTargetID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.ScoreStatusResp{
+		ResumeID:   resumeID,
+		Scoring:    scoringDone,
+		TotalScore: weightedTotal(scores),
+		Modules:    make([]types.ModuleScoreState, 0, len(scores)),
+	}
+	for _, s := range scores {
+		if s.State == resumescore.StatePending {
+			resp.Scoring = scoringPending
+		}
+		resp.Modules = append(resp.Modules, types.ModuleScoreState{
+			ModuleID:  s.TargetID,
+			State:     string(s.State),
+			Scored:    s.Scored,
+			Score:     s.Score,
+			UpdatedAt: s.UpdatedAt.Format(timeLayout),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// scoreSubscribeTimeout 订阅评分状态的最长时间，超时后客户端可重新订阅或改为轮询
+const scoreSubscribeTimeout = 5 * time.Minute
+
+type SubscribeScoreStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 订阅评分状态（SSE，评分状态变化时推送，没有进行中的评分后结束）
+func NewSubscribeScoreStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubscribeScoreStatusLogic {
+	return &SubscribeScoreStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// SubscribeScoreStatus 先推送当前评分状态，之后每完成一个模块的评分推送一次，没有进行中的评分时结束
+func (l *SubscribeScoreStatusLogic) SubscribeScoreStatus(req *types.ScoreStatusReq, client chan<- *types.ScoreStatusResp) error {
+	if _, err := loadOwnedResume(l.ctx, l.svcCtx, req.ResumeID); err != nil {
+		return err
+	}
+
+	// 先订阅再查询状态，避免错过查询后到订阅前完成的评分
+	sub := l.svcCtx.Redis.Subscribe(l.ctx, scoreEventPrefix+strconv.FormatInt(req.ResumeID, 10))
+	defer sub.Close()
+	if _, err := sub.Receive(l.ctx); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "订阅评分状态失败")
+	}
+	events := sub.Channel()
+
+	timeout := time.NewTimer(scoreSubscribeTimeout)
+	defer timeout.Stop()
+	for {
+		status, err := resumeScoreStatus(l.ctx, l.svcCtx, req.ResumeID)
+		if err != nil {
+			return errx.Warp(http.StatusInternalServerError, err, "查询评分状态失败")
+		}
+		select {
+		case client <- status:
+		case <-l.ctx.Done():
+			return nil
+		}
+		if status.Scoring != scoringPending {
+			return nil
+		}
+
+		select {
+		case <-events:
+		case <-timeout.C:
+			return nil
+		case <-l.ctx.Done():
+			return nil
+		}
+	}
+}
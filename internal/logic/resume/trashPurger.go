@@ -0,0 +1,137 @@
+package resume
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/infra/ent/resumescore"
+	"cv2/internal/infra/ent/resumeshare"
+	"cv2/internal/infra/ent/resumeshareview"
+	"cv2/internal/infra/ent/schema"
+	"cv2/internal/infra/ent/scorehistory"
+	"cv2/internal/infra/ent/scorerun"
+	"cv2/internal/infra/minio"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+const (
+	trashPurgeLockKey   = "resume_trash:purge_lock"
+	trashPurgeInterval  = time.Hour
+	trashPurgeBatchSize = 100
+)
+
+// StartTrashPurger 启动回收站清理任务
+// 定期彻底删除超过保留期的简历，多实例部署时通过 Redis 锁保证同一时间只有一个实例执行
+func StartTrashPurger(svcCtx *svc.ServiceContext) {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(trashPurgeInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			purgeExpiredTrash(context.Background(), svcCtx)
+		}
+	})
+}
+
+// trashPurgeAt 计算回收站中简历的彻底删除时间
+func trashPurgeAt(svcCtx *svc.ServiceContext, deletedAt time.Time) time.Time {
+	return deletedAt.AddDate(0, 0, svcCtx.Config.Trash.RetentionDays)
+}
+
+// purgeExpiredTrash 彻底删除超过保留期的简历
+func purgeExpiredTrash(ctx context.Context, svcCtx *svc.ServiceContext) {
+	logger := logx.WithContext(ctx)
+
+	ok, err := svcCtx.Redis.SetNX(ctx, trashPurgeLockKey, 1, trashPurgeInterval-time.Minute).Result()
+	if err != nil {
+		logger.Errorf("acquire trash purge lock failed: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	deadline := time.Now().AddDate(0, 0, -svcCtx.Config.Trash.RetentionDays)
+	rows, err := svcCtx.Ent.Resume.Query().
+		Where(resume.DeletedAtLT(deadline)).
+		Limit(trashPurgeBatchSize).
+		All(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		logger.Errorf("query expired trash failed: %v", err)
+		return
+	}
+
+	for _, r := range rows {
+		if err := purgeResume(ctx, svcCtx, r); err != nil {
+			// 失败的简历保留在回收站，下一轮重试
+			logger.Errorf("purge resume failed: resume_id=%d, err=%v", r.ID, err)
+		}
+	}
+	if len(rows) > 0 {
+		logger.Infof("trash purged: count=%d", len(rows))
+	}
+}
+
+// purgeResume 彻底删除简历
+// 先清理 MongoDB 内容和 MinIO 文件，最后硬删除 MySQL 记录，外部存储清理失败时可重试
+func purgeResume(ctx context.Context, svcCtx *svc.ServiceContext, r *ent.Resume) error {
+	// 1. 删除 MongoDB 简历内容及版本历史
+	if err := svcCtx.Mongo.DeleteResumeContent(ctx, r.ID); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "删除简历内容失败")
+	}
+	if err := svcCtx.Mongo.DeleteResumeHistory(ctx, r.ID); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "删除简历版本失败")
+	}
+
+	// 2. 删除 MinIO 中的简历文件（当前文件、原始文件，以及各版本的渲染和导出文件）
+	for _, path := range []string{r.FilePath, r.SourceFilePath} {
+		if key, ok := svcCtx.MinIO.ObjectKeyFromURL(path); ok {
+			if err := svcCtx.MinIO.RemoveObject(ctx, key); err != nil {
+				return errx.Warp(http.StatusInternalServerError, err, "删除简历文件失败")
+			}
+		}
+	}
+	if err := svcCtx.MinIO.RemovePrefix(ctx, minio.RenderPrefix(r.ID)); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "删除简历导出文件失败")
+	}
+
+	// 3. 硬删除评分、评分历史、分享链接和简历记录
+	ctx = schema.SkipSoftDelete(ctx)
+	tx, err := svcCtx.Ent.Tx(ctx)
+	if err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "开启事务失败")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ResumeScore.Delete().Where(resumescore.ResumeID(r.ID)).Exec(ctx); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "删除简历评分失败")
+	}
+	if _, err := tx.ScoreHistory.Delete().Where(scorehistory.ResumeID(r.ID)).Exec(ctx); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "删除评分历史失败")
+	}
+	if _, err := tx.ScoreRun.Delete().Where(scorerun.ResumeID(r.ID)).Exec(ctx); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "删除评分历史失败")
+	}
+	if _, err := tx.ResumeShareView.Delete().Where(resumeshareview.ResumeID(r.ID)).Exec(ctx); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "删除分享访问记录失败")
+	}
+	if _, err := tx.ResumeShare.Delete().Where(resumeshare.ResumeID(r.ID)).Exec(ctx); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "删除分享链接失败")
+	}
+	if err := tx.Resume.DeleteOneID(r.ID).Exec(ctx); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "删除简历记录失败")
+	}
+	if err := tx.Commit(); err != nil {
+		return errx.Warp(http.StatusInternalServerError, err, "提交事务失败")
+	}
+
+	logx.WithContext(ctx).Infof("resume purged: resume_id=%d", r.ID)
+	return nil
+}
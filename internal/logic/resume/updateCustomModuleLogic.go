@@ -0,0 +1,90 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/ent/scorerun"
+	"cv2/internal/infra/mongo/model"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateCustomModuleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改自定义模块标题
+func NewUpdateCustomModuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateCustomModuleLogic {
+	return &UpdateCustomModuleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateCustomModuleLogic) UpdateCustomModule(req *types.UpdateCustomModuleReq) (resp *types.CustomModuleResp, err error) {
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	title, err := normalizeCustomTitle(req.Title)
+	if err != nil {
+		return nil, err
+	}
+	_, content, err := loadOwnedContent(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, errx.New(http.StatusNotFound, "简历内容不存在")
+	}
+	if content.Revision != req.Revision {
+		return nil, errx.New(http.StatusConflict, "简历已被修改，请刷新后重试")
+	}
+
+	custom, err := findCustomModule(content, req.ModuleID)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := customTemplate(l.ctx, l.svcCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureHistoryBaseline(l.ctx, l.svcCtx, req.ResumeID, owner.UserID); err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "记录简历版本失败")
+	}
+
+	// 1. 修改标题，数据不变
+	revision, err := l.svcCtx.Mongo.UpdateModule(l.ctx, req.ResumeID, req.ModuleID, title, custom.Data, req.Revision)
+	if err != nil {
+		return nil, contentWriteError(err, "修改自定义模块失败")
+	}
+
+	// 2. 标题是评分时的模块名，重新计算该模块的评分（后台评分）
+	mod := customModule(tmpl, req.ModuleID, title)
+	modules := []model.ModuleData{{ModuleID: req.ModuleID, Title: title, Custom: true}}
+	queueModuleScores(l.ctx, l.svcCtx, req.ResumeID, revision, modules, map[int64][]map[string]interface{}{req.ModuleID: custom.Data}, scorerun.TriggerSave)
+
+	// 3. 重新渲染并写入版本快照（失败不影响操作结果）
+	afterContentChange(l.ctx, l.svcCtx, &model.ResumeContentHistory{
+		MySQLID:  req.ResumeID,
+		Action:   model.HistoryActionSave,
+		ModuleID: req.ModuleID,
+		AuthorID: owner.UserID,
+	})
+
+	return &types.CustomModuleResp{
+		Module:   buildModuleInfo(l.ctx, l.svcCtx, req.ResumeID, mod, custom.Data),
+		Revision: revision,
+	}, nil
+}
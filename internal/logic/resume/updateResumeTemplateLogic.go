@@ -0,0 +1,64 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+	"net/http"
+
+	"cv2/internal/infra/ent/resume"
+	"cv2/internal/pkg/errx"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateResumeTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 设置简历 PDF 模板
+func NewUpdateResumeTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateResumeTemplateLogic {
+	return &UpdateResumeTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateResumeTemplateLogic) UpdateResumeTemplate(req *types.UpdateResumeTemplateReq) (resp *types.UpdateResumeTemplateResp, err error) {
+	template := resume.Template(req.Template)
+	if err := resume.TemplateValidator(template); err != nil {
+		return nil, errx.Newf(http.StatusBadRequest, "不支持的模板: %s", req.Template)
+	}
+
+	r, err := loadOwnedResume(l.ctx, l.svcCtx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+	if r.Template == template {
+		return &types.UpdateResumeTemplateResp{
+			Template:     string(r.Template),
+			RenderStatus: string(r.RenderStatus),
+		}, nil
+	}
+
+	err = l.svcCtx.Ent.Resume.UpdateOneID(req.ResumeID).
+		SetTemplate(template).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, errx.Warp(http.StatusInternalServerError, err, "设置简历模板失败")
+	}
+
+	// 按新模板重新渲染 PDF
+	scheduleRender(l.ctx, l.svcCtx, req.ResumeID)
+
+	return &types.UpdateResumeTemplateResp{
+		Template:     string(template),
+		RenderStatus: string(resume.RenderStatusPending),
+	}, nil
+}
@@ -40,9 +40,10 @@ func NewUploadResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Uplo
 }
 
 func (l *UploadResumeLogic) UploadResume(fileHeader *multipart.FileHeader) (resp *types.UploadResumeResp, err error) {
-	// TODO: 从 context 获取 userID 和 tenantID
-	userID := int64(1)
-	tenantID := int64(1)
+	owner, err := currentOwner(l.ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// 1. 校验文件大小
 	if fileHeader.Size > maxFileSize {
@@ -67,8 +68,8 @@ func (l *UploadResumeLogic) UploadResume(fileHeader *multipart.FileHeader) (resp
 	err = l.svcCtx.Redis.HSet(l.ctx, key, map[string]any{
 		"status":    statusPending,
 		"resume_id": resumeID,
-		"user_id":   userID,
-		"tenant_id": tenantID,
+		"user_id":   owner.UserID,
+		"tenant_id": owner.TenantID,
 	}).Err()
 	if err != nil {
 		l.Errorf("redis hset failed: %v", err)
@@ -79,7 +80,7 @@ func (l *UploadResumeLogic) UploadResume(fileHeader *multipart.FileHeader) (resp
 	l.svcCtx.Redis.Expire(l.ctx, key, 12*time.Hour)
 
 	// 6. 启动后台处理
-	go l.processUpload(context.Background(), taskID, resumeID, userID, tenantID, fileHeader)
+	go l.processUpload(context.Background(), taskID, resumeID, owner, fileHeader)
 
 	l.Infof("upload task created: task_id=%s, resume_id=%d", taskID, resumeID)
 
@@ -92,7 +93,8 @@ func (l *UploadResumeLogic) UploadResume(fileHeader *multipart.FileHeader) (resp
 func (l *UploadResumeLogic) processUpload(
 	ctx context.Context,
 	taskID string,
-	resumeID, userID, tenantID int64,
+	resumeID int64,
+	owner *resumeOwner,
 	fileHeader *multipart.FileHeader,
 ) {
 	// 1. 文件转 Markdown
@@ -116,9 +118,9 @@ func (l *UploadResumeLogic) processUpload(
 	l.Infof("markdown to struct success: resume_id=%d", resumeID)
 
 	// 3. 保存上传的简历
-	if err := saveAndProcessResume(ctx, l.svcCtx, resumeID, userID, tenantID, fileHeader, data); err != nil {
+	if err := saveAndProcessResume(ctx, l.svcCtx, resumeID, owner, fileHeader, data); err != nil {
 		l.Errorf("save upload resume failed: %v", err)
-		updateRedisStatus(ctx, l.svcCtx, resumeTaskKeyPrefix, taskID, statusFailure, 0)
+		updateRedisFailure(ctx, l.svcCtx, resumeTaskKeyPrefix, taskID, err)
 		return
 	}
 
@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package resume
+
+import (
+	"context"
+
+	"cv2/internal/infra/ent/resumeshareview"
+	"cv2/internal/infra/redact"
+	"cv2/internal/svc"
+	"cv2/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ViewSharedResumeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查看分享的简历（返回 text/html）
+func NewViewSharedResumeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ViewSharedResumeLogic {
+	return &ViewSharedResumeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ViewSharedResume 按简历当前模板渲染最新内容
+// 页面不缓存，保证撤销、过期后立即失效，访问次数准确
+func (l *ViewSharedResumeLogic) ViewSharedResume(req *types.ShareAccessReq) (*PreviewPage, error) {
+	s, r, content, err := accessShare(l.ctx, l.svcCtx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	data := buildResumeData(l.svcCtx.Modules, content.Modules, 0)
+	if s.Redact {
+		data = redact.Apply(data, l.svcCtx.Redaction)
+	}
+	html, err := renderPreview(l.svcCtx, data, string(r.Template), false)
+	if err != nil {
+		return nil, err
+	}
+	recordShareView(l.ctx, l.svcCtx, s, resumeshareview.ActionView, req)
+
+	return &PreviewPage{
+		HTML:                  html,
+		ContentSecurityPolicy: previewCSP(l.svcCtx),
+	}, nil
+}
@@ -0,0 +1,117 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// 最小化的 Word (.docx, Office Open XML) 生成
+// 只支持标题、普通段落和项目符号段落，满足简历导出；样式直接写在段落属性中，不依赖 styles.xml
+
+// ContentType .docx 文件的 MIME 类型
+const ContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+
+const (
+	contentTypesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>
+</Types>`
+
+	relsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>
+</Relationships>`
+
+	documentHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>`
+
+	// A4 纸张，页边距 2cm（单位 twip）
+	documentFooter = `<w:sectPr><w:pgSz w:w="11906" w:h="16838"/><w:pgMar w:top="1134" w:right="1134" w:bottom="1134" w:left="1134" w:header="567" w:footer="567" w:gutter="0"/></w:sectPr></w:body></w:document>`
+)
+
+// headingSizes 各级标题字号（半磅），超出的级别按最后一级处理
+var headingSizes = []int{36, 30, 26, 24}
+
+// Document docx 文档
+type Document struct {
+	body strings.Builder
+}
+
+// New 创建空文档
+func New() *Document {
+	return &Document{}
+}
+
+// Heading 添加标题，level 从 1 开始
+func (d *Document) Heading(level int, text string) {
+	if level < 1 {
+		level = 1
+	}
+	size := headingSizes[len(headingSizes)-1]
+	if level <= len(headingSizes) {
+		size = headingSizes[level-1]
+	}
+	d.paragraph(`<w:pPr><w:spacing w:before="240" w:after="120"/></w:pPr>`,
+		fmt.Sprintf(`<w:b/><w:sz w:val="%d"/>`, size), text)
+}
+
+// Paragraph 添加普通段落
+func (d *Document) Paragraph(text string) {
+	d.paragraph(`<w:pPr><w:spacing w:after="80"/></w:pPr>`, "", text)
+}
+
+// Bullet 添加项目符号段落
+func (d *Document) Bullet(text string) {
+	d.paragraph(`<w:pPr><w:spacing w:after="60"/><w:ind w:left="420" w:hanging="280"/></w:pPr>`, "", "• "+text)
+}
+
+// paragraph 写入一个段落，文本中的换行转为 Word 的换行
+func (d *Document) paragraph(pPr, rPr, text string) {
+	d.body.WriteString("<w:p>")
+	d.body.WriteString(pPr)
+	d.body.WriteString("<w:r>")
+	if rPr != "" {
+		d.body.WriteString("<w:rPr>" + rPr + "</w:rPr>")
+	}
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			d.body.WriteString("<w:br/>")
+		}
+		d.body.WriteString(`<w:t xml:space="preserve">`)
+		xml.EscapeText(&d.body, []byte(line))
+		d.body.WriteString("</w:t>")
+	}
+	d.body.WriteString("</w:r></w:p>")
+}
+
+// Bytes 生成 .docx 文件内容
+func (d *Document) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", relsXML},
+		{"word/document.xml", documentHeader + d.body.String() + documentFooter},
+	}
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return nil, fmt.Errorf("create %s: %w", f.name, err)
+		}
+		if _, err := w.Write([]byte(f.content)); err != nil {
+			return nil, fmt.Errorf("write %s: %w", f.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
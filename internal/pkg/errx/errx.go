@@ -6,6 +6,7 @@ type Error struct {
 	code  int
 	msg   string
 	cause error
+	data  any
 }
 
 func (e *Error) Error() string {
@@ -32,6 +33,14 @@ func (e *Error) Code() int {
 	return e.code
 }
 
+// Data 返回随错误一起返回给客户端的结构化数据（如字段校验错误）
+func (e *Error) Data() any {
+	if e == nil {
+		return nil
+	}
+	return e.data
+}
+
 func New(code int, msg string) error {
 	return &Error{code: code, msg: msg}
 }
@@ -53,3 +62,8 @@ func Warpf(code int, err error, format string, args ...any) error {
 	}
 	return &Error{code: code, msg: fmt.Sprintf(format, args...), cause: err}
 }
+
+// WithData 创建携带结构化数据的错误，data 会放在响应的 data 字段中
+func WithData(code int, msg string, data any) error {
+	return &Error{code: code, msg: msg, data: data}
+}
@@ -0,0 +1,265 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// JSON Schema 子集校验
+// 支持: type, properties, required, additionalProperties(bool), items,
+// minItems/maxItems, minLength/maxLength, minimum/maximum, enum, pattern, format
+//
+// format 支持:
+//   - date: 2006-01-02
+//   - date-time: RFC3339
+//   - email
+//   - resume-date: 简历常见的年月写法，如 2020、2020-09、2020.09、2020/09/01、2020年9月，以及“至今”
+//
+// 空字符串不做 format 和 pattern 校验（视为未填写），是否必填由 required / minLength 控制
+
+// Schema 编译后的 schema
+type Schema struct {
+	Type                 string             `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Format               string             `json:"format,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// FieldError 单个字段的校验错误
+type FieldError struct {
+	Pointer string `json:"pointer"` // JSON Pointer (RFC 6901)，如 /data/0/company，整个文档为空字符串
+	Message string `json:"message"` // 错误描述
+}
+
+// Error 多个字段校验错误
+type Error []FieldError
+
+func (e Error) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, fe := range e {
+		parts = append(parts, fe.Pointer+": "+fe.Message)
+	}
+	return strings.Join(parts, "; ")
+}
+
+var resumeDatePattern = regexp.MustCompile(`^\d{4}([-./]\d{1,2}([-./]\d{1,2})?|年(\d{1,2}月(\d{1,2}日)?)?)?$`)
+
+// Compile 从 JSON 对象（如数据库中的 JSON 字段）编译 schema
+func Compile(raw map[string]interface{}) (*Schema, error) {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var s Schema
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+	if err := s.compile(""); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (s *Schema) compile(pointer string) error {
+	switch s.Type {
+	case "", "object", "array", "string", "number", "integer", "boolean", "null":
+	default:
+		return fmt.Errorf("%s: unsupported type %q", fragment(pointer), s.Type)
+	}
+	switch s.Format {
+	case "", "date", "date-time", "email", "resume-date":
+	default:
+		return fmt.Errorf("%s: unsupported format %q", fragment(pointer), s.Format)
+	}
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("%s: invalid pattern: %w", fragment(pointer), err)
+		}
+		s.pattern = re
+	}
+	for name, p := range s.Properties {
+		if err := p.compile(pointer + "/properties/" + escape(name)); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		if err := s.Items.compile(pointer + "/items"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate 校验任意 Go 值，返回按 JSON Pointer 排序的错误列表，通过时返回 nil
+// pointer 为错误路径的前缀，如 "/data"
+func (s *Schema) Validate(value interface{}, pointer string) Error {
+	// 统一转换为 encoding/json 的通用类型，兼容 []map[string]interface{} 等具体类型
+	b, err := json.Marshal(value)
+	if err != nil {
+		return Error{{Pointer: pointer, Message: "数据无法序列化"}}
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return Error{{Pointer: pointer, Message: "数据无法序列化"}}
+	}
+
+	var errs Error
+	s.validate(v, pointer, &errs)
+	if len(errs) == 0 {
+		return nil
+	}
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].Pointer < errs[j].Pointer })
+	return errs
+}
+
+func (s *Schema) validate(v interface{}, pointer string, errs *Error) {
+	add := func(p, format string, args ...interface{}) {
+		*errs = append(*errs, FieldError{Pointer: p, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if s.Type != "" && !matchType(s.Type, v) {
+		add(pointer, "类型错误，应为 %s", s.Type)
+		return
+	}
+
+	if len(s.Enum) > 0 && !inEnum(s.Enum, v) {
+		add(pointer, "取值不在允许范围内")
+	}
+
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := val[name]; !ok {
+				add(pointer+"/"+escape(name), "缺少必填字段")
+			}
+		}
+		for name, fv := range val {
+			p := pointer + "/" + escape(name)
+			if ps, ok := s.Properties[name]; ok {
+				ps.validate(fv, p, errs)
+			} else if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				add(p, "不允许的字段")
+			}
+		}
+	case []interface{}:
+		if s.MinItems != nil && len(val) < *s.MinItems {
+			add(pointer, "至少 %d 项", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(val) > *s.MaxItems {
+			add(pointer, "最多 %d 项", *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range val {
+				s.Items.validate(item, pointer+"/"+strconv.Itoa(i), errs)
+			}
+		}
+	case string:
+		n := utf8.RuneCountInString(val)
+		if s.MinLength != nil && n < *s.MinLength {
+			add(pointer, "长度不能少于 %d", *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			add(pointer, "长度不能超过 %d", *s.MaxLength)
+		}
+		if val == "" {
+			return
+		}
+		if s.pattern != nil && !s.pattern.MatchString(val) {
+			add(pointer, "格式不正确")
+		}
+		if s.Format != "" && !matchFormat(s.Format, val) {
+			add(pointer, "格式错误，应为 %s", s.Format)
+		}
+	case float64:
+		if s.Minimum != nil && val < *s.Minimum {
+			add(pointer, "不能小于 %v", *s.Minimum)
+		}
+		if s.Maximum != nil && val > *s.Maximum {
+			add(pointer, "不能大于 %v", *s.Maximum)
+		}
+	}
+}
+
+func matchType(t string, v interface{}) bool {
+	switch t {
+	case "object":
+		_, ok := v.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := v.([]interface{})
+		return ok
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "number":
+		_, ok := v.(float64)
+		return ok
+	case "integer":
+		f, ok := v.(float64)
+		return ok && f == math.Trunc(f)
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "null":
+		return v == nil
+	}
+	return true
+}
+
+func matchFormat(format, v string) bool {
+	switch format {
+	case "date":
+		_, err := time.Parse("2006-01-02", v)
+		return err == nil
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, v)
+		return err == nil
+	case "email":
+		addr, err := mail.ParseAddress(v)
+		return err == nil && addr.Address == v
+	case "resume-date":
+		return v == "至今" || strings.EqualFold(v, "present") || resumeDatePattern.MatchString(v)
+	}
+	return true
+}
+
+func inEnum(enum []interface{}, v interface{}) bool {
+	for _, e := range enum {
+		if reflect.DeepEqual(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// escape 按 RFC 6901 转义 JSON Pointer 中的单个片段
+func escape(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// fragment 返回 JSON Pointer 的 URI 片段表示（RFC 6901 第 6 节），根为 #，用于错误信息
+func fragment(pointer string) string {
+	return "#" + pointer
+}
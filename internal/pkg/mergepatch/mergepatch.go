@@ -0,0 +1,24 @@
+package mergepatch
+
+// Apply 按 RFC 7386 (JSON Merge Patch) 将 patch 合并到 target，返回合并后的新对象
+// patch 中值为 null 的字段会被删除，对象类型的值递归合并，其它类型直接覆盖；target 不会被修改
+func Apply(target, patch map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(target)+len(patch))
+	for k, v := range target {
+		result[k] = v
+	}
+
+	for k, v := range patch {
+		if v == nil {
+			delete(result, k)
+			continue
+		}
+		if sub, ok := v.(map[string]interface{}); ok {
+			orig, _ := result[k].(map[string]interface{})
+			result[k] = Apply(orig, sub)
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
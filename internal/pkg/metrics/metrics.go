@@ -108,6 +108,17 @@ var (
 		[]string{"article_id"},
 	)
 
+	// 业务指标：简历评分缓存
+	ScoreCacheTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "cv2",
+			Subsystem: "business",
+			Name:      "score_cache_total",
+			Help:      "Total number of resume score cache lookups",
+		},
+		[]string{"result"}, // hit, miss, bypass
+	)
+
 	// MongoDB 操作耗时
 	MongoDuration = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -37,7 +37,7 @@ func Error(ctx context.Context, err error) (int, any) {
 		return code, &Response{
 			Code:    code,
 			Message: err.Error(),
-			Data:    nil,
+			Data:    e.Data(),
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"cv2/internal/config"
 	"cv2/internal/infra/ent"
+	_ "cv2/internal/infra/ent/runtime"
 	"cv2/internal/pkg/metrics"
 
 	_ "github.com/go-sql-driver/mysql"
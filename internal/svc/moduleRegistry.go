@@ -0,0 +1,11 @@
+package svc
+
+import (
+	"context"
+	"cv2/internal/infra/ent"
+	"cv2/internal/infra/moduleregistry"
+)
+
+func newModuleRegistry(client *ent.Client) (*moduleregistry.Registry, error) {
+	return moduleregistry.Load(context.Background(), client)
+}
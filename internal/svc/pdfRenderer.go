@@ -0,0 +1,21 @@
+package svc
+
+import (
+	"fmt"
+
+	"cv2/internal/config"
+	"cv2/internal/infra/pdf"
+)
+
+// newPDFRenderer 创建本地 PDF 渲染器，配置为 remote 时返回 nil（使用算法服务生成 PDF）
+// 配置为 local 但没有可用字体时返回错误，避免悄悄退回算法服务
+func newPDFRenderer(c config.Config) (*pdf.Renderer, error) {
+	if c.Render.PDF != "local" {
+		return nil, nil
+	}
+	fonts, err := pdf.LoadFonts(c.Render.FontPath, c.Render.BoldFontPath)
+	if err != nil {
+		return nil, fmt.Errorf("local pdf renderer requires a cjk font (see internal/infra/pdf/fonts/README.md): %w", err)
+	}
+	return pdf.NewRenderer(fonts), nil
+}
@@ -0,0 +1,22 @@
+package svc
+
+import (
+	"cv2/internal/config"
+	"cv2/internal/infra/redact"
+)
+
+// newRedactionPolicy 根据配置创建脱敏策略
+func newRedactionPolicy(c config.Config) redact.Policy {
+	r := c.Redaction
+	return redact.Policy{
+		Name:      redact.Mode(r.Name),
+		Phone:     redact.Mode(r.Phone),
+		Email:     redact.Mode(r.Email),
+		Birthday:  redact.Mode(r.Birthday),
+		Ethnicity: redact.Mode(r.Ethnicity),
+		Politics:  redact.Mode(r.Politics),
+		School:    redact.Mode(r.School),
+		Photo:     redact.Mode(r.Photo),
+		FreeText:  r.FreeText,
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"cv2/internal/config"
 	"cv2/internal/infra/algorithm"
 	"cv2/internal/infra/ent"
+	"cv2/internal/infra/markdown"
 	"cv2/internal/infra/minio"
+	"cv2/internal/infra/moduleregistry"
 	"cv2/internal/infra/mongo"
 	"cv2/internal/infra/payclient"
+	"cv2/internal/infra/pdf"
+	"cv2/internal/infra/redact"
 	"cv2/internal/infra/shiji"
 	"cv2/internal/middleware"
 
@@ -18,10 +22,14 @@ type ServiceContext struct {
 	Config    config.Config
 	Auth      rest.Middleware
 	Ent       *ent.Client
+	Modules   *moduleregistry.Registry
 	Mongo     *mongo.Client
 	MinIO     *minio.Client
 	Redis     *redis.Client
 	Algorithm *algorithm.Client
+	Markdown  *markdown.Renderer
+	PDF       *pdf.Renderer // 本地 PDF 渲染器，为 nil 时由算法服务生成 PDF
+	Redaction redact.Policy // 脱敏导出、脱敏分享使用的策略
 	Shiji     *shiji.Client
 	PayClient payclient.Client
 }
@@ -32,6 +40,11 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		panic(err)
 	}
 
+	moduleRegistry, err := newModuleRegistry(client)
+	if err != nil {
+		panic(err)
+	}
+
 	mongoClient, err := newMongo(c)
 	if err != nil {
 		panic(err)
@@ -42,6 +55,11 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		panic(err)
 	}
 
+	pdfRenderer, err := newPDFRenderer(c)
+	if err != nil {
+		panic(err)
+	}
+
 	redisClient := newRedis(c)
 	algClient := algorithm.NewClient(c.Algorithm.GenerateURL, c.Algorithm.DataURL, c.Algorithm.ScoreURL)
 	shijiClient := shiji.NewClient(c.Shiji.BaseURL)
@@ -60,10 +78,14 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		Config:    c,
 		Auth:      authMiddleware.Handle,
 		Ent:       client,
+		Modules:   moduleRegistry,
 		Mongo:     mongoClient,
 		MinIO:     minioClient,
 		Redis:     redisClient,
 		Algorithm: algClient,
+		Markdown:  markdown.NewRenderer(algClient, c.Render.Markdown),
+		PDF:       pdfRenderer,
+		Redaction: newRedactionPolicy(c),
 		Shiji:     shijiClient,
 		PayClient: payClient,
 	}
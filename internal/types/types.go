@@ -9,6 +9,14 @@ type AIWriteReq struct {
 	Info     string `json:"info,optional"`    // 额外提示信息
 }
 
+type AppendModuleItemReq struct {
+	ResumeID int64                  `path:"resume_id"`           // 简历ID
+	ModuleID int64                  `path:"module_id"`           // 模块ID
+	Item     map[string]interface{} `json:"item"`                // 条目数据
+	Position int                    `json:"position,default=-1"` // 插入位置（从 0 开始），-1 或超出范围时追加到末尾
+	Revision int64                  `json:"revision"`            // 读取时的内容版本号
+}
+
 type Article struct {
 	ArticleId    int64  `json:"articleId,string"`
 	Title        string `json:"title"`
@@ -27,6 +35,20 @@ type CityItem struct {
 	Title string `json:"title"`
 }
 
+type CreateCustomModuleReq struct {
+	ResumeID int64  `path:"resume_id"` // 简历ID
+	Title    string `json:"title"`     // 模块标题，如 获奖经历、语言能力
+	Revision int64  `json:"revision"`  // 读取时的内容版本号
+}
+
+type CreateResumeShareReq struct {
+	ResumeID   int64  `path:"resume_id"`                                     // 简历ID
+	Permission string `json:"permission,default=read,options=read|download"` // 权限: read=只能在线查看, download=可下载 PDF
+	ExpiresIn  int64  `json:"expires_in,default=604800,range=[300:7776000]"` // 有效期（秒），默认 7 天，最长 90 天
+	Password   string `json:"password,optional"`                             // 访问密码（4-64 位），为空表示不需要密码
+	Redact     bool   `json:"redact,optional"`                               // 脱敏分享（查看和下载的内容均按配置的策略遮盖个人信息）
+}
+
 type CreateSlotOrderReq struct {
 	Quantity int32 `json:"quantity"` // 购买数量
 }
@@ -38,15 +60,82 @@ type CreateSlotOrderResp struct {
 	ExpireTime  string `json:"expire_time"`  // 过期时间
 }
 
+type CustomModuleResp struct {
+	Module   ModuleInfo `json:"module"`   // 操作后的模块（含数据和得分）
+	Revision int64      `json:"revision"` // 操作后的内容版本号
+}
+
+type DeleteCustomModuleReq struct {
+	ResumeID int64 `path:"resume_id"` // 简历ID
+	ModuleID int64 `path:"module_id"` // 自定义模块ID
+	Revision int64 `form:"revision"`  // 读取时的内容版本号
+}
+
+type DeleteCustomModuleResp struct {
+	ModuleID int64 `json:"module_id,string"` // 删除的模块ID
+	Revision int64 `json:"revision"`         // 操作后的内容版本号
+}
+
+type DeleteModuleItemReq struct {
+	ResumeID int64  `path:"resume_id"` // 简历ID
+	ModuleID int64  `path:"module_id"` // 模块ID
+	ItemID   string `path:"item_id"`   // 条目ID
+	Revision int64  `form:"revision"`  // 读取时的内容版本号
+}
+
+type DeleteResumeReq struct {
+	ResumeID int64 `path:"resume_id"` // 简历ID
+}
+
+type DeleteResumeResp struct {
+	ResumeID  int64  `json:"resume_id,string"` // 简历ID
+	DeletedAt string `json:"deleted_at"`       // 删除时间
+	PurgeAt   string `json:"purge_at"`         // 预计彻底删除时间
+}
+
 type DictionaryItem struct {
 	ID    int64  `json:"id"`
 	Title string `json:"title"`
 }
 
+type DiffResumeVersionsReq struct {
+	ResumeID int64  `path:"resume_id"`          // 简历ID
+	From     string `form:"from"`               // 起始版本ID
+	To       string `form:"to,optional"`        // 目标版本ID，为空表示当前内容
+	ModuleID int64  `form:"module_id,optional"` // 只对比指定模块
+}
+
+type DiffResumeVersionsResp struct {
+	From    string               `json:"from"`    // 起始版本ID
+	To      string               `json:"to"`      // 目标版本ID，为空表示当前内容
+	Changes []VersionFieldChange `json:"changes"` // 变更列表
+}
+
 type DimensionInfo struct {
 	DimensionID int64   `json:"dimension_id,string"` // 维度ID
 	Title       string  `json:"title"`               // 维度标题
-	Score       float64 `json:"score"`               // 得分
+	Score       float64 `json:"score"`               // 得分（百分制）
+	Weight      float64 `json:"weight"`              // 在模块得分中的权重占比（0-1），未评分的维度为 0
+}
+
+type ExportResumeReq struct {
+	ResumeID int64  `path:"resume_id"`                                                                             // 简历ID
+	Format   string `form:"format,default=pdf,options=pdf|md|docx|json|txt|jsonresume|europass-xml|europass-json"` // 导出格式（jsonresume 为 JSON Resume 格式，europass-xml、europass-json 为 Europass 格式）
+	Redact   bool   `form:"redact,optional"`                                                                       // 脱敏导出（按配置的策略遮盖姓名、联系方式、学校等个人信息）
+}
+
+type ExportResumeResp struct {
+	DownloadURL string `json:"download_url"` // 预签名下载 URL（短期有效）
+	FileName    string `json:"file_name"`    // 下载文件名
+	Format      string `json:"format"`       // 导出格式
+	Revision    int64  `json:"revision"`     // 导出的内容版本号
+	Redacted    bool   `json:"redacted"`     // 是否已脱敏
+	ExpiresIn   int64  `json:"expires_in"`   // 过期时间（秒）
+}
+
+type FieldError struct {
+	Pointer string `json:"pointer"` // JSON Pointer，如 /data/0/company
+	Message string `json:"message"` // 错误描述
 }
 
 type GenerateResumeReq struct {
@@ -90,13 +179,19 @@ type GetResumeReq struct {
 }
 
 type GetResumeResp struct {
-	ResumeID   int64        `json:"resume_id,string"` // 简历ID
-	FileName   string       `json:"file_name"`        // 文件名
-	Status     int32        `json:"status"`           // 状态
-	TotalScore float64      `json:"total_score"`      // 总分
-	Modules    []ModuleInfo `json:"modules"`          // 模块列表（含数据和得分）
-	CreatedAt  string       `json:"created_at"`       // 创建时间
-	UpdatedAt  string       `json:"updated_at"`       // 更新时间
+	ResumeID         int64        `json:"resume_id,string"`  // 简历ID
+	FileName         string       `json:"file_name"`         // 文件名
+	Status           int32        `json:"status"`            // 状态
+	TotalScore       float64      `json:"total_score"`       // 总分（百分制，各模块得分按权重的加权平均）
+	Modules          []ModuleInfo `json:"modules"`           // 模块列表（含数据和得分）
+	Revision         int64        `json:"revision"`          // 内容版本号，保存模块时需带上
+	FilePath         string       `json:"file_path"`         // 简历文件地址
+	RenderStatus     string       `json:"render_status"`     // PDF 渲染状态: pending/rendering/done/failed
+	RenderedRevision int64        `json:"rendered_revision"` // 简历文件对应的内容版本号，小于 revision 时文件尚未更新
+	Template         string       `json:"template"`          // PDF 模板: classic/modern/compact
+	Scoring          string       `json:"scoring"`           // 评分状态: pending=有模块正在后台评分（可订阅评分状态等待结果）, done=没有进行中的评分
+	CreatedAt        string       `json:"created_at"`        // 创建时间
+	UpdatedAt        string       `json:"updated_at"`        // 更新时间
 }
 
 type GetSlotOrderReq struct {
@@ -112,6 +207,18 @@ type GetSlotOrderResp struct {
 	CreatedAt   string `json:"created_at"`       // 创建时间
 }
 
+type ImportMapping struct {
+	Source string `json:"source"`           // 来源字段路径，如 work[0].name
+	Target string `json:"target,omitempty"` // 目标字段路径，如 工作经历[0].公司名称，放入自定义模块时为模块名称
+	Kind   string `json:"kind"`             // mapped: 映射到内置模块 / custom: 放入自定义模块 / ignored: 格式元信息，不保存
+}
+
+type ImportResumeResp struct {
+	ResumeID int64           `json:"resume_id,string"` // 新建的简历ID
+	Format   string          `json:"format"`           // 导入格式
+	Mappings []ImportMapping `json:"mappings"`         // 字段映射报告
+}
+
 type ListArticlesReq struct {
 	PageNum  int    `form:"pageNum,default=1"`
 	PageSize int    `form:"pageSize,default=10"`
@@ -123,6 +230,73 @@ type ListArticlesResp struct {
 	Total int64     `json:"total"`
 }
 
+type ListResumeShareViewsReq struct {
+	ResumeID int64  `path:"resume_id"`                      // 简历ID
+	ShareID  int64  `path:"share_id"`                       // 分享ID
+	Cursor   string `form:"cursor,optional"`                // 分页游标（取上一页返回的 next_cursor）
+	Limit    int    `form:"limit,default=20,range=[1:100]"` // 每页数量
+}
+
+type ListResumeShareViewsResp struct {
+	Items      []ResumeShareViewItem `json:"items"`       // 访问记录（按时间倒序）
+	NextCursor string                `json:"next_cursor"` // 下一页游标，为空表示没有更多
+	HasMore    bool                  `json:"has_more"`    // 是否还有更多
+}
+
+type ListResumeSharesReq struct {
+	ResumeID int64 `path:"resume_id"` // 简历ID
+}
+
+type ListResumeSharesResp struct {
+	Items []ResumeShareItem `json:"items"` // 分享链接（按创建时间倒序）
+}
+
+type ListResumeTemplatesResp struct {
+	Templates []ResumeTemplateInfo `json:"templates"` // 可选模板
+}
+
+type ListResumeVersionsReq struct {
+	ResumeID int64  `path:"resume_id"`                      // 简历ID
+	Cursor   string `form:"cursor,optional"`                // 分页游标（取上一页返回的 next_cursor）
+	Limit    int    `form:"limit,default=20,range=[1:100]"` // 每页数量
+}
+
+type ListResumeVersionsResp struct {
+	Items      []ResumeVersionItem `json:"items"`       // 版本列表（按时间倒序）
+	NextCursor string              `json:"next_cursor"` // 下一页游标，为空表示没有更多
+	HasMore    bool                `json:"has_more"`    // 是否还有更多
+}
+
+type ListResumesReq struct {
+	Cursor      string `form:"cursor,optional"`                                          // 分页游标（取上一页返回的 next_cursor，sort_by 和 order 需与上一页一致，否则返回 400）
+	Limit       int    `form:"limit,default=20,range=[1:100]"`                           // 每页数量
+	Status      int32  `form:"status,optional"`                                          // 状态筛选: 1=pending, 2=processing, 3=completed
+	Keyword     string `form:"keyword,optional"`                                         // 文件名关键字
+	CreatedFrom string `form:"created_from,optional"`                                    // 创建时间起（2006-01-02 或 2006-01-02 15:04:05）
+	CreatedTo   string `form:"created_to,optional"`                                      // 创建时间止
+	UpdatedFrom string `form:"updated_from,optional"`                                    // 更新时间起
+	UpdatedTo   string `form:"updated_to,optional"`                                      // 更新时间止
+	SortBy      string `form:"sort_by,default=updated_at,options=created_at|updated_at"` // 排序字段
+	Order       string `form:"order,default=desc,options=asc|desc"`                      // 排序方向
+}
+
+type ListResumesResp struct {
+	Items      []ResumeListItem `json:"items"`       // 简历列表
+	NextCursor string           `json:"next_cursor"` // 下一页游标，为空表示没有更多
+	HasMore    bool             `json:"has_more"`    // 是否还有更多
+}
+
+type ListTrashReq struct {
+	Cursor string `form:"cursor,optional"`                // 分页游标（取上一页返回的 next_cursor）
+	Limit  int    `form:"limit,default=20,range=[1:100]"` // 每页数量
+}
+
+type ListTrashResp struct {
+	Items      []TrashItem `json:"items"`       // 回收站简历列表
+	NextCursor string      `json:"next_cursor"` // 下一页游标，为空表示没有更多
+	HasMore    bool        `json:"has_more"`    // 是否还有更多
+}
+
 type LoginReq struct {
 	GrantType   string `json:"grant_type"`  // 授权类型
 	Credentials string `json:"credentials"` // 凭证信息(JSON字符串)
@@ -135,10 +309,42 @@ type LoginResp struct {
 
 type ModuleInfo struct {
 	ModuleID   int64                    `json:"module_id,string"` // 模块ID
+	Code       string                   `json:"code"`             // 模块编码（稳定标识）
+	Shape      string                   `json:"shape"`            // 数据形态: object/list/text
 	Title      string                   `json:"title"`            // 模块标题
-	Score      float64                  `json:"score"`            // 模块得分
+	Score      float64                  `json:"score"`            // 模块得分（百分制，各维度得分按权重的加权平均）
+	Weight     float64                  `json:"weight"`           // 在简历总分中的权重占比（0-1），未评分的模块为 0
 	Data       []map[string]interface{} `json:"data"`             // 模块数据
 	Dimensions []DimensionInfo          `json:"dimensions"`       // 维度得分列表
+	ScoreState string                   `json:"score_state"`      // 评分状态: pending=后台评分中, done=已评分, failed=最近一次评分失败, stale=数据已修改但未重新评分；未评分为空。非 done 时得分为修改前的得分
+}
+
+type ModuleItemResp struct {
+	ItemID   string     `json:"item_id"`  // 操作的条目ID
+	Module   ModuleInfo `json:"module"`   // 操作后的模块（含数据和得分）
+	Revision int64      `json:"revision"` // 操作后的内容版本号
+}
+
+type ModuleScoreSeries struct {
+	ModuleID int64        `json:"module_id,string"` // 模块ID
+	Title    string       `json:"title"`            // 模块标题（最近一次评分时的标题）
+	Points   []ScorePoint `json:"points"`           // 得分（按时间升序，模块未评分的记录没有点）
+}
+
+type ModuleScoreState struct {
+	ModuleID  int64   `json:"module_id,string"` // 模块ID
+	State     string  `json:"state"`            // 评分状态: pending/done/failed/stale
+	Scored    bool    `json:"scored"`           // 是否已有得分，首次评分完成前为 false
+	Score     float64 `json:"score"`            // 模块得分（非 done 时为修改前的得分）
+	UpdatedAt string  `json:"updated_at"`       // 状态更新时间
+}
+
+type MoveModuleItemReq struct {
+	ResumeID int64  `path:"resume_id"` // 简历ID
+	ModuleID int64  `path:"module_id"` // 模块ID
+	ItemID   string `path:"item_id"`   // 条目ID
+	Position int    `json:"position"`  // 目标位置（从 0 开始），超出范围时移到末尾
+	Revision int64  `json:"revision"`  // 读取时的内容版本号
 }
 
 type OptionsResp struct {
@@ -146,15 +352,120 @@ type OptionsResp struct {
 	Data  string `json:"data"`
 }
 
+type PatchModuleItemReq struct {
+	ResumeID int64                  `path:"resume_id"` // 简历ID
+	ModuleID int64                  `path:"module_id"` // 模块ID
+	ItemID   string                 `path:"item_id"`   // 条目ID
+	Patch    map[string]interface{} `json:"patch"`     // 合并补丁，值为 null 的字段会被删除
+	Revision int64                  `json:"revision"`  // 读取时的内容版本号
+}
+
+type PreviewResumeReq struct {
+	ResumeID int64  `path:"resume_id"`                                        // 简历ID
+	Revision int64  `form:"revision,optional"`                                // 内容版本号，指定时须与当前版本一致，响应可长期缓存
+	Template string `form:"template,optional,options=classic|modern|compact"` // 预览使用的模板，为空时使用简历当前模板
+	Print    bool   `form:"print,optional"`                                   // 打印模式（去掉背景和阴影，按 A4 分页）
+}
+
+type PurgeResumeReq struct {
+	ResumeID int64 `path:"resume_id"` // 简历ID
+}
+
+type PurgeResumeResp struct {
+	ResumeID int64 `json:"resume_id,string"` // 简历ID
+}
+
 type QuestionAnswer struct {
 	Question string   `json:"question"` // 问题
 	Answers  []string `json:"answers"`  // 答案列表
 }
 
+type RestoreResumeReq struct {
+	ResumeID int64 `path:"resume_id"` // 简历ID
+}
+
+type RestoreResumeResp struct {
+	ResumeID int64 `json:"resume_id,string"` // 简历ID
+}
+
+type ResumeListItem struct {
+	ResumeID         int64   `json:"resume_id,string"`  // 简历ID
+	FileName         string  `json:"file_name"`         // 文件名
+	FilePath         string  `json:"file_path"`         // 文件路径
+	CoverImage       string  `json:"cover_image"`       // 封面图URL
+	Status           int32   `json:"status"`            // 状态
+	TotalScore       float64 `json:"total_score"`       // 总分（百分制，各模块得分按权重的加权平均）
+	CompletedModules int     `json:"completed_modules"` // 已填写的模块数
+	TotalModules     int     `json:"total_modules"`     // 需要填写的模块总数（不含可选的工作经历和自定义模块）
+	CreatedAt        string  `json:"created_at"`        // 创建时间
+	UpdatedAt        string  `json:"updated_at"`        // 更新时间
+}
+
+type ResumeShareItem struct {
+	ShareID      int64  `json:"share_id,string"`  // 分享ID
+	ResumeID     int64  `json:"resume_id,string"` // 简历ID
+	Token        string `json:"token"`            // 分享令牌
+	URL          string `json:"url"`              // 分享地址
+	Permission   string `json:"permission"`       // 权限: read/download
+	HasPassword  bool   `json:"has_password"`     // 是否需要访问密码
+	Redact       bool   `json:"redact"`           // 是否脱敏
+	Status       string `json:"status"`           // 状态: active=有效, expired=已过期, revoked=已撤销
+	ExpiresAt    string `json:"expires_at"`       // 过期时间
+	RevokedAt    string `json:"revoked_at"`       // 撤销时间，未撤销为空
+	ViewCount    int64  `json:"view_count"`       // 访问次数（在线查看和下载）
+	LastViewedAt string `json:"last_viewed_at"`   // 最近访问时间，未访问为空
+	CreatedAt    string `json:"created_at"`       // 创建时间
+}
+
+type ResumeShareViewItem struct {
+	ViewID    int64  `json:"view_id,string"` // 记录ID
+	Action    string `json:"action"`         // 操作: view=在线查看, download=下载 PDF
+	Referrer  string `json:"referrer"`       // 来源页面（不含查询参数）
+	UserAgent string `json:"user_agent"`     // 客户端类型，如 Chrome/Windows
+	ViewedAt  string `json:"viewed_at"`      // 访问时间
+}
+
+type ResumeTemplateInfo struct {
+	Code        string `json:"code"`        // 模板编码
+	Name        string `json:"name"`        // 模板名称
+	Description string `json:"description"` // 模板说明
+}
+
+type ResumeVersionItem struct {
+	VersionID  string  `json:"version_id"`       // 版本ID
+	Action     string  `json:"action"`           // 产生版本的操作: create/init/save/revert
+	ModuleID   int64   `json:"module_id,string"` // 变更的模块ID，0 表示整份简历
+	AuthorID   int64   `json:"author_id,string"` // 操作人ID
+	TotalScore float64 `json:"total_score"`      // 写入版本时的简历总分，修改的模块仍在后台评分时按修改前的得分计算
+	RevertFrom string  `json:"revert_from"`      // 回滚来源版本ID（仅 revert）
+	CreatedAt  string  `json:"created_at"`       // 创建时间
+}
+
+type RevertResumeVersionReq struct {
+	ResumeID  int64  `path:"resume_id"`                 // 简历ID
+	VersionID string `path:"version_id"`                // 回滚到的版本ID
+	ModuleID  int64  `json:"module_id,string,optional"` // 只回滚指定模块，为空表示回滚整份简历
+}
+
+type RevertResumeVersionResp struct {
+	ResumeID  int64    `json:"resume_id,string"` // 简历ID
+	VersionID string   `json:"version_id"`       // 回滚后生成的新版本ID，版本快照写入失败时为空
+	ModuleIDs []string `json:"module_ids"`       // 重新评分的模块ID列表
+	Revision  int64    `json:"revision"`         // 回滚后的内容版本号
+}
+
+type RevokeResumeShareReq struct {
+	ResumeID int64 `path:"resume_id"` // 简历ID
+	ShareID  int64 `path:"share_id"`  // 分享ID
+}
+
 type SaveModuleReq struct {
-	ResumeID int64                    `json:"resume_id,string"` // 简历ID
-	ModuleID int64                    `json:"module_id,string"` // 模块ID
-	Data     []map[string]interface{} `json:"data"`             // 模块数据
+	ResumeID int64                    `json:"resume_id,string"`                    // 简历ID
+	ModuleID int64                    `json:"module_id,string"`                    // 模块ID
+	Data     []map[string]interface{} `json:"data"`                                // 模块数据
+	Revision int64                    `json:"revision"`                            // 读取时的内容版本号，与服务端不一致时返回 409
+	Source   string                   `json:"source,default=edit,options=edit|ai"` // 修改来源: edit=手动编辑, ai=采纳 AI 帮写（记入评分历史）
+	Force    bool                     `json:"force,optional"`                      // 强制重新评分，不使用评分缓存
 }
 
 type SaveModuleResp struct {
@@ -163,6 +474,90 @@ type SaveModuleResp struct {
 	Score      float64                  `json:"score"`            // 模块得分
 	Data       []map[string]interface{} `json:"data"`             // 模块数据
 	Dimensions []DimensionInfo          `json:"dimensions"`       // 维度得分列表
+	Revision   int64                    `json:"revision"`         // 保存后的内容版本号
+	Scoring    string                   `json:"scoring"`          // 评分状态: pending=后台评分中（score 和 dimensions 为修改前的得分）, stale=未能加入评分队列，可重新保存
+}
+
+type ScoreChange struct {
+	ModuleID  int64   `json:"module_id,string"`   // 模块ID
+	Title     string  `json:"title"`              // 模块标题
+	FromRunID int64   `json:"from_run_id,string"` // 变化前的评分记录ID
+	ToRunID   int64   `json:"to_run_id,string"`   // 变化后的评分记录ID
+	Trigger   string  `json:"trigger"`            // 变化后那次评分的触发方式
+	Before    float64 `json:"before"`             // 变化前得分
+	After     float64 `json:"after"`              // 变化后得分
+	Delta     float64 `json:"delta"`              // 得分变化
+	CreatedAt string  `json:"created_at"`         // 变化后那次评分的时间
+}
+
+type ScoreFailure struct {
+	ModuleID int64  `json:"module_id,string"` // 模块ID
+	Title    string `json:"title"`            // 模块标题
+	Reason   string `json:"reason"`           // 失败原因: timeout=评分超时, error=评分服务异常
+}
+
+type ScoreHistoryReq struct {
+	ResumeID int64 `path:"resume_id"`                      // 简历ID
+	Limit    int   `form:"limit,default=50,range=[1:200]"` // 最近的评分次数
+	Top      int   `form:"top,default=5,range=[1:20]"`     // 得分提升和下降各返回的条数
+}
+
+type ScoreHistoryResp struct {
+	Runs        []ScoreRunItem      `json:"runs"`        // 评分记录（按时间升序）
+	Modules     []ModuleScoreSeries `json:"modules"`     // 各模块的得分曲线
+	Gains       []ScoreChange       `json:"gains"`       // 得分提升最多的变化（按提升幅度降序）
+	Regressions []ScoreChange       `json:"regressions"` // 得分下降最多的变化（按下降幅度降序）
+}
+
+type ScorePoint struct {
+	RunID     int64   `json:"run_id,string"` // 评分记录ID
+	Score     float64 `json:"score"`         // 模块得分
+	CreatedAt string  `json:"created_at"`    // 评分时间
+}
+
+type ScoreRunItem struct {
+	RunID       int64          `json:"run_id,string"` // 评分记录ID
+	Trigger     string         `json:"trigger"`       // 触发方式: upload=上传/生成/导入, save=编辑保存, ai_apply=采纳 AI 帮写, rescore=重新评分
+	RuleVersion string         `json:"rule_version"`  // 评分规则版本，不同版本的得分不完全可比
+	TotalScore  float64        `json:"total_score"`   // 评分后的简历总分
+	Delta       float64        `json:"delta"`         // 与上一次评分相比的总分变化
+	Status      string         `json:"status"`        // 评分结果: success=全部成功, partial=部分模块失败, failed=全部失败
+	Failures    []ScoreFailure `json:"failures"`      // 评分失败的模块（曲线上没有这些模块在本次评分的点）
+	CreatedAt   string         `json:"created_at"`    // 评分时间
+}
+
+type ScoreStatusReq struct {
+	ResumeID int64 `path:"resume_id"` // 简历ID
+}
+
+type ScoreStatusResp struct {
+	ResumeID   int64              `json:"resume_id,string"` // 简历ID
+	Scoring    string             `json:"scoring"`          // 评分状态: pending=有模块正在后台评分, done=没有进行中的评分
+	TotalScore float64            `json:"total_score"`      // 当前总分
+	Modules    []ModuleScoreState `json:"modules"`          // 已评分或等待评分的模块
+}
+
+type ShareAccessReq struct {
+	Token     string `path:"token"`                       // 分享令牌
+	Password  string `header:"X-Share-Password,optional"` // 访问密码（通过请求头传递，避免出现在地址和日志中）
+	Referer   string `header:"Referer,optional"`          // 来源页面，用于访问记录
+	UserAgent string `header:"User-Agent,optional"`       // 客户端，用于访问记录
+}
+
+type ShareDownloadResp struct {
+	DownloadURL string `json:"download_url"` // 预签名下载 URL（短期有效）
+	FileName    string `json:"file_name"`    // 下载文件名
+	ExpiresIn   int64  `json:"expires_in"`   // 过期时间（秒）
+}
+
+type ShareInfoReq struct {
+	Token string `path:"token"` // 分享令牌
+}
+
+type ShareInfoResp struct {
+	Permission      string `json:"permission"`       // 权限: read/download
+	RequirePassword bool   `json:"require_password"` // 是否需要访问密码
+	ExpiresAt       string `json:"expires_at"`       // 过期时间
 }
 
 type SlotPayNotifyReq struct {
@@ -185,8 +580,35 @@ type TaskStatusReq struct {
 }
 
 type TaskStatusResp struct {
-	Status   string `json:"status"`             // 任务状态: PENDING/PROCESSING/SUCCESS/FAILURE/TIMEOUT
-	ResumeID int64  `json:"resume_id,optional"` // 简历ID（成功时返回）
+	Status   string       `json:"status"`             // 任务状态: PENDING/PROCESSING/SUCCESS/FAILURE/TIMEOUT
+	ResumeID int64        `json:"resume_id,optional"` // 简历ID（成功时返回）
+	Message  string       `json:"message,optional"`   // 失败原因（失败时返回）
+	Errors   []FieldError `json:"errors,optional"`    // 数据校验错误（校验失败时返回）
+}
+
+type TrashItem struct {
+	ResumeID   int64  `json:"resume_id,string"` // 简历ID
+	FileName   string `json:"file_name"`        // 文件名
+	CoverImage string `json:"cover_image"`      // 封面图URL
+	DeletedAt  string `json:"deleted_at"`       // 删除时间
+	PurgeAt    string `json:"purge_at"`         // 预计彻底删除时间
+}
+
+type UpdateCustomModuleReq struct {
+	ResumeID int64  `path:"resume_id"` // 简历ID
+	ModuleID int64  `path:"module_id"` // 自定义模块ID
+	Title    string `json:"title"`     // 模块标题
+	Revision int64  `json:"revision"`  // 读取时的内容版本号
+}
+
+type UpdateResumeTemplateReq struct {
+	ResumeID int64  `path:"resume_id"`                               // 简历ID
+	Template string `json:"template,options=classic|modern|compact"` // 模板编码
+}
+
+type UpdateResumeTemplateResp struct {
+	Template     string `json:"template"`      // 模板编码
+	RenderStatus string `json:"render_status"` // PDF 渲染状态，切换模板后按新模板重新渲染
 }
 
 type UploadPolicyReq struct {
@@ -213,3 +635,17 @@ type UploadPresignResp struct {
 type UploadResumeResp struct {
 	TaskID string `json:"task_id"` // 任务ID（用于查询解析状态）
 }
+
+type ValidationErrors struct {
+	Errors []FieldError `json:"errors"` // 字段校验错误列表
+}
+
+type VersionFieldChange struct {
+	ModuleID  int64       `json:"module_id,string"` // 模块ID
+	Title     string      `json:"title"`            // 模块标题
+	ItemIndex int         `json:"item_index"`       // 模块数据项下标，-1 表示整个模块
+	Field     string      `json:"field"`            // 字段名，为空表示整项
+	Op        string      `json:"op"`               // 变更类型: added/removed/modified
+	Before    interface{} `json:"before"`           // 变更前的值
+	After     interface{} `json:"after"`            // 变更后的值
+}